@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/NarthurN/FileServerService/internal/cache"
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/database"
+	fileserverCompositeRepo "github.com/NarthurN/FileServerService/internal/repository"
+	fileserverService "github.com/NarthurN/FileServerService/internal/service"
+)
+
+// Создание первого администратора: go run ./cmd/admin -login admin -password 'Secret1!'
+// Если пользователь с таким логином уже существует, ему назначается роль admin
+func main() {
+	login := flag.String("login", "", "логин администратора")
+	password := flag.String("password", os.Getenv("ADMIN_PASSWORD"), "пароль администратора (по умолчанию ADMIN_PASSWORD)")
+	flag.Parse()
+	if *login == "" {
+		log.Fatal("🚨 не указан логин администратора (-login)")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("🚨 ошибка загрузки конфигурации:", err)
+	}
+
+	ctx := context.Background()
+
+	cacheManager, err := cache.NewCacheManager(100)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания кэш-менеджера:", err)
+	}
+	pool, err := database.NewPool(cfg.Database)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания пула соединений:", err)
+	}
+	defer pool.Close()
+
+	repo := fileserverCompositeRepo.NewCompositeRepository(pool)
+	service, err := fileserverService.NewCompositeService(repo, cfg, cacheManager)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания сервиса:", err)
+	}
+
+	admin, err := service.BootstrapAdmin(ctx, *login, *password)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания администратора:", err)
+	}
+
+	fmt.Printf("admin\t%s\t%s\n", admin.Login, admin.ID)
+}
@@ -0,0 +1,256 @@
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"log"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Локальный провайдер OpenID Connect для разработки и проверки входа через OIDC:
+// go run ./cmd/mockoidc [-addr :9090] [-issuer http://localhost:9090] [-client-id fileserver]
+//
+// Страница входа не спрашивает пароль: пользователь задается параметром login_hint
+// (или -email по умолчанию), subject - детерминированный хеш email
+func main() {
+	addr := flag.String("addr", ":9090", "адрес HTTP-сервера")
+	issuer := flag.String("issuer", "http://localhost:9090", "значение iss и базовый адрес провайдера")
+	clientID := flag.String("client-id", "fileserver", "идентификатор клиента")
+	clientSecret := flag.String("client-secret", "", "секрет клиента; пусто - публичный клиент")
+	email := flag.String("email", "sso.user@example.com", "email пользователя без login_hint")
+	flag.Parse()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		log.Fatal("🚨 ошибка генерации ключа:", err)
+	}
+
+	provider := &mockProvider{
+		issuer:       strings.TrimSuffix(*issuer, "/"),
+		clientID:     *clientID,
+		clientSecret: *clientSecret,
+		email:        *email,
+		key:          key,
+		kid:          "mock-" + time.Now().UTC().Format("20060102150405"),
+		codes:        make(map[string]authorization),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", provider.discovery)
+	mux.HandleFunc("GET /authorize", provider.authorize)
+	mux.HandleFunc("POST /token", provider.token)
+	mux.HandleFunc("GET /jwks", provider.jwks)
+
+	log.Printf("🚀 Mock OIDC провайдер %s слушает %s (client_id=%s)", provider.issuer, *addr, provider.clientID)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Fatal("🚨 ошибка HTTP-сервера:", err)
+	}
+}
+
+// authorization - выданный код авторизации
+type authorization struct {
+	redirectURI   string
+	codeChallenge string
+	nonce         string
+	email         string
+	expiresAt     time.Time
+}
+
+type mockProvider struct {
+	issuer       string
+	clientID     string
+	clientSecret string
+	email        string
+	key          *rsa.PrivateKey
+	kid          string
+
+	mu    sync.Mutex
+	codes map[string]authorization
+}
+
+func (p *mockProvider) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]any{
+		"issuer":                                p.issuer,
+		"authorization_endpoint":                p.issuer + "/authorize",
+		"token_endpoint":                        p.issuer + "/token",
+		"jwks_uri":                              p.issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+// authorize - "вход" без пароля: сразу перенаправляет обратно с кодом авторизации
+func (p *mockProvider) authorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != p.clientID || query.Get("response_type") != "code" {
+		http.Error(w, "unknown client or unsupported response_type", http.StatusBadRequest)
+		return
+	}
+	if query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "PKCE S256 is required", http.StatusBadRequest)
+		return
+	}
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.Scheme == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+
+	email := query.Get("login_hint")
+	if email == "" {
+		email = p.email
+	}
+
+	code := randomString()
+	p.mu.Lock()
+	p.codes[code] = authorization{
+		redirectURI:   redirectURI.String(),
+		codeChallenge: query.Get("code_challenge"),
+		nonce:         query.Get("nonce"),
+		email:         strings.ToLower(email),
+		expiresAt:     time.Now().Add(time.Minute),
+	}
+	p.mu.Unlock()
+
+	callback := redirectURI.Query()
+	callback.Set("code", code)
+	callback.Set("state", query.Get("state"))
+	redirectURI.RawQuery = callback.Encode()
+
+	log.Printf("🔄 Mock OIDC: вход %s, возврат на %s", email, redirectURI.Host+redirectURI.Path)
+	http.Redirect(w, r, redirectURI.String(), http.StatusFound)
+}
+
+// token - обмен кода на ID-токен с проверкой клиента, redirect_uri и PKCE
+func (p *mockProvider) token(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		oauthError(w, "unsupported_grant_type")
+		return
+	}
+	if !p.authenticateClient(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	code := r.PostForm.Get("code")
+	p.mu.Lock()
+	auth, ok := p.codes[code]
+	delete(p.codes, code) // Код одноразовый
+	p.mu.Unlock()
+
+	sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	switch {
+	case !ok || time.Now().After(auth.expiresAt):
+		oauthError(w, "invalid_grant")
+		return
+	case r.PostForm.Get("redirect_uri") != auth.redirectURI:
+		oauthError(w, "invalid_grant")
+		return
+	case base64.RawURLEncoding.EncodeToString(sum[:]) != auth.codeChallenge:
+		oauthError(w, "invalid_grant")
+		return
+	}
+
+	now := time.Now().Unix()
+	subject := sha256.Sum256([]byte(auth.email))
+	idToken, err := p.sign(map[string]any{
+		"iss":                p.issuer,
+		"sub":                hex.EncodeToString(subject[:16]),
+		"aud":                p.clientID,
+		"iat":                now,
+		"exp":                now + 300,
+		"nonce":              auth.nonce,
+		"email":              auth.email,
+		"email_verified":     true,
+		"preferred_username": strings.Split(auth.email, "@")[0],
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token": randomString(),
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func (p *mockProvider) authenticateClient(r *http.Request) bool {
+	id, secret, basic := r.BasicAuth()
+	if basic {
+		id, _ = url.QueryUnescape(id)
+		secret, _ = url.QueryUnescape(secret)
+	} else {
+		id = r.PostForm.Get("client_id")
+	}
+	if id != p.clientID {
+		return false
+	}
+	return p.clientSecret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(p.clientSecret)) == 1
+}
+
+func (p *mockProvider) jwks(w http.ResponseWriter, r *http.Request) {
+	encode := base64.RawURLEncoding.EncodeToString
+	writeJSON(w, http.StatusOK, map[string]any{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"use": "sig",
+			"alg": "RS256",
+			"kid": p.kid,
+			"n":   encode(p.key.N.Bytes()),
+			"e":   encode(big.NewInt(int64(p.key.E)).Bytes()),
+		}},
+	})
+}
+
+func (p *mockProvider) sign(claims map[string]any) (string, error) {
+	encode := base64.RawURLEncoding.EncodeToString
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": p.kid})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := encode(header) + "." + encode(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + encode(signature), nil
+}
+
+func oauthError(w http.ResponseWriter, code string) {
+	writeJSON(w, http.StatusBadRequest, map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func randomString() string {
+	buf := make([]byte, 24)
+	_, _ = rand.Read(buf)
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/NarthurN/FileServerService/internal/cache"
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/database"
+	"github.com/NarthurN/FileServerService/internal/jobs"
+	fileserverCompositeRepo "github.com/NarthurN/FileServerService/internal/repository"
+	fileserverService "github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// Разовая сверка хранилища файлов и БД: go run ./cmd/reconcile [-repair] [-grace 1h]
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatal("🚨 ошибка загрузки конфигурации:", err)
+	}
+
+	repair := flag.Bool("repair", cfg.Reconcile.Repair, "удалить осиротевшие объекты и документы без содержимого, пересчитать ссылки")
+	grace := flag.Duration("grace", cfg.Reconcile.Grace, "объекты моложе этого возраста не считаются осиротевшими")
+	flag.Parse()
+	cfg.Reconcile.Repair = *repair
+	cfg.Reconcile.Grace = *grace
+
+	ctx := context.Background()
+
+	cacheManager, err := cache.NewCacheManager(100)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания кэш-менеджера:", err)
+	}
+	pool, err := database.NewPool(cfg.Database)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания пула соединений:", err)
+	}
+	defer pool.Close()
+
+	repo := fileserverCompositeRepo.NewCompositeRepository(pool)
+	service, err := fileserverService.NewCompositeService(repo, cfg, cacheManager)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания сервиса:", err)
+	}
+	blobStore, err := storage.New(ctx, cfg.Storage)
+	if err != nil {
+		log.Fatal("🚨 ошибка создания хранилища файлов:", err)
+	}
+
+	report, err := jobs.NewReconciler(service, blobStore, cfg.Reconcile).Reconcile(ctx)
+	if err != nil {
+		log.Fatal("🚨 ошибка сверки:", err)
+	}
+
+	for _, key := range report.OrphanObjects {
+		fmt.Printf("orphan object\t%s\n", key)
+	}
+	for _, key := range report.StaleTempFiles {
+		fmt.Printf("stale temp file\t%s\n", key)
+	}
+	for _, f := range report.MissingContent {
+		fmt.Printf("missing content\tdocument=%s version=%d current=%t key=%s\n", f.DocumentID, f.Version, f.Current, f.Key)
+	}
+	fmt.Printf("orphan objects: %d, stale temp files: %d, missing content: %d, blob ref mismatches: %d\n",
+		len(report.OrphanObjects), len(report.StaleTempFiles), len(report.MissingContent), report.BlobRefMismatch)
+	if *repair {
+		fmt.Printf("deleted objects: %d, deleted temp files: %d, deleted documents: %d\n",
+			report.DeletedObjects, report.DeletedTempFiles, report.DeletedDocuments)
+	}
+
+	// Ненулевой код возврата позволяет использовать команду в мониторинге
+	if report.HasProblems() && !*repair {
+		os.Exit(1)
+	}
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/NarthurN/FileServerService/internal/config"
 	"github.com/NarthurN/FileServerService/internal/database"
 	"github.com/NarthurN/FileServerService/internal/database/migrator"
+	"github.com/NarthurN/FileServerService/internal/jobs"
 	fileserverCompositeRepo "github.com/NarthurN/FileServerService/internal/repository"
 	fileserverService "github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
@@ -67,13 +69,45 @@ func main() {
 	repo := fileserverCompositeRepo.NewCompositeRepository(pool)
 	log.Printf("🟢 Репозиторий создан")
 	// Создание сервиса
-	service := fileserverService.NewCompositeService(repo, cfg, cacheManager)
+	service, err := fileserverService.NewCompositeService(repo, cfg, cacheManager)
+	if err != nil {
+		log.Printf("🚨 ошибка создания сервиса: %v", err)
+		return
+	}
 	log.Printf("🟢 Сервис создан")
+	// Создание хранилища файлов
+	blobStore, err := storage.New(ctx, cfg.Storage)
+	if err != nil {
+		log.Printf("🚨 ошибка создания хранилища файлов: %v", err)
+		return
+	}
+	log.Printf("🟢 Хранилище файлов создано (драйвер %s)", cfg.Storage.Driver)
+	// Фоновая очистка истекших сессий загрузки
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+	go jobs.NewUploadCleaner(service, blobStore, cfg.Upload.CleanupInterval).Run(jobsCtx)
+	log.Printf("🟢 Очистка загрузок запущена")
+	// Фоновая сверка файлов в хранилище со строками БД
+	go jobs.NewReconciler(service, blobStore, cfg.Reconcile).Run(jobsCtx)
+	log.Printf("🟢 Сверка хранилища запущена")
+	// Фоновое извлечение текста документов для полнотекстового поиска
+	go jobs.NewSearchIndexer(service, blobStore, cfg.Search).Run(jobsCtx)
+	log.Printf("🟢 Индексатор поиска запущен")
+	// Размеры файлов, перенесенных в версии без размера (разово, до опустошения очереди)
+	go jobs.NewSizeBackfill(service, blobStore).Run(jobsCtx)
+	// Окончательное удаление документов, срок хранения которых в корзине истек
+	go jobs.NewTrashPurger(service, blobStore, cfg.Trash).Run(jobsCtx)
+	log.Printf("🟢 Очистка корзины запущена")
+	// Синхронизация списка отозванных JWT между экземплярами
+	if cfg.Auth.TokenMode == config.TokenModeJWT {
+		go jobs.NewRevocationSync(service, cfg.Auth.JWT.RevokeSync).Run(jobsCtx)
+		log.Printf("🟢 Синхронизация отозванных токенов запущена")
+	}
 	// Создание API
-	api := fileserverAPI.NewAPI(service)
+	api := fileserverAPI.NewAPI(service, blobStore)
 	log.Printf("🟢 API создан")
 	// Создание сервера
-	fileServer, err := fileserverV1.NewServer(api)
+	fileServer, err := fileserverV1.NewServer(api, api)
 	if err != nil {
 		log.Printf("🚨 ошибка создания сервера: %v", err)
 		return
@@ -85,10 +119,14 @@ func main() {
 	r.Use(middleware.Recoverer)
 	r.Use(middleware.Timeout(60 * time.Second))
 
-	r.Mount("/api", fileServer)
+	// Файлы документов отдаются с поддержкой Range и условных запросов, остальное - сгенерированным сервером
+	apiHandler := fileserverAPI.ClientInfo(api.FileDownloads(fileServer))
+	r.Mount("/api", apiHandler)
+	// Публичные ссылки на документы (без авторизации)
+	r.Mount("/s", apiHandler)
 
-	// Статические файлы для загруженных документов
-	r.Handle("/uploads/*", http.StripPrefix("/uploads", http.FileServer(http.Dir("./bin/storage"))))
+	// Объекты хранилища наружу не раздаются: содержимое доступно только через /api/docs/{id}
+	// и /s/{slug}, где проверяются права доступа, корзина и отзыв ссылок
 
 	// Swagger UI
 	swaggerFS := http.FileServer(http.Dir("./pkg/openapi/bundles"))
@@ -124,6 +162,7 @@ func main() {
 	<-quit
 
 	log.Println("👋 HTTP сервер завершает работу...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
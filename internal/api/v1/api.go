@@ -7,20 +7,26 @@ import (
 
 	"github.com/NarthurN/FileServerService/internal/model"
 	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
 
-var _ fileserverV1.Handler = (*api)(nil)
+var (
+	_ fileserverV1.Handler         = (*api)(nil)
+	_ fileserverV1.SecurityHandler = (*api)(nil)
+)
 
 type api struct {
 	//fileserverV1.UnimplementedHandler
 
 	service service.FileServerService
+	storage storage.BlobStore
 }
 
-func NewAPI(service service.FileServerService) *api {
+func NewAPI(service service.FileServerService, storage storage.BlobStore) *api {
 	return &api{
 		service: service,
+		storage: storage,
 	}
 }
 
@@ -38,6 +44,28 @@ func (a *api) validateToken(ctx context.Context, token string) (model.User, erro
 	return user, nil
 }
 
+// scopeError - ответ на запрос API-ключом без разрешения scope
+func scopeError(scope model.Scope) *fileserverV1.ForbiddenError {
+	log.Printf("🚨 API: У API-ключа нет разрешения %s", scope)
+	return &fileserverV1.ForbiddenError{
+		Error: fileserverV1.ForbiddenErrorError{
+			Code: 403,
+			Text: fmt.Sprintf("🚨 У API-ключа нет разрешения %s", scope),
+		},
+	}
+}
+
+// apiKeyError - ответ на запрос API-ключом к операциям с учетной записью, доступным только с токеном сессии
+func apiKeyError() *fileserverV1.ForbiddenError {
+	log.Printf("🚨 API: Операция с учетной записью запрошена API-ключом")
+	return &fileserverV1.ForbiddenError{
+		Error: fileserverV1.ForbiddenErrorError{
+			Code: 403,
+			Text: "🚨 Операция доступна только с токеном сессии",
+		},
+	}
+}
+
 // getUserByLogin - получение пользователя по логину
 func (a *api) getUserByLogin(ctx context.Context, login string) (model.User, error) {
 	log.Printf("API: Поиск пользователя по логину: %s", login)
@@ -50,34 +78,3 @@ func (a *api) getUserByLogin(ctx context.Context, login string) (model.User, err
 	log.Printf("API: Пользователь %s найден, ID: %s", login, user.ID)
 	return user, nil
 }
-
-// filterDocuments - фильтрация документов по ключу и значению
-func (a *api) filterDocuments(docs []model.Document, key, value string) []model.Document {
-	var filtered []model.Document
-
-	for _, doc := range docs {
-		match := false
-		switch key {
-		case "name":
-			match = doc.Name == value
-		case "mime":
-			match = doc.MimeType == value
-		case "public":
-			match = (value == "true" && doc.IsPublic) || (value == "false" && !doc.IsPublic)
-		case "file":
-			match = (value == "true" && doc.IsFile) || (value == "false" && !doc.IsFile)
-		case "created":
-			// Простое сравнение даты в формате строки
-			match = doc.CreatedAt.Format("2006-01-02 15:04:05") == value
-		default:
-			// Если ключ не распознан, возвращаем все документы
-			return docs
-		}
-
-		if match {
-			filtered = append(filtered, doc)
-		}
-	}
-
-	return filtered
-}
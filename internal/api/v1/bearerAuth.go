@@ -0,0 +1,21 @@
+package v1
+
+import (
+	"context"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+type bearerTokenKey struct{}
+
+// HandleBearerAuth - токен из заголовка Authorization сохраняется в контексте; проверяет его обработчик,
+// чтобы ответ об ошибке имел тот же формат, что и у остальных методов
+func (a *api) HandleBearerAuth(ctx context.Context, operationName fileserverV1.OperationName, t fileserverV1.BearerAuth) (context.Context, error) {
+	return context.WithValue(ctx, bearerTokenKey{}, t.Token), nil
+}
+
+// bearerToken - токен из заголовка Authorization текущего запроса
+func bearerToken(ctx context.Context) string {
+	token, _ := ctx.Value(bearerTokenKey{}).(string)
+	return token
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CancelUpload - отмена загрузки с удалением принятых фрагментов
+func (a *api) CancelUpload(ctx context.Context, params fileserverV1.CancelUploadParams) (fileserverV1.CancelUploadRes, error) {
+	log.Printf("🔄 API: Отмена загрузки %s", params.UploadID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	session, err := a.service.GetUploadSession(ctx, params.UploadID, user.ID)
+	if err != nil {
+		return uploadSessionError(err), nil
+	}
+
+	if err := a.service.DeleteUploadSession(ctx, session.ID); err != nil {
+		return uploadSessionError(err), nil
+	}
+	a.deleteUploadData(ctx, session.ID)
+
+	log.Printf("🎉 API: Загрузка %s отменена", session.ID)
+	return &fileserverV1.CancelUploadNoContent{}, nil
+}
@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ChangePassword - смена пароля текущего пользователя
+func (a *api) ChangePassword(ctx context.Context, req *fileserverV1.ChangePasswordRequest, params fileserverV1.ChangePasswordParams) (fileserverV1.ChangePasswordRes, error) {
+	log.Printf("🔄 API: Смена пароля")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	revoked, err := a.service.ChangePassword(ctx, user.ID, params.Token, req.Pswd, req.NewPswd, clientInfo(ctx))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка смены пароля пользователя %s: %v", user.Login, err)
+		var throttle model.ThrottleError
+		if errors.As(err, &throttle) {
+			return throttleError(throttle), nil
+		}
+		return passwordError(err), nil
+	}
+
+	log.Printf("🎉 API: Пользователь %s сменил пароль", user.Login)
+	return &fileserverV1.ChangePasswordResponse{
+		Data: fileserverV1.ChangePasswordResponseData{
+			RevokedSessions: revoked,
+		},
+	}, nil
+}
+
+// passwordError - преобразование ошибки смены пароля в ответ API
+func passwordError(err error) interface {
+	fileserverV1.ChangePasswordRes
+	fileserverV1.ConfirmPasswordResetRes
+} {
+	switch {
+	case errors.Is(err, model.ErrInvalidCredentials):
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный текущий пароль",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidToken), errors.Is(err, model.ErrAccountDisabled):
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Токен сброса недействителен, истек или уже использован",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidInput):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось сменить пароль",
+			},
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// ClientInfo - middleware, сохраняющая User-Agent и IP клиента в контексте запроса для записи в сессию.
+// Используется адрес соединения: заголовки X-Forwarded-For клиент может подставить сам
+func ClientInfo(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip, _, err := net.SplitHostPort(r.RemoteAddr)
+		if err != nil {
+			ip = r.RemoteAddr
+		}
+		client := model.ClientInfo{
+			UserAgent: r.UserAgent(),
+			IPAddress: ip,
+		}
+		next.ServeHTTP(w, r.WithContext(model.WithClientInfo(r.Context(), client)))
+	})
+}
+
+// clientInfo - сведения о клиенте текущего запроса
+func clientInfo(ctx context.Context) model.ClientInfo {
+	return model.ClientInfoFromContext(ctx)
+}
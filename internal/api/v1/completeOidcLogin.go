@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CompleteOidcLogin - возврат пользователя от провайдера OIDC: выпуск токена авторизации
+func (a *api) CompleteOidcLogin(ctx context.Context, params fileserverV1.CompleteOidcLoginParams) (fileserverV1.CompleteOidcLoginRes, error) {
+	log.Printf("🔄 API: Завершение входа через OIDC")
+
+	if providerError, ok := params.Error.Get(); ok {
+		log.Printf("🚨 API: Провайдер OIDC отказал во входе: %s", providerError)
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: fmt.Sprintf("🚨 Провайдер отказал во входе: %s", providerError),
+			},
+		}, nil
+	}
+
+	result, err := a.service.CompleteOIDCLogin(ctx, params.Code.Or(""), params.State.Or(""), clientInfo(ctx))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка входа через OIDC: %v", err)
+		switch {
+		case errors.Is(err, model.ErrOIDCNotConfigured):
+			return oidcNotConfiguredError(), nil
+		case errors.Is(err, model.ErrInvalidInput):
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 %v", err),
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidToken), errors.Is(err, model.ErrInvalidCredentials):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Вход через OIDC отклонен, начните вход заново",
+				},
+			}, nil
+		case errors.Is(err, model.ErrAccessDenied), errors.Is(err, model.ErrAccountDisabled):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: fmt.Sprintf("🚨 %v", err),
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось выполнить вход через OIDC",
+			},
+		}, nil
+	}
+
+	if result.Token == "" {
+		log.Printf("🎉 API: Вход через OIDC принят, ожидается код второго фактора")
+		return &fileserverV1.SecondFactorChallengeResponse{
+			Response: fileserverV1.SecondFactorChallengeResponseResponse{
+				Challenge: result.Challenge,
+				Expires:   result.ChallengeExpiresAt,
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Пользователь аутентифицирован через OIDC")
+	return &fileserverV1.LoginResponse{
+		Response: fileserverV1.LoginResponseResponse{
+			Token: result.Token,
+		},
+	}, nil
+}
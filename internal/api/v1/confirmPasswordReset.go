@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ConfirmPasswordReset - установка нового пароля по токену сброса
+func (a *api) ConfirmPasswordReset(ctx context.Context, req *fileserverV1.PasswordResetConfirmRequest) (fileserverV1.ConfirmPasswordResetRes, error) {
+	log.Printf("🔄 API: Установка пароля по токену сброса")
+
+	revoked, err := a.service.ConfirmPasswordReset(ctx, req.ResetToken, req.NewPswd)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка установки пароля по токену сброса: %v", err)
+		return passwordError(err), nil
+	}
+
+	log.Printf("🎉 API: Пароль изменен по токену сброса")
+	return &fileserverV1.ChangePasswordResponse{
+		Data: fileserverV1.ChangePasswordResponseData{
+			RevokedSessions: revoked,
+		},
+	}, nil
+}
@@ -0,0 +1,85 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ConfirmTotp - включение двухфакторной аутентификации кодом из приложения
+func (a *api) ConfirmTotp(ctx context.Context, req *fileserverV1.TotpCodeRequest, params fileserverV1.ConfirmTotpParams) (fileserverV1.ConfirmTotpRes, error) {
+	log.Printf("🔄 API: Подтверждение 2FA")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	codes, err := a.service.ConfirmTOTP(ctx, user.ID, req.Code)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка подтверждения 2FA пользователя %s: %v", user.Login, err)
+		if errors.Is(err, model.ErrConflict) {
+			return totpEnabledError(), nil
+		}
+		return twoFactorError(err), nil
+	}
+
+	log.Printf("🎉 API: Пользователь %s включил 2FA", user.Login)
+	return &fileserverV1.RecoveryCodesResponse{
+		Data: fileserverV1.RecoveryCodesResponseData{
+			RecoveryCodes: codes,
+		},
+	}, nil
+}
+
+// twoFactorError - преобразование ошибки управления 2FA в ответ API
+func twoFactorError(err error) interface {
+	fileserverV1.ConfirmTotpRes
+	fileserverV1.DisableTotpRes
+} {
+	switch {
+	case errors.Is(err, model.ErrSecondFactorInvalid), errors.Is(err, model.ErrInvalidInput):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	case errors.Is(err, model.ErrSecondFactorRequired):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Двухфакторная аутентификация обязательна для вашей роли",
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось изменить настройки 2FA",
+			},
+		}
+	}
+}
+
+// totpEnabledError - ответ 409: двухфакторная аутентификация уже включена
+func totpEnabledError() *fileserverV1.ConflictError {
+	return &fileserverV1.ConflictError{
+		Error: fileserverV1.ConflictErrorError{
+			Code: 409,
+			Text: "🚨 Двухфакторная аутентификация уже включена",
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CreateApiKey - выпуск API-ключа текущего пользователя
+func (a *api) CreateApiKey(ctx context.Context, req *fileserverV1.CreateAPIKeyRequest, params fileserverV1.CreateApiKeyParams) (fileserverV1.CreateApiKeyRes, error) {
+	log.Printf("🔄 API: Выпуск API-ключа %s", req.Name)
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	spec := model.APIKey{
+		Name:         req.Name,
+		AllowedCIDRs: req.AllowedCidrs,
+	}
+	for _, scope := range req.Scopes {
+		spec.Scopes = append(spec.Scopes, model.Scope(scope))
+	}
+	if expires, ok := req.Expires.Get(); ok {
+		spec.ExpiresAt = &expires
+	}
+
+	created, err := a.service.CreateAPIKey(ctx, user.ID, spec)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка выпуска API-ключа пользователя %s: %v", user.Login, err)
+		switch {
+		case errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Разрешение admin доступно только администраторам",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidInput), errors.Is(err, model.ErrRequired):
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 %v", err),
+				},
+			}, nil
+		default:
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось выпустить API-ключ",
+				},
+			}, nil
+		}
+	}
+
+	log.Printf("🎉 API: Пользователь %s выпустил API-ключ %s", user.Login, created.APIKey.ID)
+	return &fileserverV1.CreateAPIKeyResponse{
+		Data: fileserverV1.CreateAPIKeyResponseData{
+			Key:    created.Key,
+			APIKey: apiKeyToDTO(created.APIKey),
+		},
+	}, nil
+}
@@ -2,11 +2,9 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
 	"log"
-	"os"
-	"path/filepath"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,6 +12,7 @@ import (
 	"encoding/json"
 
 	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
 
@@ -32,6 +31,9 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 			},
 		}, nil
 	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
 
 	// Валидация имени документа
 	if req.Meta.Name == "" {
@@ -43,46 +45,27 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 		}, nil
 	}
 
+	var metadata model.JSONData
+	if metaVal, ok := req.Meta.Metadata.Get(); ok {
+		if metadata, err = parseMetadata(metaVal); err != nil {
+			log.Printf("🚨 API: Ошибка парсинга метаданных: %v", err)
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 Ошибка парсинга метаданных: %v", err),
+				},
+			}, nil
+		}
+	}
+
 	docID := uuid.New().String()
 
-	// Создание файла
-	var filePath string
+	// Содержимое сначала записывается во временный объект и переносится под ключ SHA-256
+	// только внутри транзакции создания документа
+	var staged *storage.StagedBlob
 	if req.Meta.File {
-		if fileData, ok := req.File.Get(); ok {
-			storageDir := filepath.Join("bin", "storage")
-			if err := os.MkdirAll(storageDir, 0755); err != nil {
-				log.Printf("🚨 API: Ошибка создания директории: %v", err)
-				return &fileserverV1.InternalServerError{
-					Error: fileserverV1.InternalServerErrorError{
-						Code: 500,
-						Text: fmt.Sprintf("🚨 Ошибка создания директории: %v", err),
-					},
-				}, nil
-			}
-
-			filePath = filepath.Join(storageDir, docID)
-			dstFile, err := os.Create(filePath)
-			if err != nil {
-				log.Printf("🚨 API: Ошибка создания файла: %v", err)
-				return &fileserverV1.InternalServerError{
-					Error: fileserverV1.InternalServerErrorError{
-						Code: 500,
-						Text: fmt.Sprintf("🚨 Ошибка создания файла: %v", err),
-					},
-				}, nil
-			}
-			defer dstFile.Close()
-
-			if _, err := io.Copy(dstFile, fileData.File); err != nil {
-				log.Printf("🚨 API: Ошибка копирования файла: %v", err)
-				return &fileserverV1.InternalServerError{
-					Error: fileserverV1.InternalServerErrorError{
-						Code: 500,
-						Text: fmt.Sprintf("🚨 Ошибка копирования файла: %v", err),
-					},
-				}, nil
-			}
-		} else if req.Meta.File {
+		fileData, ok := req.File.Get()
+		if !ok {
 			log.Printf("🚨 API: Файл не найден")
 			return &fileserverV1.BadRequestError{
 				Error: fileserverV1.BadRequestErrorError{
@@ -91,6 +74,18 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 				},
 			}, nil
 		}
+
+		staged, err = storage.Stage(ctx, a.storage, fileData.File, fileData.Size, req.Meta.Mime)
+		if err != nil {
+			log.Printf("🚨 API: Ошибка сохранения файла: %v", err)
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: fmt.Sprintf("🚨 Ошибка сохранения файла: %v", err),
+				},
+			}, nil
+		}
+		defer a.discardStaged(ctx, staged)
 	}
 
 	doc := model.Document{
@@ -98,14 +93,20 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 		UserID:    user.ID,
 		Name:      req.Meta.Name,
 		MimeType:  req.Meta.Mime,
-		FilePath:  filePath,
 		IsFile:    req.Meta.File,
 		IsPublic:  req.Meta.Public,
 		JSONData:  nil,
 		Grants:    req.Meta.Grant,
+		FolderID:  req.Meta.Folder.Or(""),
+		Metadata:  metadata,
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
+	if staged != nil {
+		doc.FilePath = staged.Key
+		doc.Size = staged.Size
+		doc.Digest = staged.Digest
+	}
 
 	// Обрабатываем JSON данные (если есть)
 	if jsonVal, ok := req.JSON.Get(); ok {
@@ -127,18 +128,10 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 	}
 
 	// Сохраняем документ через сервис
-	createDoc, err := a.service.CreateDocument(ctx, doc)
+	createDoc, err := a.service.CreateDocument(ctx, doc, stagedCommit(staged))
 	if err != nil {
 		log.Printf("🚨 API: Ошибка создания документа: %v", err)
-		if filePath != "" {
-			os.Remove(filePath)
-		}
-		return &fileserverV1.BadRequestError{
-			Error: fileserverV1.BadRequestErrorError{
-				Code: 400,
-				Text: fmt.Sprintf("🚨 Ошибка парсинга JSON: %v", err),
-			},
-		}, nil
+		return createDocumentError(err), nil
 	}
 
 	// Формируем ответ
@@ -155,3 +148,43 @@ func (a *api) CreateDocument(ctx context.Context, req *fileserverV1.CreateDocume
 		Data: responseData,
 	}, nil
 }
+
+// createDocumentError - ответ на ошибку создания документа (общий для создания и завершения загрузки).
+// Текст ошибки отдается клиенту только для ошибок валидации; ошибки БД и хранилища скрываются за общим 500
+func createDocumentError(err error) interface {
+	fileserverV1.CreateDocumentRes
+	fileserverV1.FinalizeUploadRes
+} {
+	switch {
+	case errors.Is(err, model.ErrDocumentNameExists):
+		return &fileserverV1.ConflictError{
+			Error: fileserverV1.ConflictErrorError{
+				Code: 409,
+				Text: "🚨 Документ с таким именем уже есть в папке",
+			},
+		}
+	case errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Роль пользователя не позволяет создавать документы",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidInput), errors.Is(err, model.ErrRequired),
+		errors.Is(err, model.ErrDocumentNameEmpty), errors.Is(err, model.ErrDocumentNameTooLong),
+		errors.Is(err, model.ErrDocumentNoContent), errors.Is(err, model.ErrDocumentInvalidGrant):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Не удалось создать документ: %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось создать документ",
+			},
+		}
+	}
+}
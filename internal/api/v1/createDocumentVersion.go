@@ -0,0 +1,109 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// errVersionStorage - ошибка сохранения содержимого версии в хранилище
+var errVersionStorage = errors.New("failed to store version content")
+
+// CreateDocumentVersion - загрузка нового содержимого документа (новая версия)
+func (a *api) CreateDocumentVersion(ctx context.Context, req *fileserverV1.CreateVersionRequestMultipart, params fileserverV1.CreateDocumentVersionParams) (fileserverV1.CreateDocumentVersionRes, error) {
+	log.Printf("🔄 API: Загрузка новой версии документа %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.GetDocument(ctx, params.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения документа %s: %v", params.ID, err)
+		return updateError(err), nil
+	}
+
+	created, err := a.uploadVersion(ctx, doc, user, req)
+	if err != nil {
+		return updateError(err), nil
+	}
+
+	log.Printf("🎉 API: Версия %d документа %s создана", created.Version, doc.ID)
+	return &fileserverV1.DocumentVersionResponse{
+		Data: versionToDTO(created, created.Version),
+	}, nil
+}
+
+// uploadVersion - сохранение содержимого из multipart запроса в хранилище и создание новой версии
+func (a *api) uploadVersion(ctx context.Context, doc model.Document, user model.User, req *fileserverV1.CreateVersionRequestMultipart) (model.DocumentVersion, error) {
+	// Проверяем права до стейджинга, чтобы не писать в хранилище лишние файлы
+	if err := a.service.AuthorizeDocumentChange(ctx, user.ID, doc.ID); err != nil {
+		return model.DocumentVersion{}, err
+	}
+
+	version := model.DocumentVersion{
+		DocumentID:  doc.ID,
+		MimeType:    req.Mime.Or(doc.MimeType),
+		AuthorID:    user.ID,
+		AuthorLogin: user.Login,
+	}
+
+	var staged *storage.StagedBlob
+	if doc.IsFile {
+		fileData, ok := req.File.Get()
+		if !ok {
+			return model.DocumentVersion{}, fmt.Errorf("file is required for file documents: %w", model.ErrDocumentNoContent)
+		}
+
+		// Версии с одинаковым содержимым ссылаются на один неизменяемый объект
+		var err error
+		staged, err = storage.Stage(ctx, a.storage, fileData.File, fileData.Size, version.MimeType)
+		if err != nil {
+			log.Printf("🚨 API: Ошибка сохранения файла версии: %v", err)
+			return model.DocumentVersion{}, fmt.Errorf("%w: %v", errVersionStorage, err)
+		}
+		defer a.discardStaged(ctx, staged)
+
+		version.FilePath = staged.Key
+		version.Size = staged.Size
+		version.Digest = staged.Digest
+	} else {
+		jsonVal, ok := req.JSON.Get()
+		if !ok {
+			return model.DocumentVersion{}, fmt.Errorf("json is required for JSON documents: %w", model.ErrDocumentNoContent)
+		}
+
+		version.JSONData = make(model.JSONData, len(jsonVal))
+		for k, raw := range jsonVal {
+			var v any
+			if err := json.Unmarshal(raw, &v); err != nil {
+				return model.DocumentVersion{}, fmt.Errorf("invalid JSON: %w", model.ErrInvalidInput)
+			}
+			version.JSONData[k] = v
+		}
+	}
+
+	created, err := a.service.CreateDocumentVersion(ctx, version, stagedCommit(staged))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка создания версии документа %s: %v", doc.ID, err)
+		return model.DocumentVersion{}, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CreateFolder - создание папки в корне пользователя или внутри другой папки
+func (a *api) CreateFolder(ctx context.Context, req *fileserverV1.CreateFolderRequest, params fileserverV1.CreateFolderParams) (fileserverV1.CreateFolderRes, error) {
+	log.Printf("🔄 API: Создание папки %s", req.Name)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	folder, err := a.service.CreateFolder(ctx, user.ID, model.Folder{
+		Name:     req.Name,
+		ParentID: req.Parent.Or(""),
+		IsPublic: req.Public.Or(false),
+		Grants:   req.Grant,
+	})
+	if err != nil {
+		log.Printf("🚨 API: Ошибка создания папки %s: %v", req.Name, err)
+		return folderWriteError(err), nil
+	}
+
+	log.Printf("🎉 API: Папка %s создана с ID %s", folder.NamePath, folder.ID)
+	return &fileserverV1.FolderResponse{
+		Data: folderToDTO(folder),
+	}, nil
+}
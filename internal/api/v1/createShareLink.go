@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CreateShareLink - создание публичной ссылки на документ
+func (a *api) CreateShareLink(ctx context.Context, req *fileserverV1.CreateShareLinkRequest, params fileserverV1.CreateShareLinkParams) (fileserverV1.CreateShareLinkRes, error) {
+	log.Printf("🔄 API: Создание ссылки на документ %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	var spec model.ShareLinkSpec
+	spec.Password = req.Password.Or("")
+	if expires, ok := req.Expires.Get(); ok {
+		spec.ExpiresAt = &expires
+	}
+	if maxDownloads, ok := req.MaxDownloads.Get(); ok {
+		spec.MaxDownloads = &maxDownloads
+	}
+
+	link, err := a.service.CreateShareLink(ctx, params.ID, user.ID, spec)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка создания ссылки на документ %s: %v", params.ID, err)
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ не найден",
+				},
+			}, nil
+		case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на создание ссылки",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidInput):
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 %v", err),
+				},
+			}, nil
+		default:
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось создать ссылку",
+				},
+			}, nil
+		}
+	}
+
+	log.Printf("🎉 API: Пользователь %s создал ссылку %s на документ %s", user.Login, link.ID, params.ID)
+	return &fileserverV1.ShareLinkResponse{
+		Data: shareLinkToDTO(link),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// CreateUpload - создание сессии возобновляемой загрузки
+func (a *api) CreateUpload(ctx context.Context, req *fileserverV1.CreateUploadRequest, params fileserverV1.CreateUploadParams) (fileserverV1.CreateUploadRes, error) {
+	log.Printf("🔄 API: Создание сессии загрузки %s (%d байт)", req.Name, req.Size)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	session, err := a.service.CreateUploadSession(ctx, model.UploadSession{
+		UserID:   user.ID,
+		Name:     req.Name,
+		MimeType: req.Mime,
+		IsPublic: req.Public.Or(false),
+		Grants:   req.Grant,
+		Size:     req.Size,
+		FolderID: req.Folder.Or(""),
+	})
+	if err != nil {
+		log.Printf("🚨 API: Ошибка создания сессии загрузки: %v", err)
+		if errors.Is(err, model.ErrAccessDenied) {
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Роль пользователя не позволяет создавать документы",
+				},
+			}, nil
+		}
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Не удалось создать загрузку: %v", err),
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Сессия загрузки %s создана", session.ID)
+	return &fileserverV1.UploadResponseHeaders{
+		Location: fileserverV1.NewOptString("/api/uploads/" + session.ID),
+		Response: fileserverV1.UploadResponse{
+			Data: uploadToDTO(session),
+		},
+	}, nil
+}
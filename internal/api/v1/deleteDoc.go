@@ -2,14 +2,14 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"log"
-	"os"
-	"strings"
 
+	"github.com/NarthurN/FileServerService/internal/model"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
 
-// DeleteDocument - удаление документа
+// DeleteDocument - перемещение документа в корзину
 func (a *api) DeleteDocument(ctx context.Context, params fileserverV1.DeleteDocumentParams) (fileserverV1.DeleteDocumentRes, error) {
 	log.Printf("🔄 API: Удаление документа %s", params.ID)
 
@@ -23,46 +23,32 @@ func (a *api) DeleteDocument(ctx context.Context, params fileserverV1.DeleteDocu
 			},
 		}, nil
 	}
+	if !user.HasScope(model.ScopeDocsDelete) {
+		return scopeError(model.ScopeDocsDelete), nil
+	}
 
-	// Получаем документ для проверки прав
-	doc, err := a.service.GetDocument(ctx, params.ID)
+	// Перемещаем документ в корзину через сервис: права проверяет политика доступа сервисного слоя.
+	// Файл остается в хранилище до окончательного удаления документа из корзины
+	err = a.service.DeleteDocument(ctx, params.ID, user.ID)
 	if err != nil {
-		if strings.Contains(err.Error(), "not found") {
+		log.Printf("🚨 API: Ошибка удаления документа %s: %v", params.ID, err)
+		switch {
+		case errors.Is(err, model.ErrNotFound):
 			return &fileserverV1.NotFoundError{
 				Error: fileserverV1.NotFoundErrorError{
 					Code: 404,
 					Text: "🚨 Документ не найден",
 				},
 			}, nil
+		case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на удаление документа",
+				},
+			}, nil
 		}
 
-		return &fileserverV1.InternalServerError{
-			Error: fileserverV1.InternalServerErrorError{
-				Code: 500,
-				Text: "🚨 Не удалось получить документ",
-			},
-		}, nil
-	}
-
-	// Проверяем, что пользователь является владельцем
-	if doc.UserID != user.ID {
-		return &fileserverV1.ForbiddenError{
-			Error: fileserverV1.ForbiddenErrorError{
-				Code: 403,
-				Text: "🚨 Только владелец документа может удалить его",
-			},
-		}, nil
-	}
-
-	// Удаляем физический файл, если он есть
-	if doc.IsFile && doc.FilePath != "" {
-		if err := os.Remove(doc.FilePath); err != nil {
-			log.Printf("🚨 API: Предупреждение - не удалось удалить файл %s: %v", doc.FilePath, err)
-		}
-	}
-
-	// Удаляем документ через сервис
-	if err := a.service.DeleteDocument(ctx, params.ID); err != nil {
 		return &fileserverV1.InternalServerError{
 			Error: fileserverV1.InternalServerErrorError{
 				Code: 500,
@@ -71,7 +57,7 @@ func (a *api) DeleteDocument(ctx context.Context, params fileserverV1.DeleteDocu
 		}, nil
 	}
 
-	log.Printf("🎉 API: Документ %s успешно удален", params.ID)
+	log.Printf("🎉 API: Документ %s перемещен в корзину", params.ID)
 
 	// Формируем ответ согласно заданию
 	response := make(fileserverV1.DeleteDocumentResponseResponse)
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// DeleteFolder - удаление пустой папки или, с recursive, папки с вложенными папками (документы - в корзину)
+func (a *api) DeleteFolder(ctx context.Context, params fileserverV1.DeleteFolderParams) (fileserverV1.DeleteFolderRes, error) {
+	recursive := params.Recursive.Or(false)
+	log.Printf("🔄 API: Удаление папки %s (рекурсивно: %t)", params.ID, recursive)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsDelete) {
+		return scopeError(model.ScopeDocsDelete), nil
+	}
+
+	result, err := a.service.DeleteFolder(ctx, user.ID, params.ID, recursive)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка удаления папки %s: %v", params.ID, err)
+		return folderWriteError(err), nil
+	}
+
+	log.Printf("🎉 API: Папка %s удалена: папок %d, документов в корзину %d", params.ID, len(result.DeletedFolders), len(result.DeletedDocuments))
+	response := fileserverV1.DeleteFolderResponseResponse{
+		Folders: result.DeletedFolders,
+		Docs:    result.DeletedDocuments,
+	}
+	if response.Docs == nil {
+		response.Docs = []string{}
+	}
+	return &fileserverV1.DeleteFolderResponse{Response: response}, nil
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// DeleteSession - завершение одной из сессий текущего пользователя
+func (a *api) DeleteSession(ctx context.Context, params fileserverV1.DeleteSessionParams) (fileserverV1.DeleteSessionRes, error) {
+	log.Printf("🔄 API: Завершение сессии %s", params.SessionID)
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	if err := a.service.RevokeSession(ctx, user.ID, params.SessionID); err != nil {
+		log.Printf("🚨 API: Ошибка завершения сессии %s: %v", params.SessionID, err)
+		if errors.Is(err, model.ErrNotFound) {
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Сессия не найдена",
+				},
+			}, nil
+		}
+
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось завершить сессию",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Сессия %s завершена", params.SessionID)
+	return &fileserverV1.LogoutResponse{
+		Response: fileserverV1.LogoutResponseResponse{
+			params.SessionID: true,
+		},
+	}, nil
+}
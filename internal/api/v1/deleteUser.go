@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// DeleteUser - удаление пользователя администратором с удалением или передачей его документов
+func (a *api) DeleteUser(ctx context.Context, params fileserverV1.DeleteUserParams) (fileserverV1.DeleteUserRes, error) {
+	log.Printf("🔄 API: Удаление пользователя %s", params.UserID)
+
+	admin, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	deletion, err := a.service.DeleteUser(ctx, admin.ID, params.UserID, params.TransferTo.Or(""))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка удаления пользователя %s: %v", params.UserID, err)
+		if errors.Is(err, model.ErrConflict) {
+			return &fileserverV1.ConflictError{
+				Error: fileserverV1.ConflictErrorError{
+					Code: 409,
+					Text: "🚨 Имена передаваемых документов совпали с новыми документами получателя, повторите запрос",
+				},
+			}, nil
+		}
+		return userAdminError(err), nil
+	}
+
+	// Удаляем содержимое, на которое больше не ссылается ни один документ
+	a.service.DeleteReleasedObjects(ctx, deletion.Released, a.storage.Delete)
+
+	log.Printf("🎉 API: Пользователь %s удален", params.UserID)
+	return &fileserverV1.DeleteUserResponse{
+		Data: fileserverV1.DeleteUserResponseData{
+			ID:                   params.UserID,
+			DeletedDocuments:     len(deletion.DeletedDocuments),
+			TransferredDocuments: len(deletion.TransferredDocuments),
+		},
+	}, nil
+}
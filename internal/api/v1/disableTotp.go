@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// DisableTotp - отключение двухфакторной аутентификации
+func (a *api) DisableTotp(ctx context.Context, req *fileserverV1.TotpCodeRequest, params fileserverV1.DisableTotpParams) (fileserverV1.DisableTotpRes, error) {
+	log.Printf("🔄 API: Отключение 2FA")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	if err := a.service.DisableTOTP(ctx, user.ID, req.Code); err != nil {
+		log.Printf("🚨 API: Ошибка отключения 2FA пользователя %s: %v", user.Login, err)
+		return twoFactorError(err), nil
+	}
+
+	log.Printf("🎉 API: Пользователь %s отключил 2FA", user.Login)
+	user.TOTPEnabled = false
+	return &fileserverV1.UserResponse{
+		Data: userToDTO(user),
+	}, nil
+}
@@ -0,0 +1,148 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// AddDocumentTags - добавление тегов документу
+func (a *api) AddDocumentTags(ctx context.Context, req *fileserverV1.AddTagsRequest, params fileserverV1.AddDocumentTagsParams) (fileserverV1.AddDocumentTagsRes, error) {
+	log.Printf("🔄 API: Добавление тегов %v документу %s", req.Tags, params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.AddDocumentTags(ctx, params.ID, user.ID, req.Tags)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка добавления тегов документу %s: %v", params.ID, err)
+		return tagsError(err), nil
+	}
+
+	log.Printf("🎉 API: Теги документа %s: %v", params.ID, doc.Tags)
+	return &fileserverV1.UpdateDocumentResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
+
+// RemoveDocumentTag - снятие тега с документа
+func (a *api) RemoveDocumentTag(ctx context.Context, params fileserverV1.RemoveDocumentTagParams) (fileserverV1.RemoveDocumentTagRes, error) {
+	log.Printf("🔄 API: Снятие тега %s с документа %s", params.Tag, params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.RemoveDocumentTag(ctx, params.ID, user.ID, params.Tag)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка снятия тега %s с документа %s: %v", params.Tag, params.ID, err)
+		return tagsError(err), nil
+	}
+
+	log.Printf("🎉 API: Тег %s снят с документа %s", params.Tag, params.ID)
+	return &fileserverV1.UpdateDocumentResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
+
+// ListTags - теги пользователя с количеством документов
+func (a *api) ListTags(ctx context.Context, params fileserverV1.ListTagsParams) (fileserverV1.ListTagsRes, error) {
+	log.Printf("🔄 API: Получение тегов пользователя")
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	tags, err := a.service.ListTags(ctx, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения тегов пользователя %s: %v", user.Login, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить теги",
+			},
+		}, nil
+	}
+
+	tagDTOs := make([]fileserverV1.TagDto, 0, len(tags))
+	for _, tag := range tags {
+		tagDTOs = append(tagDTOs, fileserverV1.TagDto{Name: tag.Name, Count: tag.Count})
+	}
+
+	log.Printf("🎉 API: Найдено %d тегов пользователя %s", len(tagDTOs), user.Login)
+	return &fileserverV1.ListTagsResponse{
+		Data: fileserverV1.ListTagsResponseData{Tags: tagDTOs},
+	}, nil
+}
+
+// tagsError - ответ на ошибку изменения тегов документа (общий для добавления и снятия)
+func tagsError(err error) interface {
+	fileserverV1.AddDocumentTagsRes
+	fileserverV1.RemoveDocumentTagRes
+} {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Документ или тег не найден",
+			},
+		}
+	case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Нет прав на изменение документа",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidInput):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось изменить теги документа",
+			},
+		}
+	}
+}
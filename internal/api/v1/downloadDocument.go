@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+const (
+	docsPathPrefix   = "/api/docs/" // Путь операций getDocument/getDocumentHead
+	sharedPathPrefix = "/s/"        // Путь операции getSharedDocument
+
+	// sharePasswordHeader - заголовок с паролем публичной ссылки (в URL пароль попал бы в журналы и историю)
+	sharePasswordHeader = "X-Share-Password"
+)
+
+// FileDownloads - отдача содержимого файловых документов с поддержкой Range, ETag и условных запросов.
+// Сгенерированный ответ getDocument не позволяет выставить заголовки и код 206/304, поэтому
+// успешные GET/HEAD файловых документов (в том числе по публичной ссылке) обслуживаются здесь,
+// а все остальное (JSON документы, ошибки авторизации и доступа) передается в next - сгенерированный сервер.
+func (a *api) FileDownloads(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		served := false
+		if docID, ok := pathParam(r.URL.Path, docsPathPrefix); ok {
+			served = a.serveFile(w, r, docID)
+		} else if slug, ok := pathParam(r.URL.Path, sharedPathPrefix); ok {
+			served, r = a.serveSharedFile(w, r, slug)
+		}
+		if !served {
+			next.ServeHTTP(w, r)
+		}
+	})
+}
+
+// pathParam - единственный сегмент пути после prefix
+func pathParam(path, prefix string) (string, bool) {
+	if !strings.HasPrefix(path, prefix) {
+		return "", false
+	}
+	param := strings.TrimPrefix(path, prefix)
+	if param == "" || strings.Contains(param, "/") {
+		return "", false
+	}
+	return param, true
+}
+
+// serveFile - отдача файла документа; false - запрос должен обработать сгенерированный сервер
+func (a *api) serveFile(w http.ResponseWriter, r *http.Request, docID string) bool {
+	ctx := r.Context()
+	query := r.URL.Query()
+
+	user, err := a.validateToken(ctx, query.Get("token"))
+	if err != nil || !user.HasScope(model.ScopeDocsRead) {
+		return false
+	}
+
+	doc, err := a.service.GetDocument(ctx, docID)
+	if err != nil || !doc.IsFile {
+		return false
+	}
+
+	hasAccess, err := a.service.HasAccessToDocument(ctx, user.ID, docID)
+	if err != nil || !hasAccess {
+		return false
+	}
+
+	number := doc.Version
+	if raw := query.Get("version"); raw != "" {
+		if number, err = strconv.Atoi(raw); err != nil || number < 1 {
+			return false
+		}
+	}
+
+	version, err := a.service.GetDocumentVersion(ctx, docID, number)
+	if err != nil || version.FilePath == "" {
+		return false
+	}
+
+	return a.serveVersion(w, r, doc, version)
+}
+
+// serveSharedFile - отдача текущей версии файла по публичной ссылке. Скачиванием считается только GET
+// с полным содержимым (200), см. shareDownloadWriter. Отказ в открытии ссылки записывается здесь же,
+// чтобы пароль не проверялся повторно; false - запрос должен обработать сгенерированный сервер
+// с открытой ссылкой в контексте возвращенного запроса (до этого скачивание еще не учтено)
+func (a *api) serveSharedFile(w http.ResponseWriter, r *http.Request, slug string) (bool, *http.Request) {
+	ctx := r.Context()
+
+	shared, err := a.service.OpenSharedDocument(ctx, slug, r.Header.Get(sharePasswordHeader), clientInfo(ctx))
+	if err != nil {
+		log.Printf("🚨 API: Документ по ссылке не открыт: %v", err)
+		writeSharedDocumentError(w, err)
+		return true, r
+	}
+	r = r.WithContext(withSharedDocument(ctx, shared))
+	if !shared.Document.IsFile {
+		return false, r
+	}
+
+	version, err := a.service.GetDocumentVersion(ctx, shared.Document.ID, shared.Document.Version)
+	if err != nil || version.FilePath == "" {
+		return false, r
+	}
+
+	if r.Method == http.MethodGet {
+		w = &shareDownloadWriter{
+			ResponseWriter: w,
+			record: func() error {
+				_, err := a.service.RecordShareDownload(ctx, shared.Link)
+				return err
+			},
+		}
+	}
+	return a.serveVersion(w, r, shared.Document, version), r
+}
+
+// serveVersion - отдача содержимого версии файла; false (файл не открылся) - ответ еще не начат
+func (a *api) serveVersion(w http.ResponseWriter, r *http.Request, doc model.Document, version model.DocumentVersion) bool {
+	body, info, err := storage.OpenSeeker(r.Context(), a.storage, version.FilePath)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка чтения файла %s: %v", version.FilePath, err)
+		return false
+	}
+	defer body.Close()
+
+	contentType := version.MimeType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	// Содержимое версии неизменяемо, поэтому ID документа и номер версии - надежный ETag
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": doc.Name}))
+	w.Header().Set("ETag", fmt.Sprintf(`"%s-v%d"`, doc.ID, version.Version))
+	w.Header().Set("Cache-Control", "private, no-cache")
+	// Контрольная сумма содержимого для проверки целостности на клиенте (у старых файлов ее нет)
+	if version.Digest != "" {
+		w.Header().Set("Digest", storage.DigestHeader(version.Digest))
+	}
+
+	modTime := version.CreatedAt
+	if modTime.IsZero() {
+		modTime = info.ModTime
+	}
+
+	log.Printf("🎉 API: Отдача файла %s (версия %d, %s %s)", doc.ID, version.Version, r.Method, r.Header.Get("Range"))
+	// ServeContent обрабатывает Range (в том числе несколько диапазонов), If-None-Match,
+	// If-Modified-Since, If-Range и HEAD, а также выставляет Content-Length и Last-Modified
+	http.ServeContent(w, r, doc.Name, modTime, body)
+	return true
+}
+
+// shareDownloadWriter - учет скачивания по публичной ссылке в момент, когда ServeContent отвечает 200
+// с полным содержимым, то есть уже после проверки условных запросов и Range: ответы 206, 304 и 416
+// не расходуют лимит. Если учесть скачивание не удалось (лимит исчерпан параллельным запросом),
+// вместо файла отдается ошибка, а тело ServeContent отбрасывается
+type shareDownloadWriter struct {
+	http.ResponseWriter
+	record      func() error
+	wroteHeader bool
+	discard     bool
+}
+
+func (w *shareDownloadWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+
+	if code == http.StatusOK {
+		if err := w.record(); err != nil {
+			log.Printf("🚨 API: Скачивание по ссылке не учтено: %v", err)
+			w.discard = true
+			writeSharedDocumentError(w.ResponseWriter, err)
+			return
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *shareDownloadWriter) Write(p []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.discard {
+		return len(p), nil
+	}
+	return w.ResponseWriter.Write(p)
+}
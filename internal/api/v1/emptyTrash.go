@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// EmptyTrash - окончательное удаление всех документов из корзины пользователя
+func (a *api) EmptyTrash(ctx context.Context, params fileserverV1.EmptyTrashParams) (fileserverV1.EmptyTrashRes, error) {
+	log.Printf("🔄 API: Очистка корзины")
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsDelete) {
+		return scopeError(model.ScopeDocsDelete), nil
+	}
+
+	result, err := a.service.EmptyTrash(ctx, user.ID)
+
+	// Файлы уже удаленных документов удаляются, даже если очистка прервалась на ошибке
+	a.service.DeleteReleasedObjects(ctx, result.Released, a.storage.Delete)
+
+	if err != nil {
+		log.Printf("🚨 API: Ошибка очистки корзины пользователя %s: %v", user.Login, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось очистить корзину",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Корзина пользователя %s очищена, удалено документов: %d", user.Login, len(result.PurgedDocuments))
+	response := fileserverV1.EmptyTrashResponseResponse{Docs: result.PurgedDocuments}
+	if response.Docs == nil {
+		response.Docs = []string{}
+	}
+	return &fileserverV1.EmptyTrashResponse{Response: response}, nil
+}
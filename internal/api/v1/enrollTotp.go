@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// EnrollTotp - выпуск секрета TOTP для подключения двухфакторной аутентификации
+func (a *api) EnrollTotp(ctx context.Context, params fileserverV1.EnrollTotpParams) (fileserverV1.EnrollTotpRes, error) {
+	log.Printf("🔄 API: Подключение 2FA")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	enrollment, err := a.service.EnrollTOTP(ctx, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка подключения 2FA пользователя %s: %v", user.Login, err)
+		if errors.Is(err, model.ErrConflict) {
+			return totpEnabledError(), nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось подключить 2FA",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Секрет TOTP выпущен пользователю %s", user.Login)
+	return &fileserverV1.TotpEnrollmentResponse{
+		Data: fileserverV1.TotpEnrollmentResponseData{
+			Secret: enrollment.Secret,
+			URI:    enrollment.URI,
+		},
+	}, nil
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// FinalizeUpload - сборка принятых фрагментов в файл и создание документа
+func (a *api) FinalizeUpload(ctx context.Context, params fileserverV1.FinalizeUploadParams) (fileserverV1.FinalizeUploadRes, error) {
+	log.Printf("🔄 API: Завершение загрузки %s", params.UploadID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	session, err := a.service.GetUploadSession(ctx, params.UploadID, user.ID)
+	if err != nil {
+		return uploadSessionError(err), nil
+	}
+
+	if !session.IsComplete() {
+		return &fileserverV1.ConflictError{
+			Error: fileserverV1.ConflictErrorError{
+				Code: 409,
+				Text: fmt.Sprintf("🚨 Файл загружен не полностью: %d из %d байт", session.Offset, session.Size),
+			},
+		}, nil
+	}
+
+	// Собираем фрагменты во временный объект, под ключ SHA-256 он переносится в транзакции создания документа
+	docID := uuid.New().String()
+	reader := newChunkReader(ctx, a.storage, session.Chunks)
+	staged, err := storage.Stage(ctx, a.storage, reader, session.Size, session.MimeType)
+	reader.Close()
+	if err != nil {
+		log.Printf("🚨 API: Ошибка сборки файла загрузки %s: %v", session.ID, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось собрать файл из фрагментов",
+			},
+		}, nil
+	}
+	defer a.discardStaged(ctx, staged)
+
+	now := time.Now().UTC()
+	doc, err := a.service.CreateDocument(ctx, model.Document{
+		ID:        docID,
+		UserID:    user.ID,
+		Name:      session.Name,
+		MimeType:  session.MimeType,
+		FilePath:  staged.Key,
+		IsFile:    true,
+		IsPublic:  session.IsPublic,
+		Grants:    session.Grants,
+		FolderID:  session.FolderID,
+		Size:      staged.Size,
+		Digest:    staged.Digest,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, staged.Commit)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка создания документа из загрузки %s: %v", session.ID, err)
+		return createDocumentError(err), nil
+	}
+
+	// Документ создан - сессия и фрагменты больше не нужны
+	if err := a.service.DeleteUploadSession(ctx, session.ID); err != nil {
+		log.Printf("🚨 API: Предупреждение - не удалось удалить сессию загрузки %s: %v", session.ID, err)
+	}
+	a.deleteUploadData(ctx, session.ID)
+
+	log.Printf("🎉 API: Загрузка %s завершена, создан документ %s", session.ID, doc.ID)
+	return &fileserverV1.FinalizeUploadResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
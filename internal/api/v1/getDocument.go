@@ -3,10 +3,11 @@ package v1
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
-	"os"
 	"strings"
 
+	"github.com/NarthurN/FileServerService/internal/model"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 	"github.com/go-faster/jx"
 )
@@ -25,6 +26,9 @@ func (a *api) GetDocument(ctx context.Context, params fileserverV1.GetDocumentPa
 			},
 		}, nil
 	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
 
 	// Получаем документ
 	doc, err := a.service.GetDocument(ctx, params.ID)
@@ -66,10 +70,37 @@ func (a *api) GetDocument(ctx context.Context, params fileserverV1.GetDocumentPa
 		}, nil
 	}
 
+	// Запрошена конкретная версия - подменяем содержимое документа содержимым версии
+	if number, ok := params.Version.Get(); ok {
+		version, err := a.service.GetDocumentVersion(ctx, params.ID, number)
+		if err != nil {
+			if strings.Contains(err.Error(), "not found") {
+				return &fileserverV1.NotFoundError{
+					Error: fileserverV1.NotFoundErrorError{
+						Code: 404,
+						Text: fmt.Sprintf("🚨 Версия %d не найдена", number),
+					},
+				}, nil
+			}
+
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось получить версию документа",
+				},
+			}, nil
+		}
+
+		doc.FilePath = version.FilePath
+		doc.MimeType = version.MimeType
+		doc.JSONData = version.JSONData
+	}
+
 	// Если это файл - возвращаем файл
 	if doc.IsFile && doc.FilePath != "" {
-		file, err := os.Open(doc.FilePath)
+		file, _, err := a.storage.Get(ctx, doc.FilePath)
 		if err != nil {
+			log.Printf("🚨 API: Ошибка чтения файла %s: %v", doc.FilePath, err)
 			return &fileserverV1.InternalServerError{
 				Error: fileserverV1.InternalServerErrorError{
 					Code: 500,
@@ -115,6 +146,9 @@ func (a *api) GetDocumentHead(ctx context.Context, params fileserverV1.GetDocume
 	if err != nil {
 		return &fileserverV1.GetDocumentHeadUnauthorized{}, nil
 	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return &fileserverV1.GetDocumentHeadForbidden{}, nil
+	}
 
 	// Проверяем существование и права доступа
 	hasAccess, err := a.service.HasAccessToDocument(ctx, user.ID, params.ID)
@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// GetFolder - получение папки с путем из имен
+func (a *api) GetFolder(ctx context.Context, params fileserverV1.GetFolderParams) (fileserverV1.GetFolderRes, error) {
+	log.Printf("🔄 API: Получение папки %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	folder, err := a.service.GetFolder(ctx, user.ID, params.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения папки %s: %v", params.ID, err)
+		return folderReadError(err), nil
+	}
+
+	log.Printf("🎉 API: Папка %s получена", folder.NamePath)
+	return &fileserverV1.FolderResponse{
+		Data: folderToDTO(folder),
+	}, nil
+}
+
+// folderToDTO - конвертация папки в DTO ответа
+func folderToDTO(folder model.Folder) fileserverV1.FolderDto {
+	dto := fileserverV1.FolderDto{
+		ID:      folder.ID,
+		Name:    folder.Name,
+		Path:    folder.NamePath,
+		Public:  folder.IsPublic,
+		Grant:   folder.Grants,
+		Created: folder.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if dto.Grant == nil {
+		dto.Grant = []string{}
+	}
+	if folder.ParentID != "" {
+		dto.Parent = fileserverV1.NewOptString(folder.ParentID)
+	}
+	return dto
+}
+
+// folderReadError - ответ на ошибку чтения папки (общий для получения папки и ее содержимого)
+func folderReadError(err error) interface {
+	fileserverV1.GetFolderRes
+	fileserverV1.ListFolderRes
+} {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Папка не найдена",
+			},
+		}
+	case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Нет доступа к папке",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidInput):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить папку",
+			},
+		}
+	}
+}
+
+// folderWriteError - ответ на ошибку создания, изменения или удаления папки
+func folderWriteError(err error) interface {
+	fileserverV1.CreateFolderRes
+	fileserverV1.UpdateFolderRes
+	fileserverV1.DeleteFolderRes
+} {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Папка не найдена",
+			},
+		}
+	case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Нет прав на папку",
+			},
+		}
+	case errors.Is(err, model.ErrConflict):
+		return &fileserverV1.ConflictError{
+			Error: fileserverV1.ConflictErrorError{
+				Code: 409,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Не удалось изменить папку: %v", err),
+			},
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+	"github.com/go-faster/jx"
+)
+
+// GetSharedDocument - документ по публичной ссылке без авторизации. Файлы обычно отдает
+// FileDownloads; сюда попадают JSON документы, отказы и файлы, которые FileDownloads не смог открыть
+// (в этом случае скачивание им еще не учтено)
+func (a *api) GetSharedDocument(ctx context.Context, params fileserverV1.GetSharedDocumentParams) (fileserverV1.GetSharedDocumentRes, error) {
+	log.Printf("🔄 API: Открытие документа по ссылке")
+
+	// Ссылку, уже открытую FileDownloads, повторно не открываем: проверка пароля учитывается один раз
+	shared, ok := sharedDocumentFromContext(ctx)
+	if !ok || shared.Link.Slug != params.Slug {
+		var err error
+		shared, err = a.service.OpenSharedDocument(ctx, params.Slug, params.XSharePassword.Or(""), clientInfo(ctx))
+		if err != nil {
+			log.Printf("🚨 API: Документ по ссылке не открыт: %v", err)
+			return sharedDocumentError(err), nil
+		}
+	}
+
+	doc := shared.Document
+	if doc.IsFile && doc.FilePath != "" {
+		file, _, err := a.storage.Get(ctx, doc.FilePath)
+		if err != nil {
+			log.Printf("🚨 API: Ошибка чтения файла %s: %v", doc.FilePath, err)
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось открыть файл",
+				},
+			}, nil
+		}
+		// Скачивание учитывается, только когда содержимое готово к отдаче
+		if err := a.recordShareDownload(ctx, shared.Link); err != nil {
+			file.Close()
+			return sharedDocumentError(err), nil
+		}
+
+		log.Printf("🎉 API: Файл %s отдан по ссылке %s", doc.ID, shared.Link.ID)
+		return &fileserverV1.GetSharedDocumentOKApplicationOctetStream{
+			Data: file,
+		}, nil
+	}
+
+	if doc.JSONData != nil {
+		if err := a.recordShareDownload(ctx, shared.Link); err != nil {
+			return sharedDocumentError(err), nil
+		}
+
+		jsonData := make(map[string]jx.Raw)
+		for k, v := range doc.JSONData {
+			rawData, _ := json.Marshal(v)
+			jsonData[k] = jx.Raw(rawData)
+		}
+
+		log.Printf("🎉 API: Документ %s отдан по ссылке %s", doc.ID, shared.Link.ID)
+		return &fileserverV1.GetDocumentResponse{
+			Data: jsonData,
+		}, nil
+	}
+
+	return &fileserverV1.NotFoundError{
+		Error: fileserverV1.NotFoundErrorError{
+			Code: 404,
+			Text: "🚨 Документ не содержит данных",
+		},
+	}, nil
+}
+
+type sharedDocumentKey struct{}
+
+// withSharedDocument - контекст запроса с документом, открытым по публичной ссылке
+func withSharedDocument(ctx context.Context, shared model.SharedDocument) context.Context {
+	return context.WithValue(ctx, sharedDocumentKey{}, shared)
+}
+
+// sharedDocumentFromContext - документ, открытый по публичной ссылке в FileDownloads
+func sharedDocumentFromContext(ctx context.Context) (model.SharedDocument, bool) {
+	shared, ok := ctx.Value(sharedDocumentKey{}).(model.SharedDocument)
+	return shared, ok
+}
+
+// recordShareDownload - учет скачивания по ссылке; лимит мог исчерпаться параллельным запросом
+func (a *api) recordShareDownload(ctx context.Context, link model.ShareLink) error {
+	if _, err := a.service.RecordShareDownload(ctx, link); err != nil {
+		log.Printf("🚨 API: Ошибка учета скачивания по ссылке %s: %v", link.ID, err)
+		return err
+	}
+	return nil
+}
+
+// writeSharedDocumentError - запись ответа об отказе напрямую, когда ответ формирует FileDownloads
+func writeSharedDocumentError(w http.ResponseWriter, err error) {
+	res := sharedDocumentError(err)
+	status := http.StatusInternalServerError
+	var payload any = res
+	var retryAfter string
+	switch r := res.(type) {
+	case *fileserverV1.TooManyRequestsErrorHeaders:
+		status = http.StatusTooManyRequests
+		payload = r.Response
+		retryAfter = strconv.Itoa(r.RetryAfter)
+	case *fileserverV1.UnauthorizedError:
+		status = http.StatusUnauthorized
+	case *fileserverV1.ForbiddenError:
+		status = http.StatusForbidden
+	case *fileserverV1.NotFoundError:
+		status = http.StatusNotFound
+	}
+
+	body, marshalErr := json.Marshal(payload)
+	if marshalErr != nil {
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	// Заголовки файла, выставленные до отказа, к ответу об ошибке не относятся
+	header := w.Header()
+	for key := range header {
+		header.Del(key)
+	}
+	header.Set("Content-Type", "application/json; charset=utf-8")
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	w.WriteHeader(status)
+	w.Write(body)
+}
+
+// sharedDocumentError - ответ на отказ в открытии документа по ссылке
+func sharedDocumentError(err error) fileserverV1.GetSharedDocumentRes {
+	var throttle model.ThrottleError
+	switch {
+	case errors.As(err, &throttle):
+		res := throttleError(throttle)
+		res.Response.Error.Text = "🚨 Слишком много попыток ввода пароля ссылки, повторите позже"
+		return res
+	case errors.Is(err, model.ErrSharePasswordWrong):
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный пароль ссылки",
+			},
+		}
+	case errors.Is(err, model.ErrShareLinkExpired):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Срок действия ссылки истек или лимит скачиваний исчерпан",
+			},
+		}
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Ссылка не найдена",
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось открыть документ по ссылке",
+			},
+		}
+	}
+}
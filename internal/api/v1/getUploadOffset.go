@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// GetUploadOffset - HEAD запрос текущего смещения загрузки для ее возобновления
+func (a *api) GetUploadOffset(ctx context.Context, params fileserverV1.GetUploadOffsetParams) (fileserverV1.GetUploadOffsetRes, error) {
+	log.Printf("🔄 API: HEAD запрос для загрузки %s", params.UploadID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.GetUploadOffsetUnauthorized{}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return &fileserverV1.GetUploadOffsetForbidden{}, nil
+	}
+
+	session, err := a.service.GetUploadSession(ctx, params.UploadID, user.ID)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.GetUploadOffsetNotFound{}, nil
+		case errors.Is(err, model.ErrOwnershipRequired):
+			return &fileserverV1.GetUploadOffsetForbidden{}, nil
+		default:
+			return &fileserverV1.GetUploadOffsetInternalServerError{}, nil
+		}
+	}
+
+	log.Printf("🎉 API: Загрузка %s: принято %d из %d байт", session.ID, session.Offset, session.Size)
+	return &fileserverV1.GetUploadOffsetOK{
+		CacheControl: "no-store",
+		UploadLength: session.Size,
+		UploadOffset: session.Offset,
+	}, nil
+}
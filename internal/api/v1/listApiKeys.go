@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListApiKeys - API-ключи текущего пользователя
+func (a *api) ListApiKeys(ctx context.Context, params fileserverV1.ListApiKeysParams) (fileserverV1.ListApiKeysRes, error) {
+	log.Printf("🔄 API: Получение списка API-ключей")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	keys, err := a.service.ListAPIKeys(ctx, user.ID)
+	if err != nil {
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить список API-ключей",
+			},
+		}, nil
+	}
+
+	keyDTOs := make([]fileserverV1.APIKeyDto, 0, len(keys))
+	for _, key := range keys {
+		keyDTOs = append(keyDTOs, apiKeyToDTO(key))
+	}
+
+	log.Printf("🎉 API: Найдено %d API-ключей пользователя %s", len(keyDTOs), user.Login)
+	return &fileserverV1.ListAPIKeysResponse{
+		Data: fileserverV1.ListAPIKeysResponseData{
+			Keys: keyDTOs,
+		},
+	}, nil
+}
+
+// apiKeyToDTO - преобразование API-ключа в DTO
+func apiKeyToDTO(key model.APIKey) fileserverV1.APIKeyDto {
+	scopes := make([]fileserverV1.APIKeyDtoScopesItem, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, fileserverV1.APIKeyDtoScopesItem(scope))
+	}
+	cidrs := key.AllowedCIDRs
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+
+	dto := fileserverV1.APIKeyDto{
+		ID:           key.ID,
+		Name:         key.Name,
+		Prefix:       key.KeyPrefix,
+		Scopes:       scopes,
+		AllowedCidrs: cidrs,
+		Created:      key.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if key.ExpiresAt != nil {
+		dto.Expires = fileserverV1.NewOptString(key.ExpiresAt.Format("2006-01-02 15:04:05"))
+	}
+	if key.LastUsedAt != nil {
+		dto.LastUsed = fileserverV1.NewOptString(key.LastUsedAt.Format("2006-01-02 15:04:05"))
+	}
+	if key.LastUsedIP != "" {
+		dto.LastUsedIP = fileserverV1.NewOptString(key.LastUsedIP)
+	}
+	return dto
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListDocumentVersions - история версий документа
+func (a *api) ListDocumentVersions(ctx context.Context, params fileserverV1.ListDocumentVersionsParams) (fileserverV1.ListDocumentVersionsRes, error) {
+	log.Printf("🔄 API: Получение истории версий документа %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	doc, err := a.service.GetDocument(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ не найден",
+				},
+			}, nil
+		}
+
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить документ",
+			},
+		}, nil
+	}
+
+	// История доступна всем, кто может читать документ
+	hasAccess, err := a.service.HasAccessToDocument(ctx, user.ID, params.ID)
+	if err != nil {
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось проверить права доступа",
+			},
+		}, nil
+	}
+	if !hasAccess {
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Доступ запрещен",
+			},
+		}, nil
+	}
+
+	versions, err := a.service.GetDocumentVersions(ctx, params.ID)
+	if err != nil {
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить историю версий",
+			},
+		}, nil
+	}
+
+	versionDTOs := make([]fileserverV1.DocumentVersionDto, 0, len(versions))
+	for _, v := range versions {
+		versionDTOs = append(versionDTOs, versionToDTO(v, doc.Version))
+	}
+
+	log.Printf("🎉 API: Найдено %d версий документа %s", len(versionDTOs), params.ID)
+	return &fileserverV1.ListVersionsResponse{
+		Data: fileserverV1.ListVersionsResponseData{
+			Versions: versionDTOs,
+		},
+	}, nil
+}
+
+// versionToDTO - конвертация версии документа в DTO ответа
+func versionToDTO(v model.DocumentVersion, currentVersion int) fileserverV1.DocumentVersionDto {
+	dto := fileserverV1.DocumentVersionDto{
+		Version: v.Version,
+		Size:    v.Size,
+		Mime:    v.MimeType,
+		Author:  v.AuthorLogin,
+		Created: v.CreatedAt.Format("2006-01-02 15:04:05"),
+		Current: v.Version == currentVersion,
+	}
+	if v.Digest != "" {
+		dto.Digest = fileserverV1.NewOptString(v.Digest)
+	}
+	return dto
+}
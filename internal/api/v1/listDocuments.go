@@ -2,8 +2,13 @@ package v1
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
 
+	"github.com/go-faster/jx"
+
 	"github.com/NarthurN/FileServerService/internal/model"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
@@ -22,9 +27,38 @@ func (a *api) ListDocuments(ctx context.Context, params fileserverV1.ListDocumen
 			},
 		}, nil
 	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	sort, err := model.ParseDocumentSort(params.Sort.Or(""))
+	if err != nil {
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: "🚨 Некорректная сортировка",
+			},
+		}, nil
+	}
+	filters, err := documentFilters(params)
+	if err != nil {
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}, nil
+	}
+	query := model.DocumentQuery{
+		Filters:   filters,
+		Sort:      sort,
+		Cursor:    params.Cursor.Or(""),
+		Limit:     params.Limit.Or(0),
+		WithTotal: params.Total.Or(false),
+	}
 
 	// Определяем, чьи документы получать
-	var docs []model.Document
+	var page model.DocumentPage
 	if loginParam, ok := params.Login.Get(); ok && loginParam != "" {
 		// Получаем документы другого пользователя (только те, к которым есть доступ)
 		targetUser, err := a.getUserByLogin(ctx, loginParam)
@@ -37,74 +71,134 @@ func (a *api) ListDocuments(ctx context.Context, params fileserverV1.ListDocumen
 			}, nil
 		}
 
-		docs, err = a.service.GetDocumentsForUser(ctx, user.ID, targetUser.ID)
-		if err != nil {
-			return &fileserverV1.InternalServerError{
-				Error: fileserverV1.InternalServerErrorError{
-					Code: 500,
-					Text: "🚨 Не удалось получить документы",
-				},
-			}, nil
-		}
+		page, err = a.service.GetDocumentsForUser(ctx, user.ID, targetUser.ID, query)
 	} else {
 		// Получаем собственные документы
-		docs, err = a.service.GetListDocuments(ctx, user.ID)
-		if err != nil {
-			return &fileserverV1.InternalServerError{
-				Error: fileserverV1.InternalServerErrorError{
-					Code: 500,
-					Text: "🚨 Не удалось получить документы",
+		page, err = a.service.GetListDocuments(ctx, user.ID, query)
+	}
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения списка документов: %v", err)
+		if errors.Is(err, model.ErrInvalidInput) {
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 %v", err),
 				},
 			}, nil
 		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить документы",
+			},
+		}, nil
 	}
 
-	// Применяем фильтрацию если указана
-	if keyParam, keyOk := params.Key.Get(); keyOk {
-		if valueParam, valueOk := params.Value.Get(); valueOk && valueParam != "" {
-			docs = a.filterDocuments(docs, string(keyParam), valueParam)
-		}
+	// Конвертируем в DTO для ответа
+	docDTOs := make([]fileserverV1.DocumentDto, 0, len(page.Docs))
+	for _, doc := range page.Docs {
+		docDTOs = append(docDTOs, documentToDTO(doc))
 	}
 
-	// Применяем лимит если указан
-	if limitParam, ok := params.Limit.Get(); ok && limitParam > 0 {
-		if len(docs) > limitParam {
-			docs = docs[:limitParam]
-		}
+	log.Printf("🎉 API: Найдено %d документов", len(docDTOs))
+
+	data := fileserverV1.ListDocumentsResponseData{
+		Docs:  docDTOs,
+		Limit: page.Limit,
 	}
+	if page.NextCursor != "" {
+		data.NextCursor = fileserverV1.NewOptString(page.NextCursor)
+	}
+	if page.Total != nil {
+		data.Total = fileserverV1.NewOptInt(*page.Total)
+	}
+	return &fileserverV1.ListDocumentsResponse{Data: data}, nil
+}
 
-	// Конвертируем в DTO для ответа
-	docDTOs := make([]fileserverV1.DocumentDto, 0, len(docs))
-	for _, doc := range docs {
-		dto := fileserverV1.DocumentDto{
-			ID:      doc.ID,
-			Name:    doc.Name,
-			Mime:    doc.MimeType,
-			File:    doc.IsFile,
-			Public:  doc.IsPublic,
-			Created: doc.CreatedAt.Format("2006-01-02 15:04:05"),
-			Grant:   doc.Grants,
+// documentFilters - разбор условий filter и устаревшей пары key/value в условия фильтра
+func documentFilters(params fileserverV1.ListDocumentsParams) ([]model.DocumentCondition, error) {
+	raw := params.Filter
+	if key, ok := params.Key.Get(); ok {
+		if value, ok := params.Value.Get(); ok && value != "" {
+			raw = append([]string{string(key) + "=" + value}, raw...)
 		}
-		docDTOs = append(docDTOs, dto)
 	}
+	return parseDocumentFilters(raw)
+}
 
-	log.Printf("🎉 API: Найдено %d документов", len(docDTOs))
-
-	return &fileserverV1.ListDocumentsResponse{
-		Data: fileserverV1.ListDocumentsResponseData{
-			Docs: docDTOs,
-		},
-	}, nil
+// parseDocumentFilters - разбор условий параметра filter
+func parseDocumentFilters(raw []string) ([]model.DocumentCondition, error) {
+	filters := make([]model.DocumentCondition, 0, len(raw))
+	for _, item := range raw {
+		cond, err := model.ParseDocumentFilter(item)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, cond)
+	}
+	return filters, nil
 }
 
 // ListDocumentsHead - HEAD запрос для списка документов
 func (a *api) ListDocumentsHead(ctx context.Context, params fileserverV1.ListDocumentsHeadParams) (fileserverV1.ListDocumentsHeadRes, error) {
 	// Валидация токена
-	_, err := a.validateToken(ctx, params.Token)
+	user, err := a.validateToken(ctx, params.Token)
 	if err != nil {
 		return &fileserverV1.ListDocumentsHeadUnauthorized{}, nil
 	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return &fileserverV1.ListDocumentsHeadForbidden{}, nil
+	}
 
 	// HEAD запрос не должен возвращать данные согласно заданию
 	return &fileserverV1.ListDocumentsHeadOK{}, nil
 }
+
+// documentToDTO - конвертация документа в DTO ответа
+func documentToDTO(doc model.Document) fileserverV1.DocumentDto {
+	dto := fileserverV1.DocumentDto{
+		ID:      doc.ID,
+		Name:    doc.Name,
+		Mime:    doc.MimeType,
+		File:    doc.IsFile,
+		Public:  doc.IsPublic,
+		Created: doc.CreatedAt.Format("2006-01-02 15:04:05"),
+		Grant:   doc.Grants,
+		Tags:    doc.Tags,
+		Version: fileserverV1.NewOptInt(doc.Version),
+	}
+	if doc.IsFile {
+		dto.Size = fileserverV1.NewOptInt64(doc.Size)
+	}
+	if doc.Digest != "" {
+		dto.Digest = fileserverV1.NewOptString(doc.Digest)
+	}
+	if doc.FolderID != "" {
+		dto.Folder = fileserverV1.NewOptString(doc.FolderID)
+	}
+	if len(doc.Metadata) > 0 {
+		metadata := make(fileserverV1.DocumentDtoMetadata, len(doc.Metadata))
+		for k, v := range doc.Metadata {
+			rawData, _ := json.Marshal(v)
+			metadata[k] = jx.Raw(rawData)
+		}
+		dto.Metadata = fileserverV1.NewOptDocumentDtoMetadata(metadata)
+	}
+	if doc.DeletedAt != nil {
+		dto.Deleted = fileserverV1.NewOptString(doc.DeletedAt.Format("2006-01-02 15:04:05"))
+	}
+	return dto
+}
+
+// parseMetadata - разбор метаданных из запроса (null сохраняется как nil)
+func parseMetadata(raw map[string]jx.Raw) (model.JSONData, error) {
+	metadata := make(model.JSONData, len(raw))
+	for k, value := range raw {
+		var v any
+		if err := json.Unmarshal(value, &v); err != nil {
+			return nil, err
+		}
+		metadata[k] = v
+	}
+	return metadata, nil
+}
@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListFolder - вложенные папки и страница документов папки (без parent - корень пользователя)
+func (a *api) ListFolder(ctx context.Context, params fileserverV1.ListFolderParams) (fileserverV1.ListFolderRes, error) {
+	parentID := params.Parent.Or("")
+	log.Printf("🔄 API: Получение содержимого папки %q", parentID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	sort, err := model.ParseDocumentSort(params.Sort.Or(""))
+	if err != nil {
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: "🚨 Некорректная сортировка",
+			},
+		}, nil
+	}
+
+	listing, err := a.service.ListFolder(ctx, user.ID, parentID, model.DocumentQuery{
+		Sort:   sort,
+		Cursor: params.Cursor.Or(""),
+		Limit:  params.Limit.Or(0),
+	})
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения содержимого папки %q: %v", parentID, err)
+		return folderReadError(err), nil
+	}
+
+	folderDTOs := make([]fileserverV1.FolderDto, 0, len(listing.Folders))
+	for _, folder := range listing.Folders {
+		folderDTOs = append(folderDTOs, folderToDTO(folder))
+	}
+	docDTOs := make([]fileserverV1.DocumentDto, 0, len(listing.Docs.Docs))
+	for _, doc := range listing.Docs.Docs {
+		docDTOs = append(docDTOs, documentToDTO(doc))
+	}
+
+	log.Printf("🎉 API: В папке %q найдено папок %d, документов %d", parentID, len(folderDTOs), len(docDTOs))
+
+	data := fileserverV1.FolderListingResponseData{
+		Folders: folderDTOs,
+		Docs:    docDTOs,
+		Limit:   listing.Docs.Limit,
+	}
+	if listing.Folder != nil {
+		data.Folder = fileserverV1.NewOptFolderDto(folderToDTO(*listing.Folder))
+	}
+	if listing.Docs.NextCursor != "" {
+		data.NextCursor = fileserverV1.NewOptString(listing.Docs.NextCursor)
+	}
+	return &fileserverV1.FolderListingResponse{Data: data}, nil
+}
@@ -0,0 +1,61 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListSessions - активные сессии текущего пользователя
+func (a *api) ListSessions(ctx context.Context, params fileserverV1.ListSessionsParams) (fileserverV1.ListSessionsRes, error) {
+	log.Printf("🔄 API: Получение списка сессий")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	sessions, err := a.service.ListSessions(ctx, user.ID, params.Token)
+	if err != nil {
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить список сессий",
+			},
+		}, nil
+	}
+
+	sessionDTOs := make([]fileserverV1.SessionDto, 0, len(sessions))
+	for _, session := range sessions {
+		sessionDTOs = append(sessionDTOs, sessionToDTO(session))
+	}
+
+	log.Printf("🎉 API: Найдено %d сессий пользователя %s", len(sessionDTOs), user.Login)
+	return &fileserverV1.ListSessionsResponse{
+		Data: fileserverV1.ListSessionsResponseData{
+			Sessions: sessionDTOs,
+		},
+	}, nil
+}
+
+// sessionToDTO - преобразование сессии в DTO
+func sessionToDTO(session model.Session) fileserverV1.SessionDto {
+	return fileserverV1.SessionDto{
+		ID:        session.ID,
+		Created:   session.CreatedAt.Format("2006-01-02 15:04:05"),
+		Expires:   session.ExpiresAt.Format("2006-01-02 15:04:05"),
+		UserAgent: session.UserAgent,
+		IP:        session.IPAddress,
+		Current:   session.Current,
+	}
+}
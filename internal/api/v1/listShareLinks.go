@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListShareLinks - публичные ссылки на документ
+func (a *api) ListShareLinks(ctx context.Context, params fileserverV1.ListShareLinksParams) (fileserverV1.ListShareLinksRes, error) {
+	log.Printf("🔄 API: Получение ссылок на документ %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	links, err := a.service.ListShareLinks(ctx, params.ID, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения ссылок на документ %s: %v", params.ID, err)
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ не найден",
+				},
+			}, nil
+		case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на просмотр ссылок",
+				},
+			}, nil
+		default:
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось получить ссылки",
+				},
+			}, nil
+		}
+	}
+
+	linkDTOs := make([]fileserverV1.ShareLinkDto, 0, len(links))
+	for _, link := range links {
+		linkDTOs = append(linkDTOs, shareLinkToDTO(link))
+	}
+
+	log.Printf("🎉 API: Ссылок на документ %s: %d", params.ID, len(linkDTOs))
+	return &fileserverV1.ListShareLinksResponse{
+		Data: fileserverV1.ListShareLinksResponseData{Links: linkDTOs},
+	}, nil
+}
+
+func shareLinkToDTO(link model.ShareLink) fileserverV1.ShareLinkDto {
+	dto := fileserverV1.ShareLinkDto{
+		ID:        link.ID,
+		Slug:      link.Slug,
+		URL:       sharedPathPrefix + link.Slug,
+		Document:  link.DocumentID,
+		Password:  link.HasPassword(),
+		Downloads: link.Downloads,
+		Active:    link.Active(time.Now().UTC()),
+		Created:   link.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if link.ExpiresAt != nil {
+		dto.Expires = fileserverV1.NewOptString(link.ExpiresAt.Format("2006-01-02 15:04:05"))
+	}
+	if link.MaxDownloads != nil {
+		dto.MaxDownloads = fileserverV1.NewOptInt(*link.MaxDownloads)
+	}
+	if link.LastAccessedAt != nil {
+		dto.LastAccess = fileserverV1.NewOptString(link.LastAccessedAt.Format("2006-01-02 15:04:05"))
+	}
+	if link.RevokedAt != nil {
+		dto.Revoked = fileserverV1.NewOptString(link.RevokedAt.Format("2006-01-02 15:04:05"))
+	}
+	return dto
+}
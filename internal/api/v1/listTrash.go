@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListTrash - документы в корзине пользователя
+func (a *api) ListTrash(ctx context.Context, params fileserverV1.ListTrashParams) (fileserverV1.ListTrashRes, error) {
+	log.Printf("🔄 API: Получение корзины пользователя")
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	docs, err := a.service.ListTrash(ctx, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения корзины пользователя %s: %v", user.Login, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить корзину",
+			},
+		}, nil
+	}
+
+	docDTOs := make([]fileserverV1.DocumentDto, 0, len(docs))
+	for _, doc := range docs {
+		docDTOs = append(docDTOs, documentToDTO(doc))
+	}
+
+	log.Printf("🎉 API: В корзине пользователя %s документов: %d", user.Login, len(docDTOs))
+	return &fileserverV1.ListTrashResponse{
+		Data: fileserverV1.ListTrashResponseData{Docs: docDTOs},
+	}, nil
+}
@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ListUsers - поиск пользователей администратором
+func (a *api) ListUsers(ctx context.Context, params fileserverV1.ListUsersParams) (fileserverV1.ListUsersRes, error) {
+	log.Printf("🔄 API: Получение списка пользователей")
+
+	admin, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	filter := model.UserFilter{
+		Query:  params.Q.Or(""),
+		Role:   model.Role(params.Role.Or("")),
+		Limit:  params.Limit.Or(0),
+		Offset: params.Offset.Or(0),
+	}
+	if disabled, ok := params.Disabled.Get(); ok {
+		filter.Disabled = &disabled
+	}
+
+	page, err := a.service.ListUsers(ctx, admin.ID, filter)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения списка пользователей: %v", err)
+		switch {
+		case errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Список пользователей доступен только администраторам",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidToken):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Неверный токен",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidInput):
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: "🚨 Некорректные параметры поиска",
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить список пользователей",
+			},
+		}, nil
+	}
+
+	userDTOs := make([]fileserverV1.UserDto, 0, len(page.Users))
+	for _, user := range page.Users {
+		userDTOs = append(userDTOs, userToDTO(user))
+	}
+
+	log.Printf("🎉 API: Найдено %d из %d пользователей", len(userDTOs), page.Total)
+	return &fileserverV1.ListUsersResponse{
+		Data: fileserverV1.ListUsersResponseData{
+			Users:  userDTOs,
+			Total:  page.Total,
+			Limit:  page.Limit,
+			Offset: page.Offset,
+		},
+	}, nil
+}
+
+// userToDTO - преобразование пользователя в DTO
+func userToDTO(user model.User) fileserverV1.UserDto {
+	dto := fileserverV1.UserDto{
+		ID:                    user.ID,
+		Login:                 user.Login,
+		Role:                  string(user.Role),
+		Disabled:              user.Disabled,
+		PasswordResetRequired: user.PasswordResetRequired,
+		FailedLogins:          user.FailedLogins,
+		TotpEnabled:           user.TOTPEnabled,
+		Created:               user.CreatedAt,
+	}
+	if user.Locked(time.Now().UTC()) {
+		dto.LockedUntil = fileserverV1.NewOptDateTime(*user.LockedUntil)
+	}
+	return dto
+}
@@ -2,18 +2,25 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"math"
 
+	"github.com/NarthurN/FileServerService/internal/model"
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
 
 func (a *api) LoginUser(ctx context.Context, req *fileserverV1.LoginRequest) (fileserverV1.LoginUserRes, error) {
 	log.Printf("🔄 API: Аутентификация пользователя %s", req.Login)
 
-	token, err := a.service.AuthenticateUser(ctx, req.Login, req.Pswd)
+	result, err := a.service.AuthenticateUser(ctx, req.Login, req.Pswd, clientInfo(ctx))
 	if err != nil {
 		log.Printf("🚨 API: Ошибка аутентификации пользователя %s: %v", req.Login, err)
+		var throttle model.ThrottleError
+		if errors.As(err, &throttle) {
+			return throttleError(throttle), nil
+		}
 		return &fileserverV1.BadRequestError{
 			Error: fileserverV1.BadRequestErrorError{
 				Code: 400,
@@ -22,10 +29,37 @@ func (a *api) LoginUser(ctx context.Context, req *fileserverV1.LoginRequest) (fi
 		}, nil
 	}
 
+	if result.Token == "" {
+		log.Printf("🎉 API: Пароль пользователя %s принят, ожидается код второго фактора", req.Login)
+		return &fileserverV1.SecondFactorChallengeResponse{
+			Response: fileserverV1.SecondFactorChallengeResponseResponse{
+				Challenge: result.Challenge,
+				Expires:   result.ChallengeExpiresAt,
+			},
+		}, nil
+	}
+
 	log.Printf("🎉 API: Пользователь %s успешно аутентифицирован", req.Login)
 	return &fileserverV1.LoginResponse{
 		Response: fileserverV1.LoginResponseResponse{
-			Token: token,
+			Token: result.Token,
 		},
 	}, nil
 }
+
+// throttleError - ответ 429 с Retry-After в целых секундах (не меньше одной)
+func throttleError(throttle model.ThrottleError) *fileserverV1.TooManyRequestsErrorHeaders {
+	text := "🚨 Слишком много попыток входа, повторите позже"
+	if errors.Is(throttle, model.ErrAccountLocked) {
+		text = "🚨 Учетная запись временно заблокирована после неудачных попыток входа"
+	}
+	return &fileserverV1.TooManyRequestsErrorHeaders{
+		RetryAfter: int(math.Max(1, math.Ceil(throttle.RetryAfter.Seconds()))),
+		Response: fileserverV1.TooManyRequestsError{
+			Error: fileserverV1.TooManyRequestsErrorError{
+				Code: 429,
+				Text: text,
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// LogoutEverywhere - завершение всех сессий текущего пользователя
+func (a *api) LogoutEverywhere(ctx context.Context, params fileserverV1.LogoutEverywhereParams) (fileserverV1.LogoutEverywhereRes, error) {
+	log.Printf("🔄 API: Выход на всех устройствах")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	count, err := a.service.LogoutEverywhere(ctx, user.ID)
+	if err != nil {
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось завершить сессии",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Пользователь %s вышел на всех устройствах (%d сессий)", user.Login, count)
+	return &fileserverV1.LogoutResponse{
+		Response: fileserverV1.LogoutResponseResponse{
+			"sessions": true,
+		},
+	}, nil
+}
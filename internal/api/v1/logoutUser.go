@@ -10,17 +10,18 @@ import (
 	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
 )
 
-func (a *api) LogoutUser(ctx context.Context, params fileserverV1.LogoutUserParams) (fileserverV1.LogoutUserRes, error) {
-	log.Printf("🔄 API: Выход пользователя %s", params.Token)
+// LogoutUser - завершение сессии токена из заголовка Authorization. Сам токен в журнал не пишется
+func (a *api) LogoutUser(ctx context.Context) (fileserverV1.LogoutUserRes, error) {
+	log.Printf("🔄 API: Выход пользователя")
 
-	err := a.service.LogoutUser(ctx, params.Token)
+	err := a.service.LogoutUser(ctx, bearerToken(ctx))
 	if err != nil {
-		log.Printf("🚨 API: Ошибка выхода пользователя %s: %v", params.Token, err)
+		log.Printf("🚨 API: Ошибка выхода пользователя: %v", err)
 		if errors.Is(err, model.ErrInvalidToken) {
 			return &fileserverV1.UnauthorizedError{
 				Error: fileserverV1.UnauthorizedErrorError{
 					Code: 401,
-					Text: fmt.Sprintf("🚨 Токен %s: %v", params.Token, err),
+					Text: fmt.Sprintf("🚨 Токен: %v", err),
 				},
 			}, nil
 		}
@@ -28,12 +29,12 @@ func (a *api) LogoutUser(ctx context.Context, params fileserverV1.LogoutUserPara
 		return &fileserverV1.InternalServerError{
 			Error: fileserverV1.InternalServerErrorError{
 				Code: 500,
-				Text: fmt.Sprintf("🚨 Токен %s: %v", params.Token, err),
+				Text: fmt.Sprintf("🚨 Токен: %v", err),
 			},
 		}, nil
 	}
 
-	log.Printf("🎉 API: Пользователь %s успешно вышел", params.Token)
+	log.Printf("🎉 API: Пользователь успешно вышел")
 	return &fileserverV1.LogoutResponse{
 		Response: fileserverV1.LogoutResponseResponse{
 			"token": true,
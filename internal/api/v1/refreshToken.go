@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RefreshToken - выпуск нового токена взамен действующего
+func (a *api) RefreshToken(ctx context.Context, params fileserverV1.RefreshTokenParams) (fileserverV1.RefreshTokenRes, error) {
+	log.Printf("🔄 API: Обновление токена")
+
+	token, err := a.service.RefreshToken(ctx, params.Token, clientInfo(ctx))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка обновления токена: %v", err)
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Токен успешно обновлен")
+	return &fileserverV1.LoginResponse{
+		Response: fileserverV1.LoginResponseResponse{
+			Token: token,
+		},
+	}, nil
+}
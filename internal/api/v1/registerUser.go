@@ -13,14 +13,46 @@ import (
 func (a *api) RegisterUser(ctx context.Context, req *fileserverV1.RegisterRequest) (fileserverV1.RegisterUserRes, error) {
 	log.Printf("🔄 API: Регистрация пользователя %s", req.Login)
 
-	user, err := a.service.RegisterUser(ctx, req.Token, req.Login, req.Pswd)
+	// Регистрировать пользователей может только администратор: токен должен принадлежать его сессии
+	admin, err := a.validateToken(ctx, req.Token)
+	if err != nil {
+		log.Printf("🚨 API: Невалидный токен при регистрации пользователя %s: %v", req.Login, err)
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Невалидный или истекший токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	role := model.Role(req.Role.Or(fileserverV1.RegisterRequestRoleUser))
+	user, err := a.service.RegisterUser(ctx, admin.ID, req.Login, req.Pswd, role)
 	if err != nil {
 		log.Printf("🚨 API: Ошибка регистрации пользователя %s: %v", req.Login, err)
-		if errors.Is(err, model.ErrInvalidAdminToken) {
+		if errors.Is(err, model.ErrAccessDenied) {
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Регистрировать пользователей может только администратор",
+				},
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidToken) {
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Невалидный или истекший токен",
+				},
+			}, nil
+		}
+		if errors.Is(err, model.ErrInvalidInput) {
 			return &fileserverV1.BadRequestError{
 				Error: fileserverV1.BadRequestErrorError{
 					Code: 400,
-					Text: fmt.Sprintf("🚨 Токен %s: %v", req.Token, err),
+					Text: fmt.Sprintf("🚨 Роль %s: %v", role, err),
 				},
 			}, nil
 		}
@@ -44,6 +76,7 @@ func (a *api) RegisterUser(ctx context.Context, req *fileserverV1.RegisterReques
 	return &fileserverV1.RegisterResponse{
 		Response: fileserverV1.RegisterResponseResponse{
 			Login: user.Login,
+			Role:  string(user.Role),
 		},
 	}, nil
 }
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ReplaceDocument - замена содержимого документа (создает новую текущую версию)
+func (a *api) ReplaceDocument(ctx context.Context, req *fileserverV1.CreateVersionRequestMultipart, params fileserverV1.ReplaceDocumentParams) (fileserverV1.ReplaceDocumentRes, error) {
+	log.Printf("🔄 API: Замена содержимого документа %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.GetDocument(ctx, params.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка получения документа %s: %v", params.ID, err)
+		return updateError(err), nil
+	}
+
+	// Права на изменение проверяются сервисом при создании версии
+	if _, err := a.uploadVersion(ctx, doc, user, req); err != nil {
+		return updateError(err), nil
+	}
+
+	doc, err = a.service.GetDocument(ctx, params.ID)
+	if err != nil {
+		return updateError(err), nil
+	}
+
+	log.Printf("🎉 API: Содержимое документа %s заменено, текущая версия %d", params.ID, doc.Version)
+	return &fileserverV1.UpdateDocumentResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
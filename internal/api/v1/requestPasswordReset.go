@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RequestPasswordReset - запрос токена сброса пароля; ответ не раскрывает существование логина
+func (a *api) RequestPasswordReset(ctx context.Context, req *fileserverV1.PasswordResetRequest) (fileserverV1.RequestPasswordResetRes, error) {
+	log.Printf("🔄 API: Запрос сброса пароля для %s", req.Login)
+
+	if err := a.service.RequestPasswordReset(ctx, req.Login); err != nil {
+		log.Printf("🚨 API: Ошибка запроса сброса пароля для %s: %v", req.Login, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось обработать запрос сброса пароля",
+			},
+		}, nil
+	}
+
+	return &fileserverV1.AcceptedResponse{
+		Response: fileserverV1.AcceptedResponseResponse{
+			Accepted: true,
+		},
+	}, nil
+}
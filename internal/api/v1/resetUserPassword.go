@@ -0,0 +1,72 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// ResetUserPassword - принудительный сброс пароля пользователя администратором
+func (a *api) ResetUserPassword(ctx context.Context, params fileserverV1.ResetUserPasswordParams) (fileserverV1.ResetUserPasswordRes, error) {
+	log.Printf("🔄 API: Сброс пароля пользователя %s", params.UserID)
+
+	admin, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	reset, err := a.service.ResetUserPassword(ctx, admin.ID, params.UserID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка сброса пароля пользователя %s: %v", params.UserID, err)
+		switch {
+		case errors.Is(err, model.ErrInvalidToken):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Неверный токен",
+				},
+			}, nil
+		case errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Управление пользователями доступно только администраторам",
+				},
+			}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Пользователь не найден",
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось сбросить пароль",
+			},
+		}, nil
+	}
+
+	// Токен сброса не пишется в лог
+	log.Printf("🎉 API: Пароль пользователя %s сброшен", reset.User.Login)
+	return &fileserverV1.PasswordResetResponse{
+		Data: fileserverV1.PasswordResetResponseData{
+			Login:      reset.User.Login,
+			ResetToken: reset.Token,
+			Expires:    reset.ExpiresAt.Format("2006-01-02 15:04:05"),
+		},
+	}, nil
+}
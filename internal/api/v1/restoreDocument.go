@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RestoreDocument - восстановление документа из корзины
+func (a *api) RestoreDocument(ctx context.Context, params fileserverV1.RestoreDocumentParams) (fileserverV1.RestoreDocumentRes, error) {
+	log.Printf("🔄 API: Восстановление документа %s из корзины", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.RestoreDocument(ctx, params.ID, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка восстановления документа %s: %v", params.ID, err)
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ не найден в корзине",
+				},
+			}, nil
+		case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на восстановление документа",
+				},
+			}, nil
+		}
+
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Не удалось восстановить документ: %v", err),
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Документ %s восстановлен из корзины", params.ID)
+	return &fileserverV1.UpdateDocumentResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RestoreDocumentVersion - восстановление старой версии документа как текущей
+func (a *api) RestoreDocumentVersion(ctx context.Context, params fileserverV1.RestoreDocumentVersionParams) (fileserverV1.RestoreDocumentVersionRes, error) {
+	log.Printf("🔄 API: Восстановление версии %d документа %s", params.Version, params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	doc, err := a.service.GetDocument(ctx, params.ID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ не найден",
+				},
+			}, nil
+		}
+
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить документ",
+			},
+		}, nil
+	}
+
+	restored, err := a.service.RestoreDocumentVersion(ctx, doc.ID, params.Version, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка восстановления версии: %v", err)
+		if errors.Is(err, model.ErrOwnershipRequired) || errors.Is(err, model.ErrAccessDenied) {
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на восстановление версий документа",
+				},
+			}, nil
+		}
+		if errors.Is(err, model.ErrNotFound) {
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: fmt.Sprintf("🚨 Версия %d не найдена", params.Version),
+				},
+			}, nil
+		}
+
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось восстановить версию",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Версия %d документа %s восстановлена как версия %d", params.Version, params.ID, restored.Version)
+	return &fileserverV1.DocumentVersionResponse{
+		Data: versionToDTO(restored, restored.Version),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RevokeApiKey - отзыв одного из API-ключей текущего пользователя
+func (a *api) RevokeApiKey(ctx context.Context, params fileserverV1.RevokeApiKeyParams) (fileserverV1.RevokeApiKeyRes, error) {
+	log.Printf("🔄 API: Отзыв API-ключа %s", params.KeyID)
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if user.APIKey != nil {
+		return apiKeyError(), nil
+	}
+
+	if err := a.service.RevokeAPIKey(ctx, user.ID, params.KeyID); err != nil {
+		log.Printf("🚨 API: Ошибка отзыва API-ключа %s: %v", params.KeyID, err)
+		if errors.Is(err, model.ErrNotFound) {
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 API-ключ не найден",
+				},
+			}, nil
+		}
+
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось отозвать API-ключ",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: API-ключ %s отозван", params.KeyID)
+	return &fileserverV1.LogoutResponse{
+		Response: fileserverV1.LogoutResponseResponse{
+			params.KeyID: true,
+		},
+	}, nil
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// RevokeShareLink - отзыв публичной ссылки на документ
+func (a *api) RevokeShareLink(ctx context.Context, params fileserverV1.RevokeShareLinkParams) (fileserverV1.RevokeShareLinkRes, error) {
+	log.Printf("🔄 API: Отзыв ссылки %s на документ %s", params.ShareID, params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	link, err := a.service.RevokeShareLink(ctx, params.ID, params.ShareID, user.ID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка отзыва ссылки %s: %v", params.ShareID, err)
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Документ или ссылка не найдены",
+				},
+			}, nil
+		case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Нет прав на отзыв ссылки",
+				},
+			}, nil
+		default:
+			return &fileserverV1.InternalServerError{
+				Error: fileserverV1.InternalServerErrorError{
+					Code: 500,
+					Text: "🚨 Не удалось отозвать ссылку",
+				},
+			}, nil
+		}
+	}
+
+	log.Printf("🎉 API: Пользователь %s отозвал ссылку %s", user.Login, link.ID)
+	return &fileserverV1.ShareLinkResponse{
+		Data: shareLinkToDTO(link),
+	}, nil
+}
@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// SearchDocuments - полнотекстовый поиск по доступным пользователю документам
+func (a *api) SearchDocuments(ctx context.Context, params fileserverV1.SearchDocumentsParams) (fileserverV1.SearchDocumentsRes, error) {
+	log.Printf("🔄 API: Поиск документов")
+
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsRead) {
+		return scopeError(model.ScopeDocsRead), nil
+	}
+
+	filters, err := parseDocumentFilters(params.Filter)
+	if err != nil {
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}, nil
+	}
+
+	page, err := a.service.SearchDocuments(ctx, user.ID, model.DocumentSearch{
+		Query:   params.Q,
+		Filters: filters,
+		Limit:   params.Limit.Or(0),
+		Offset:  params.Offset.Or(0),
+	})
+	if err != nil {
+		log.Printf("🚨 API: Ошибка поиска документов: %v", err)
+		if errors.Is(err, model.ErrInvalidInput) {
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 %v", err),
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось выполнить поиск",
+			},
+		}, nil
+	}
+
+	results := make([]fileserverV1.SearchHitDto, 0, len(page.Hits))
+	for _, hit := range page.Hits {
+		results = append(results, fileserverV1.SearchHitDto{
+			Document: documentToDTO(hit.Document),
+			Rank:     hit.Rank,
+			Snippet:  hit.Snippet,
+		})
+	}
+
+	log.Printf("🎉 API: Найдено %d из %d документов", len(results), page.Total)
+	return &fileserverV1.SearchDocumentsResponse{
+		Data: fileserverV1.SearchDocumentsResponseData{
+			Results: results,
+			Total:   page.Total,
+			Limit:   page.Limit,
+			Offset:  page.Offset,
+		},
+	}, nil
+}
@@ -0,0 +1,24 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// stagedCommit - хук переноса временного объекта под итоговый ключ внутри транзакции создания (nil без файла)
+func stagedCommit(staged *storage.StagedBlob) model.CommitHook {
+	if staged == nil {
+		return nil
+	}
+	return staged.Commit
+}
+
+// discardStaged - удаление временного объекта, если документ или версия так и не были созданы
+func (a *api) discardStaged(ctx context.Context, staged *storage.StagedBlob) {
+	if err := staged.Discard(context.WithoutCancel(ctx)); err != nil {
+		log.Printf("🚨 API: Предупреждение - не удалось удалить временный файл %s: %v", staged.Digest, err)
+	}
+}
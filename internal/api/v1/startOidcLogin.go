@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// StartOidcLogin - перенаправление на страницу входа провайдера OIDC
+func (a *api) StartOidcLogin(ctx context.Context) (fileserverV1.StartOidcLoginRes, error) {
+	log.Printf("🔄 API: Начало входа через OIDC")
+
+	authURL, err := a.service.StartOIDCLogin(ctx)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка начала входа через OIDC: %v", err)
+		if errors.Is(err, model.ErrOIDCNotConfigured) {
+			return oidcNotConfiguredError(), nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Провайдер входа недоступен",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Перенаправление на страницу входа провайдера OIDC")
+	return &fileserverV1.StartOidcLoginFound{
+		Location: authURL,
+	}, nil
+}
+
+// oidcNotConfiguredError - ответ 404: вход через OIDC не настроен
+func oidcNotConfiguredError() *fileserverV1.NotFoundError {
+	return &fileserverV1.NotFoundError{
+		Error: fileserverV1.NotFoundErrorError{
+			Code: 404,
+			Text: "🚨 Вход через OIDC не настроен",
+		},
+	}
+}
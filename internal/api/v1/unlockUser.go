@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// UnlockUser - снятие временной блокировки входа пользователя администратором
+func (a *api) UnlockUser(ctx context.Context, params fileserverV1.UnlockUserParams) (fileserverV1.UnlockUserRes, error) {
+	log.Printf("🔄 API: Снятие блокировки входа пользователя %s", params.UserID)
+
+	admin, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	user, err := a.service.UnlockUser(ctx, admin.ID, params.UserID)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка снятия блокировки пользователя %s: %v", params.UserID, err)
+		switch {
+		case errors.Is(err, model.ErrInvalidToken):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Неверный токен",
+				},
+			}, nil
+		case errors.Is(err, model.ErrAccessDenied):
+			return &fileserverV1.ForbiddenError{
+				Error: fileserverV1.ForbiddenErrorError{
+					Code: 403,
+					Text: "🚨 Управление пользователями доступно только администраторам",
+				},
+			}, nil
+		case errors.Is(err, model.ErrNotFound):
+			return &fileserverV1.NotFoundError{
+				Error: fileserverV1.NotFoundErrorError{
+					Code: 404,
+					Text: "🚨 Пользователь не найден",
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось снять блокировку",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Блокировка входа пользователя %s снята", user.Login)
+	return &fileserverV1.UserResponse{
+		Data: userToDTO(user),
+	}, nil
+}
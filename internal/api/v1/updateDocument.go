@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// UpdateDocument - частичное изменение документа (имя, публичность, grants, папка, метаданные, JSON данные)
+func (a *api) UpdateDocument(ctx context.Context, req *fileserverV1.UpdateDocumentRequest, params fileserverV1.UpdateDocumentParams) (fileserverV1.UpdateDocumentRes, error) {
+	log.Printf("🔄 API: Изменение документа %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	var update model.DocumentUpdate
+	if name, ok := req.Name.Get(); ok {
+		update.Name = &name
+	}
+	if public, ok := req.Public.Get(); ok {
+		update.IsPublic = &public
+	}
+	// Пустой массив очищает список доступа, отсутствующее поле оставляет его без изменений
+	if req.Grant != nil {
+		grants := req.Grant
+		update.Grants = &grants
+	}
+	// Пустая строка переносит документ в корень владельца
+	if folder, ok := req.Folder.Get(); ok {
+		update.FolderID = &folder
+	}
+	// Ключ со значением null удаляется из метаданных
+	if metaVal, ok := req.Metadata.Get(); ok {
+		if update.Metadata, err = parseMetadata(metaVal); err != nil {
+			log.Printf("🚨 API: Ошибка парсинга метаданных: %v", err)
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 Ошибка парсинга метаданных: %v", err),
+				},
+			}, nil
+		}
+	}
+	if jsonVal, ok := req.JSON.Get(); ok {
+		update.JSONData = make(model.JSONData, len(jsonVal))
+		for k, raw := range jsonVal {
+			var v any
+			if err := json.Unmarshal(raw, &v); err != nil {
+				log.Printf("🚨 API: Ошибка парсинга JSON: %v", err)
+				return &fileserverV1.BadRequestError{
+					Error: fileserverV1.BadRequestErrorError{
+						Code: 400,
+						Text: fmt.Sprintf("🚨 Ошибка парсинга JSON: %v", err),
+					},
+				}, nil
+			}
+			update.JSONData[k] = v
+		}
+	}
+
+	doc, err := a.service.UpdateDocument(ctx, params.ID, user.ID, update)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка изменения документа %s: %v", params.ID, err)
+		return updateError(err), nil
+	}
+
+	log.Printf("🎉 API: Документ %s успешно изменен", params.ID)
+	return &fileserverV1.UpdateDocumentResponse{
+		Data: documentToDTO(doc),
+	}, nil
+}
+
+// updateError - ответ на ошибку изменения документа (общий для PATCH, PUT и загрузки версии). Текст ошибки отдается клиенту
+// только для ошибок валидации; ошибки БД и хранилища скрываются за общим 500
+func updateError(err error) interface {
+	fileserverV1.UpdateDocumentRes
+	fileserverV1.ReplaceDocumentRes
+	fileserverV1.CreateDocumentVersionRes
+} {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Документ не найден",
+			},
+		}
+	case errors.Is(err, model.ErrOwnershipRequired), errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Нет прав на изменение документа",
+			},
+		}
+	case errors.Is(err, model.ErrDocumentNameExists):
+		return &fileserverV1.ConflictError{
+			Error: fileserverV1.ConflictErrorError{
+				Code: 409,
+				Text: "🚨 Документ с таким именем уже есть в папке",
+			},
+		}
+	case errors.Is(err, model.ErrInvalidInput), errors.Is(err, model.ErrRequired),
+		errors.Is(err, model.ErrDocumentNameEmpty), errors.Is(err, model.ErrDocumentNameTooLong),
+		errors.Is(err, model.ErrDocumentNoContent), errors.Is(err, model.ErrDocumentInvalidGrant):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Не удалось изменить документ: %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось изменить документ",
+			},
+		}
+	}
+}
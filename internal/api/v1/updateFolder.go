@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"context"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// UpdateFolder - переименование, перенос и изменение доступа к папке
+func (a *api) UpdateFolder(ctx context.Context, req *fileserverV1.UpdateFolderRequest, params fileserverV1.UpdateFolderParams) (fileserverV1.UpdateFolderRes, error) {
+	log.Printf("🔄 API: Изменение папки %s", params.ID)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	var update model.FolderUpdate
+	if name, ok := req.Name.Get(); ok {
+		update.Name = &name
+	}
+	// Пустая строка переносит папку в корень владельца
+	if parent, ok := req.Parent.Get(); ok {
+		update.ParentID = &parent
+	}
+	if public, ok := req.Public.Get(); ok {
+		update.IsPublic = &public
+	}
+	// Пустой массив очищает список доступа, отсутствующее поле оставляет его без изменений
+	if req.Grant != nil {
+		grants := req.Grant
+		update.Grants = &grants
+	}
+
+	folder, err := a.service.UpdateFolder(ctx, user.ID, params.ID, update)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка изменения папки %s: %v", params.ID, err)
+		return folderWriteError(err), nil
+	}
+
+	log.Printf("🎉 API: Папка %s успешно изменена", params.ID)
+	return &fileserverV1.FolderResponse{
+		Data: folderToDTO(folder),
+	}, nil
+}
@@ -0,0 +1,93 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// UpdateUser - смена роли и блокировка учетной записи администратором
+func (a *api) UpdateUser(ctx context.Context, req *fileserverV1.UpdateUserRequest, params fileserverV1.UpdateUserParams) (fileserverV1.UpdateUserRes, error) {
+	log.Printf("🔄 API: Изменение пользователя %s", params.UserID)
+
+	admin, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !admin.HasScope(model.ScopeAdmin) {
+		return scopeError(model.ScopeAdmin), nil
+	}
+
+	var update model.UserUpdate
+	if role, ok := req.Role.Get(); ok {
+		r := model.Role(role)
+		update.Role = &r
+	}
+	if disabled, ok := req.Disabled.Get(); ok {
+		update.Disabled = &disabled
+	}
+
+	user, err := a.service.UpdateUser(ctx, admin.ID, params.UserID, update)
+	if err != nil {
+		log.Printf("🚨 API: Ошибка изменения пользователя %s: %v", params.UserID, err)
+		return userAdminError(err), nil
+	}
+
+	log.Printf("🎉 API: Пользователь %s изменен", user.Login)
+	return &fileserverV1.UserResponse{
+		Data: userToDTO(user),
+	}, nil
+}
+
+// userAdminError - преобразование ошибки управления пользователями в ответ API
+func userAdminError(err error) interface {
+	fileserverV1.UpdateUserRes
+	fileserverV1.DeleteUserRes
+} {
+	switch {
+	case errors.Is(err, model.ErrInvalidToken):
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}
+	case errors.Is(err, model.ErrAccessDenied):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Управление пользователями доступно только администраторам",
+			},
+		}
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Пользователь не найден",
+			},
+		}
+	case errors.Is(err, model.ErrSelfModification), errors.Is(err, model.ErrInvalidInput):
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 %v", err),
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось изменить пользователя",
+			},
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// UploadChunk - прием очередного фрагмента файла
+func (a *api) UploadChunk(ctx context.Context, req fileserverV1.UploadChunkReq, params fileserverV1.UploadChunkParams) (fileserverV1.UploadChunkRes, error) {
+	log.Printf("🔄 API: Фрагмент загрузки %s со смещения %d", params.UploadID, params.UploadOffset)
+
+	// Валидация токена
+	user, err := a.validateToken(ctx, params.Token)
+	if err != nil {
+		return &fileserverV1.UnauthorizedError{
+			Error: fileserverV1.UnauthorizedErrorError{
+				Code: 401,
+				Text: "🚨 Неверный токен",
+			},
+		}, nil
+	}
+	if !user.HasScope(model.ScopeDocsWrite) {
+		return scopeError(model.ScopeDocsWrite), nil
+	}
+
+	session, err := a.service.GetUploadSession(ctx, params.UploadID, user.ID)
+	if err != nil {
+		return uploadSessionError(err), nil
+	}
+
+	// Клиент должен продолжать ровно с того места, где остановился сервер
+	if params.UploadOffset != session.Offset {
+		return &fileserverV1.ConflictError{
+			Error: fileserverV1.ConflictErrorError{
+				Code: 409,
+				Text: fmt.Sprintf("🚨 Неверное смещение %d, текущее смещение загрузки %d", params.UploadOffset, session.Offset),
+			},
+		}, nil
+	}
+
+	// Читаем на байт больше лимита, чтобы обнаружить слишком большой фрагмент
+	limit := a.service.UploadChunkLimit(session)
+	key := uploadChunkKey(session.ID, session.Offset)
+	info, err := a.storage.Put(ctx, key, io.LimitReader(req.Data, limit+1), -1, "application/octet-stream")
+	if err != nil {
+		log.Printf("🚨 API: Ошибка сохранения фрагмента загрузки %s: %v", session.ID, err)
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось сохранить фрагмент",
+			},
+		}, nil
+	}
+
+	if info.Size > limit {
+		a.deleteChunk(ctx, key)
+		return &fileserverV1.BadRequestError{
+			Error: fileserverV1.BadRequestErrorError{
+				Code: 400,
+				Text: fmt.Sprintf("🚨 Фрагмент больше допустимого размера %d байт", limit),
+			},
+		}, nil
+	}
+
+	updated, err := a.service.AdvanceUploadSession(ctx, session, session.Offset, info.Size, key)
+	if err != nil {
+		a.deleteChunk(ctx, key)
+		switch {
+		case errors.Is(err, model.ErrConflict):
+			return &fileserverV1.ConflictError{
+				Error: fileserverV1.ConflictErrorError{
+					Code: 409,
+					Text: "🚨 Смещение загрузки изменено параллельным запросом",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidInput):
+			return &fileserverV1.BadRequestError{
+				Error: fileserverV1.BadRequestErrorError{
+					Code: 400,
+					Text: fmt.Sprintf("🚨 Некорректный фрагмент: %v", err),
+				},
+			}, nil
+		default:
+			return uploadSessionError(err), nil
+		}
+	}
+
+	log.Printf("🎉 API: Загрузка %s: принято %d из %d байт", updated.ID, updated.Offset, updated.Size)
+	return &fileserverV1.UploadChunkNoContent{
+		UploadOffset: updated.Offset,
+	}, nil
+}
+
+// deleteChunk - удаление отклоненного фрагмента
+func (a *api) deleteChunk(ctx context.Context, key string) {
+	if err := a.storage.Delete(ctx, key); err != nil {
+		log.Printf("🚨 API: Предупреждение - не удалось удалить фрагмент %s: %v", key, err)
+	}
+}
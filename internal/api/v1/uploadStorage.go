@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/storage"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// uploadChunkKey - ключ фрагмента в хранилище; суффикс исключает перезапись фрагмента параллельным запросом
+func uploadChunkKey(uploadID string, offset int64) string {
+	return fmt.Sprintf("%s%020d-%s", storage.UploadPrefix(uploadID), offset, uuid.New().String()[:8])
+}
+
+// chunkReader - последовательное чтение фрагментов загрузки как одного потока
+type chunkReader struct {
+	ctx   context.Context
+	store storage.BlobStore
+	keys  []string
+	cur   io.ReadCloser
+}
+
+func newChunkReader(ctx context.Context, store storage.BlobStore, keys []string) *chunkReader {
+	return &chunkReader{ctx: ctx, store: store, keys: keys}
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for {
+		if r.cur == nil {
+			if len(r.keys) == 0 {
+				return 0, io.EOF
+			}
+			body, _, err := r.store.Get(r.ctx, r.keys[0])
+			if err != nil {
+				return 0, fmt.Errorf("failed to open chunk %s: %w", r.keys[0], err)
+			}
+			r.cur = body
+			r.keys = r.keys[1:]
+		}
+
+		n, err := r.cur.Read(p)
+		if err == io.EOF {
+			r.cur.Close()
+			r.cur = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+// Close - закрытие текущего открытого фрагмента
+func (r *chunkReader) Close() error {
+	if r.cur != nil {
+		return r.cur.Close()
+	}
+	return nil
+}
+
+// uploadToDTO - конвертация сессии загрузки в DTO ответа
+func uploadToDTO(session model.UploadSession) fileserverV1.UploadDto {
+	dto := fileserverV1.UploadDto{
+		ID:      session.ID,
+		Name:    session.Name,
+		Mime:    session.MimeType,
+		Size:    session.Size,
+		Offset:  session.Offset,
+		Expires: session.ExpiresAt.Format("2006-01-02 15:04:05"),
+	}
+	if session.FolderID != "" {
+		dto.Folder = fileserverV1.NewOptString(session.FolderID)
+	}
+	return dto
+}
+
+// uploadSessionError - ответ на ошибку получения сессии загрузки (общий для PATCH, DELETE и finalize)
+func uploadSessionError(err error) interface {
+	fileserverV1.UploadChunkRes
+	fileserverV1.CancelUploadRes
+	fileserverV1.FinalizeUploadRes
+} {
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		return &fileserverV1.NotFoundError{
+			Error: fileserverV1.NotFoundErrorError{
+				Code: 404,
+				Text: "🚨 Сессия загрузки не найдена или истекла",
+			},
+		}
+	case errors.Is(err, model.ErrOwnershipRequired):
+		return &fileserverV1.ForbiddenError{
+			Error: fileserverV1.ForbiddenErrorError{
+				Code: 403,
+				Text: "🚨 Только владелец загрузки может ее продолжить",
+			},
+		}
+	default:
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось получить сессию загрузки",
+			},
+		}
+	}
+}
+
+// deleteUploadData - удаление всех фрагментов загрузки из хранилища
+func (a *api) deleteUploadData(ctx context.Context, uploadID string) {
+	if err := storage.DeletePrefix(ctx, a.storage, storage.UploadPrefix(uploadID)); err != nil {
+		log.Printf("🚨 API: Предупреждение - не удалось удалить фрагменты загрузки %s: %v", uploadID, err)
+	}
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	fileserverV1 "github.com/NarthurN/FileServerService/pkg/generated/api/fileserver/v1"
+)
+
+// VerifySecondFactor - второй шаг входа: обмен токена второго шага и кода на токен авторизации
+func (a *api) VerifySecondFactor(ctx context.Context, req *fileserverV1.SecondFactorRequest) (fileserverV1.VerifySecondFactorRes, error) {
+	log.Printf("🔄 API: Второй шаг входа")
+
+	token, err := a.service.VerifySecondFactor(ctx, req.Challenge, req.Code, clientInfo(ctx))
+	if err != nil {
+		log.Printf("🚨 API: Ошибка второго шага входа: %v", err)
+		var throttle model.ThrottleError
+		switch {
+		case errors.As(err, &throttle):
+			return throttleError(throttle), nil
+		case errors.Is(err, model.ErrSecondFactorInvalid):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Неверный код",
+				},
+			}, nil
+		case errors.Is(err, model.ErrInvalidToken):
+			return &fileserverV1.UnauthorizedError{
+				Error: fileserverV1.UnauthorizedErrorError{
+					Code: 401,
+					Text: "🚨 Токен второго шага недействителен, истек или исчерпал попытки",
+				},
+			}, nil
+		}
+		return &fileserverV1.InternalServerError{
+			Error: fileserverV1.InternalServerErrorError{
+				Code: 500,
+				Text: "🚨 Не удалось выполнить вход",
+			},
+		}, nil
+	}
+
+	log.Printf("🎉 API: Второй шаг входа пройден")
+	return &fileserverV1.LoginResponse{
+		Response: fileserverV1.LoginResponseResponse{
+			Token: token,
+		},
+	}, nil
+}
@@ -2,10 +2,9 @@ package cache
 
 import (
 	"context"
-	"crypto/md5"
-	"encoding/hex"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 )
 
@@ -30,24 +29,29 @@ type CacheKey struct {
 	DocumentID string
 	Filter     string
 	Limit      int
+	Version    int
 }
 
-// GenerateKey генерирует уникальный ключ кэша
+// GenerateKey генерирует уникальный ключ кэша вида type:document:user:filter:limit:version.
+// Ключ не хешируется, иначе инвалидация по паттерну (InvalidateByPattern) не находит элементы.
 func (ck *CacheKey) GenerateKey() string {
-	data := fmt.Sprintf("%s:%s:%s:%s:%d",
-		ck.Type, ck.UserID, ck.DocumentID, ck.Filter, ck.Limit)
-
-	hash := md5.Sum([]byte(data))
-	return hex.EncodeToString(hash[:])
-}
-
-// DocumentListKey создает ключ для списка документов
-func DocumentListKey(userID, filterKey, filterValue string, limit int) string {
-	filter := ""
-	if filterKey != "" && filterValue != "" {
-		filter = fmt.Sprintf("%s:%s", filterKey, filterValue)
+	parts := []string{ck.Type}
+	for _, part := range []string{ck.DocumentID, ck.UserID, ck.Filter} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if ck.Limit > 0 {
+		parts = append(parts, fmt.Sprintf("limit=%d", ck.Limit))
+	}
+	if ck.Version > 0 {
+		parts = append(parts, fmt.Sprintf("v%d", ck.Version))
 	}
+	return strings.Join(parts, ":")
+}
 
+// DocumentListKey создает ключ для страницы списка документов (filter - сортировка, фильтр и курсор)
+func DocumentListKey(userID, filter string, limit int) string {
 	key := &CacheKey{
 		Type:   "docs:list",
 		UserID: userID,
@@ -66,6 +70,25 @@ func DocumentKey(documentID string) string {
 	return key.GenerateKey()
 }
 
+// DocumentVersionKey создает ключ для конкретной версии документа
+func DocumentVersionKey(documentID string, version int) string {
+	key := &CacheKey{
+		Type:       "docs:version",
+		DocumentID: documentID,
+		Version:    version,
+	}
+	return key.GenerateKey()
+}
+
+// DocumentVersionsKey создает ключ для истории версий документа
+func DocumentVersionsKey(documentID string) string {
+	key := &CacheKey{
+		Type:       "docs:history",
+		DocumentID: documentID,
+	}
+	return key.GenerateKey()
+}
+
 // UserKey создает ключ для пользователя
 func UserKey(userID string) string {
 	key := &CacheKey{
@@ -85,27 +108,16 @@ func AccessKey(documentID, userID string) string {
 	return key.GenerateKey()
 }
 
-// GetDocumentList получает список документов из кэша
-func (cm *CacheManager) GetDocumentList(ctx context.Context, userID, filterKey, filterValue string, limit int) ([]interface{}, bool) {
-	key := DocumentListKey(userID, filterKey, filterValue, limit)
-
-	value, found := cm.cache.Get(ctx, key)
-	if !found {
-		return nil, false
-	}
-
-	if docs, ok := value.([]interface{}); ok {
-		return docs, true
-	}
-
-	return nil, false
+// GetDocumentList получает страницу списка документов из кэша
+func (cm *CacheManager) GetDocumentList(ctx context.Context, userID, filter string, limit int) (interface{}, bool) {
+	return cm.cache.Get(ctx, DocumentListKey(userID, filter, limit))
 }
 
-// SetDocumentList сохраняет список документов в кэш
-func (cm *CacheManager) SetDocumentList(ctx context.Context, userID, filterKey, filterValue string, limit int, docs []interface{}) error {
-	key := DocumentListKey(userID, filterKey, filterValue, limit)
+// SetDocumentList сохраняет страницу списка документов в кэш
+func (cm *CacheManager) SetDocumentList(ctx context.Context, userID, filter string, limit int, page interface{}) error {
+	key := DocumentListKey(userID, filter, limit)
 
-	err := cm.cache.Set(ctx, key, docs, 5*time.Minute) // TTL 5 минут для списков
+	err := cm.cache.Set(ctx, key, page, 5*time.Minute) // TTL 5 минут для списков
 	if err != nil {
 		return fmt.Errorf("failed to cache document list: %w", err)
 	}
@@ -137,6 +149,69 @@ func (cm *CacheManager) SetDocument(ctx context.Context, documentID string, doc
 	return nil
 }
 
+// GetDocumentVersion получает версию документа из кэша
+func (cm *CacheManager) GetDocumentVersion(ctx context.Context, documentID string, version int) (interface{}, bool) {
+	return cm.cache.Get(ctx, DocumentVersionKey(documentID, version))
+}
+
+// SetDocumentVersion сохраняет версию документа в кэш (версии неизменяемы)
+func (cm *CacheManager) SetDocumentVersion(ctx context.Context, documentID string, version int, v interface{}) error {
+	key := DocumentVersionKey(documentID, version)
+
+	err := cm.cache.Set(ctx, key, v, 30*time.Minute) // TTL 30 минут для версий
+	if err != nil {
+		return fmt.Errorf("failed to cache document version: %w", err)
+	}
+
+	return nil
+}
+
+// GetDocumentVersions получает историю версий документа из кэша
+func (cm *CacheManager) GetDocumentVersions(ctx context.Context, documentID string) ([]interface{}, bool) {
+	value, found := cm.cache.Get(ctx, DocumentVersionsKey(documentID))
+	if !found {
+		return nil, false
+	}
+
+	if versions, ok := value.([]interface{}); ok {
+		return versions, true
+	}
+
+	return nil, false
+}
+
+// SetDocumentVersions сохраняет историю версий документа в кэш
+func (cm *CacheManager) SetDocumentVersions(ctx context.Context, documentID string, versions []interface{}) error {
+	key := DocumentVersionsKey(documentID)
+
+	err := cm.cache.Set(ctx, key, versions, 10*time.Minute) // TTL 10 минут для истории
+	if err != nil {
+		return fmt.Errorf("failed to cache document versions: %w", err)
+	}
+
+	return nil
+}
+
+// InvalidateDocumentVersions инвалидирует кэш версий документа.
+// Текущий документ и история удаляются; отдельные версии неизменяемы, но при удалении
+// документа удаляются и они (all = true).
+func (cm *CacheManager) InvalidateDocumentVersions(ctx context.Context, documentID string, all bool) error {
+	log.Printf("🗑️ Cache: Инвалидация кэша версий документа %s", documentID)
+
+	if err := cm.cache.Delete(ctx, DocumentVersionsKey(documentID)); err != nil {
+		return fmt.Errorf("failed to delete document history from cache: %w", err)
+	}
+
+	if all {
+		versionPattern := fmt.Sprintf("docs:version:%s:", documentID)
+		if err := cm.cache.InvalidateByPattern(ctx, versionPattern); err != nil {
+			return fmt.Errorf("failed to invalidate document versions: %w", err)
+		}
+	}
+
+	return nil
+}
+
 // GetUser получает пользователя из кэша
 func (cm *CacheManager) GetUser(ctx context.Context, userID string) (interface{}, bool) {
 	key := UserKey(userID)
@@ -214,6 +289,17 @@ func (cm *CacheManager) InvalidateDocument(ctx context.Context, documentID strin
 	return nil
 }
 
+// InvalidateAccess инвалидирует все закэшированные права доступа к документам
+// (доступ, унаследованный от папки, меняется сразу у всего ее содержимого)
+func (cm *CacheManager) InvalidateAccess(ctx context.Context) error {
+	log.Printf("🗑️ Cache: Инвалидация кэша прав доступа к документам")
+
+	if err := cm.cache.InvalidateByPattern(ctx, "docs:access"); err != nil {
+		return fmt.Errorf("failed to invalidate access cache: %w", err)
+	}
+	return nil
+}
+
 // InvalidateUserDocuments инвалидирует кэш для всех документов пользователя
 func (cm *CacheManager) InvalidateUserDocuments(ctx context.Context, userID string) error {
 	log.Printf("🗑️ Cache: Инвалидация кэша для документов пользователя %s", userID)
@@ -237,8 +323,8 @@ func (cm *CacheManager) InvalidateUser(ctx context.Context, userID string) error
 		return fmt.Errorf("failed to delete user from cache: %w", err)
 	}
 
-	// Инвалидируем все права доступа пользователя
-	accessPattern := fmt.Sprintf("docs:access:%s", userID)
+	// Инвалидируем все права доступа пользователя (ключ вида docs:access:<document>:<user>)
+	accessPattern := fmt.Sprintf(":%s", userID)
 	if err := cm.cache.InvalidateByPattern(ctx, accessPattern); err != nil {
 		return fmt.Errorf("failed to invalidate user access cache: %w", err)
 	}
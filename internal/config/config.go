@@ -1,9 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -11,9 +13,16 @@ import (
 
 // Все настройки
 type Config struct {
-	Database DatabaseConfig // База данных
-	Server   ServerConfig   // Сервер
-	Auth     AuthConfig     // Авторизация админа
+	Database  DatabaseConfig  // База данных
+	Server    ServerConfig    // Сервер
+	Auth      AuthConfig      // Авторизация пользователей
+	Storage   StorageConfig   // Хранилище файлов
+	Upload    UploadConfig    // Возобновляемые загрузки
+	Reconcile ReconcileConfig // Сверка хранилища и БД
+	Notify    NotifyConfig    // Уведомления пользователей
+	Search    SearchConfig    // Полнотекстовый поиск
+	Trash     TrashConfig     // Корзина удаленных документов
+	Share     ShareConfig     // Публичные ссылки на документы
 }
 
 // Настройки базы данных
@@ -32,11 +41,126 @@ type ServerConfig struct {
 	Port int
 }
 
-// Настройки авторизации для админа
+// Режимы пользовательских токенов
+const (
+	TokenModeOpaque = "opaque" // Случайная строка, проверяемая по таблице tokens
+	TokenModeJWT    = "jwt"    // Подписанный JWT, проверяемый без обращения к БД
+)
+
+// Настройки авторизации
 type AuthConfig struct {
-	AdminToken    string        // Фиксированный токен администратора для регистрации
-	TokenLifetime time.Duration // Время жизни пользовательских токенов
-	JWTSecret     string        // Секрет для JWT
+	TokenLifetime time.Duration   // Время жизни пользовательских токенов
+	JWTSecret     string          // Секрет для JWT
+	TokenMode     string          // Режим токенов: opaque или jwt
+	SingleSession bool            // Вход завершает остальные сессии пользователя
+	ResetTokenTTL time.Duration   // Время жизни токена сброса пароля
+	JWT           JWTConfig       // Настройки режима jwt
+	Login         LoginConfig     // Защита входа от подбора пароля
+	TwoFactor     TwoFactorConfig // Двухфакторная аутентификация (TOTP)
+	OIDC          OIDCConfig      // Вход через OpenID Connect (SSO)
+}
+
+// Настройки входа через OpenID Connect (authorization code + PKCE)
+type OIDCConfig struct {
+	IssuerURL     string        // Издатель (адрес провайдера); пусто - вход через OIDC выключен
+	ClientID      string        // Идентификатор клиента у провайдера
+	ClientSecret  string        // Секрет клиента; пусто - публичный клиент, защищенный только PKCE
+	RedirectURL   string        // Адрес /api/auth/oidc/callback этого сервиса, зарегистрированный у провайдера
+	Scopes        []string      // Запрашиваемые scope (openid добавляется всегда)
+	LoginClaim    string        // Claim ID-токена, из которого берется логин пользователя
+	AutoProvision bool          // Создавать пользователя при первом входе
+	DefaultRole   string        // Роль создаваемых пользователей
+	StateTTL      time.Duration // Время жизни незавершенного входа
+}
+
+// Настройки двухфакторной аутентификации
+type TwoFactorConfig struct {
+	Issuer        string        // Название сервиса в приложении-аутентификаторе
+	RequiredRoles []string      // Роли, разрешения которых действуют только при включенной 2FA
+	ChallengeTTL  time.Duration // Время жизни токена второго шага входа
+	MaxAttempts   int           // Попыток ввода кода на один токен второго шага
+	RecoveryCodes int           // Количество одноразовых кодов восстановления
+}
+
+// Настройки защиты входа от подбора пароля
+type LoginConfig struct {
+	MaxFailures int           // Неудачных попыток подряд до временной блокировки учетной записи
+	Lockout     time.Duration // Длительность временной блокировки
+	BackoffBase time.Duration // Пауза после первой неудачной попытки, удваивается с каждой следующей
+	BackoffMax  time.Duration // Максимальная пауза между попытками
+	RateWindow  time.Duration // Окно ограничения частоты попыток
+	RatePerIP   int           // Попыток входа с одного IP за окно
+	RatePerUser int           // Попыток входа в один логин за окно
+}
+
+// Настройки подписи JWT
+type JWTConfig struct {
+	Algorithm  string        // HS256 (секрет JWTSecret), RS256 или EdDSA (ключи из KeysDir)
+	KeysDir    string        // Директория с PEM-ключами <kid>.pem для RS256/EdDSA
+	KeyID      string        // kid ключа, которым подписываются новые токены
+	Issuer     string        // Значение iss в выпускаемых токенах
+	RevokeSync time.Duration // Период синхронизации списка отозванных токенов из БД
+}
+
+// Настройки хранилища файлов документов
+type StorageConfig struct {
+	Driver   string   // Драйвер хранилища: local или s3
+	LocalDir string   // Корневая директория для драйвера local
+	S3       S3Config // Настройки для драйвера s3
+}
+
+// Настройки S3-совместимого хранилища
+type S3Config struct {
+	Endpoint         string // Адрес сервиса, например http://minio:9000
+	Region           string // Регион для подписи запросов
+	Bucket           string // Имя бакета
+	AccessKey        string // Ключ доступа
+	SecretKey        string // Секретный ключ
+	PathStyle        bool   // Адресация вида endpoint/bucket/key (нужна для MinIO)
+	AutoCreateBucket bool   // Создавать бакет при старте, если его нет
+}
+
+// Настройки возобновляемых загрузок
+type UploadConfig struct {
+	SessionTTL      time.Duration // Время жизни сессии без новых фрагментов
+	MaxSize         int64         // Максимальный размер загружаемого файла в байтах
+	MaxChunkSize    int64         // Максимальный размер одного фрагмента в байтах
+	CleanupInterval time.Duration // Период удаления истекших сессий
+}
+
+// Настройки сверки файлов в хранилище со строками БД
+type ReconcileConfig struct {
+	Interval time.Duration // Период фоновой сверки
+	Grace    time.Duration // Объекты моложе этого возраста не считаются осиротевшими (загрузка еще идет)
+	Repair   bool          // Исправлять найденные расхождения, а не только сообщать о них
+}
+
+// Настройки фонового индексатора полнотекстового поиска
+type SearchConfig struct {
+	IndexInterval time.Duration // Период проверки очереди непроиндексированных документов
+	BatchSize     int           // Документов за один проход по очереди
+	MaxFileSize   int64         // Файлы больше этого размера не читаются, индексируется только имя
+	MaxTextSize   int           // Предел извлеченного текста на документ в байтах
+}
+
+// Настройки корзины удаленных документов
+type TrashConfig struct {
+	Retention     time.Duration // Срок хранения документа в корзине до окончательного удаления
+	PurgeInterval time.Duration // Период проверки корзин на документы с истекшим сроком хранения
+	BatchSize     int           // Документов за одну выборку окончательного удаления
+}
+
+// Настройки публичных ссылок: ограничение частоты проверок пароля ссылки (в памяти каждого экземпляра)
+type ShareConfig struct {
+	RateWindow  time.Duration // Окно ограничения частоты проверок пароля
+	RatePerIP   int           // Проверок пароля с одного IP за окно
+	RatePerLink int           // Проверок пароля одной ссылки за окно
+}
+
+// Настройки доставки уведомлений (токены сброса пароля)
+type NotifyConfig struct {
+	Driver string // Драйвер: log (журнал приложения) или file
+	File   string // Файл JSON Lines для драйвера file
 }
 
 func Load() (*Config, error) {
@@ -45,7 +169,7 @@ func Load() (*Config, error) {
 		log.Println("ℹ️ Файл .env не найден, используем переменные окружения:", err)
 	}
 
-	return &Config{
+	cfg := &Config{
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnvInt("DB_PORT", 5432),
@@ -59,11 +183,99 @@ func Load() (*Config, error) {
 			Port: getEnvInt("SERVER_PORT", 8080),
 		},
 		Auth: AuthConfig{
-			AdminToken:    getEnv("ADMIN_TOKEN", "admin-secret-token-123456"),
 			TokenLifetime: getTokenLifetime(),
 			JWTSecret:     getEnv("JWT_SECRET", "your-secret-key-change-in-production"),
+			TokenMode:     getEnv("AUTH_TOKEN_MODE", TokenModeOpaque),
+			SingleSession: getEnvBool("AUTH_SINGLE_SESSION", true),
+			ResetTokenTTL: getEnvDuration("AUTH_RESET_TOKEN_TTL", time.Hour),
+			JWT: JWTConfig{
+				Algorithm:  getEnv("JWT_ALGORITHM", "HS256"),
+				KeysDir:    getEnv("JWT_KEYS_DIR", ""),
+				KeyID:      getEnv("JWT_KEY_ID", ""),
+				Issuer:     getEnv("JWT_ISSUER", "fileserver"),
+				RevokeSync: getEnvDuration("JWT_REVOCATION_SYNC", 30*time.Second),
+			},
+			Login: LoginConfig{
+				MaxFailures: getEnvInt("AUTH_LOGIN_MAX_FAILURES", 10),
+				Lockout:     getEnvDuration("AUTH_LOGIN_LOCKOUT", 15*time.Minute),
+				BackoffBase: getEnvDuration("AUTH_LOGIN_BACKOFF_BASE", time.Second),
+				BackoffMax:  getEnvDuration("AUTH_LOGIN_BACKOFF_MAX", time.Minute),
+				RateWindow:  getEnvDuration("AUTH_LOGIN_RATE_WINDOW", time.Minute),
+				RatePerIP:   getEnvInt("AUTH_LOGIN_RATE_IP", 30),
+				RatePerUser: getEnvInt("AUTH_LOGIN_RATE_LOGIN", 10),
+			},
+			TwoFactor: TwoFactorConfig{
+				Issuer:        getEnv("AUTH_2FA_ISSUER", "FileServer"),
+				RequiredRoles: getEnvList("AUTH_2FA_REQUIRED_ROLES", nil),
+				ChallengeTTL:  getEnvDuration("AUTH_2FA_CHALLENGE_TTL", 5*time.Minute),
+				MaxAttempts:   getEnvInt("AUTH_2FA_MAX_ATTEMPTS", 5),
+				RecoveryCodes: getEnvInt("AUTH_2FA_RECOVERY_CODES", 10),
+			},
+			OIDC: OIDCConfig{
+				IssuerURL:     strings.TrimSuffix(getEnv("AUTH_OIDC_ISSUER", ""), "/"),
+				ClientID:      getEnv("AUTH_OIDC_CLIENT_ID", ""),
+				ClientSecret:  getEnv("AUTH_OIDC_CLIENT_SECRET", ""),
+				RedirectURL:   getEnv("AUTH_OIDC_REDIRECT_URL", ""),
+				Scopes:        getEnvList("AUTH_OIDC_SCOPES", []string{"openid", "email", "profile"}),
+				LoginClaim:    getEnv("AUTH_OIDC_LOGIN_CLAIM", "email"),
+				AutoProvision: getEnvBool("AUTH_OIDC_AUTO_PROVISION", false),
+				DefaultRole:   getEnv("AUTH_OIDC_DEFAULT_ROLE", "user"),
+				StateTTL:      getEnvDuration("AUTH_OIDC_STATE_TTL", 10*time.Minute),
+			},
+		},
+		Storage: StorageConfig{
+			Driver:   getEnv("STORAGE_DRIVER", "local"),
+			LocalDir: getEnv("STORAGE_LOCAL_DIR", "bin/storage"),
+			S3: S3Config{
+				Endpoint:         getEnv("S3_ENDPOINT", ""),
+				Region:           getEnv("S3_REGION", "us-east-1"),
+				Bucket:           getEnv("S3_BUCKET", "documents"),
+				AccessKey:        getEnv("S3_ACCESS_KEY", ""),
+				SecretKey:        getEnv("S3_SECRET_KEY", ""),
+				PathStyle:        getEnvBool("S3_PATH_STYLE", true),
+				AutoCreateBucket: getEnvBool("S3_AUTO_CREATE_BUCKET", false),
+			},
 		},
-	}, nil
+		Upload: UploadConfig{
+			SessionTTL:      getEnvDuration("UPLOAD_SESSION_TTL", 24*time.Hour),
+			MaxSize:         int64(getEnvInt("UPLOAD_MAX_SIZE_MB", 10240)) << 20,
+			MaxChunkSize:    int64(getEnvInt("UPLOAD_MAX_CHUNK_MB", 32)) << 20,
+			CleanupInterval: getEnvDuration("UPLOAD_CLEANUP_INTERVAL", 15*time.Minute),
+		},
+		Reconcile: ReconcileConfig{
+			Interval: getEnvDuration("RECONCILE_INTERVAL", 6*time.Hour),
+			Grace:    getEnvDuration("RECONCILE_GRACE", time.Hour),
+			Repair:   getEnvBool("RECONCILE_REPAIR", false),
+		},
+		Notify: NotifyConfig{
+			Driver: getEnv("NOTIFY_DRIVER", "log"),
+			File:   getEnv("NOTIFY_FILE", "bin/outbox/notifications.jsonl"),
+		},
+		Search: SearchConfig{
+			IndexInterval: getEnvDuration("SEARCH_INDEX_INTERVAL", 10*time.Second),
+			BatchSize:     getEnvInt("SEARCH_INDEX_BATCH", 50),
+			MaxFileSize:   int64(getEnvInt("SEARCH_MAX_FILE_MB", 20)) << 20,
+			MaxTextSize:   getEnvInt("SEARCH_MAX_TEXT_KB", 256) << 10,
+		},
+		Trash: TrashConfig{
+			Retention:     getEnvDuration("TRASH_RETENTION", 30*24*time.Hour),
+			PurgeInterval: getEnvDuration("TRASH_PURGE_INTERVAL", time.Hour),
+			BatchSize:     getEnvInt("TRASH_PURGE_BATCH", 100),
+		},
+		Share: ShareConfig{
+			RateWindow:  getEnvDuration("SHARE_RATE_WINDOW", time.Minute),
+			RatePerIP:   getEnvInt("SHARE_RATE_IP", 30),
+			RatePerLink: getEnvInt("SHARE_RATE_LINK", 60),
+		},
+	}
+
+	switch cfg.Auth.TokenMode {
+	case TokenModeOpaque, TokenModeJWT:
+	default:
+		return nil, fmt.Errorf("unknown AUTH_TOKEN_MODE %q", cfg.Auth.TokenMode)
+	}
+
+	return cfg, nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -82,6 +294,40 @@ func getEnvInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvDuration - длительность в формате time.ParseDuration (например 30m, 24h)
+func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil && duration > 0 {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvList - список значений через запятую; пустые элементы пропускаются
+func getEnvList(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 func getTokenLifetime() time.Duration {
 	// По умолчанию 24 часа
 	defaultHours := 24
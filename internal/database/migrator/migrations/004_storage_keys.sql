@@ -0,0 +1,10 @@
+-- +goose Up
+-- file_path теперь хранит ключ объекта в хранилище, а не путь на диске
+UPDATE documents
+SET file_path = regexp_replace(file_path, '^(\./)?bin/storage/', '')
+WHERE file_path LIKE 'bin/storage/%' OR file_path LIKE './bin/storage/%';
+
+-- +goose Down
+UPDATE documents
+SET file_path = 'bin/storage/' || file_path
+WHERE file_path IS NOT NULL AND file_path <> '' AND file_path NOT LIKE 'bin/storage/%';
@@ -0,0 +1,28 @@
+-- +goose Up
+ALTER TABLE documents ADD COLUMN current_version INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE documents ADD COLUMN size_bytes BIGINT NOT NULL DEFAULT 0;
+
+CREATE TABLE document_versions (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    document_id VARCHAR(36) NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    file_path VARCHAR(500),
+    mime_type VARCHAR(255),
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    json_data JSONB,
+    author_id VARCHAR(36) REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (document_id, version)
+);
+
+CREATE INDEX idx_document_versions_document_id ON document_versions(document_id);
+
+-- Уже загруженные документы получают первую версию
+INSERT INTO document_versions (document_id, version, file_path, mime_type, size_bytes, json_data, author_id, created_at)
+SELECT id, 1, file_path, mime_type, size_bytes, json_data, user_id, created_at
+FROM documents;
+
+-- +goose Down
+DROP TABLE IF EXISTS document_versions;
+ALTER TABLE documents DROP COLUMN IF EXISTS size_bytes;
+ALTER TABLE documents DROP COLUMN IF EXISTS current_version;
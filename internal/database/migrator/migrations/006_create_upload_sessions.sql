@@ -0,0 +1,22 @@
+-- +goose Up
+CREATE TABLE upload_sessions (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    mime_type VARCHAR(255) NOT NULL,
+    is_public BOOLEAN DEFAULT false,
+    grants JSONB DEFAULT '[]'::jsonb,
+    size_bytes BIGINT NOT NULL,
+    upload_offset BIGINT NOT NULL DEFAULT 0,
+    chunks JSONB NOT NULL DEFAULT '[]'::jsonb,  -- Ключи фрагментов в хранилище по порядку
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CHECK (upload_offset >= 0 AND upload_offset <= size_bytes)
+);
+
+CREATE INDEX idx_upload_sessions_user_id ON upload_sessions(user_id);
+CREATE INDEX idx_upload_sessions_expires_at ON upload_sessions(expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS upload_sessions;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Содержимое файлов хранится по SHA-256 и разделяется между документами и версиями
+CREATE TABLE blobs (
+    digest VARCHAR(64) PRIMARY KEY,  -- SHA-256 в hex
+    storage_key VARCHAR(500) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    ref_count INTEGER NOT NULL DEFAULT 0,  -- Количество версий документов, ссылающихся на содержимое
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Для ранее загруженных файлов digest остается пустым, они удаляются по file_path как раньше
+ALTER TABLE documents ADD COLUMN digest VARCHAR(64);
+ALTER TABLE document_versions ADD COLUMN digest VARCHAR(64);
+
+CREATE INDEX idx_document_versions_digest ON document_versions(digest);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_document_versions_digest;
+ALTER TABLE document_versions DROP COLUMN IF EXISTS digest;
+ALTER TABLE documents DROP COLUMN IF EXISTS digest;
+DROP TABLE IF EXISTS blobs;
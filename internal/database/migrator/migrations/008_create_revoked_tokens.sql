@@ -0,0 +1,13 @@
+-- +goose Up
+-- Отозванные JWT: подписанные токены не хранятся в БД, поэтому выход фиксируется по jti
+CREATE TABLE revoked_tokens (
+    jti VARCHAR(36) PRIMARY KEY,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    expires_at TIMESTAMP NOT NULL,  -- Срок действия самого токена, после него запись можно удалить
+    revoked_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_revoked_tokens_expires_at ON revoked_tokens(expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS revoked_tokens;
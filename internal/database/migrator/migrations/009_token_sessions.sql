@@ -0,0 +1,11 @@
+-- +goose Up
+-- Строки tokens становятся сессиями: в режиме jwt запись хранит jti в id и не содержит самого токена
+ALTER TABLE tokens ALTER COLUMN token DROP NOT NULL;
+ALTER TABLE tokens ADD COLUMN user_agent VARCHAR(512);
+ALTER TABLE tokens ADD COLUMN ip_address VARCHAR(64);
+
+-- +goose Down
+DELETE FROM tokens WHERE token IS NULL;
+ALTER TABLE tokens DROP COLUMN IF EXISTS ip_address;
+ALTER TABLE tokens DROP COLUMN IF EXISTS user_agent;
+ALTER TABLE tokens ALTER COLUMN token SET NOT NULL;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- В БД хранится только SHA-256 токена: дамп таблицы больше не содержит действующих учетных данных
+ALTER TABLE tokens RENAME COLUMN token TO token_hash;
+ALTER TABLE tokens RENAME CONSTRAINT tokens_token_key TO tokens_token_hash_key;
+ALTER INDEX idx_tokens_token RENAME TO idx_tokens_token_hash;
+
+UPDATE tokens
+SET token_hash = encode(sha256(convert_to(token_hash, 'UTF8')), 'hex')
+WHERE token_hash IS NOT NULL;
+
+-- +goose Down
+-- Исходные значения из хешей не восстановить: выданные токены перестают действовать
+UPDATE tokens SET is_active = false WHERE token_hash IS NOT NULL;
+
+ALTER INDEX idx_tokens_token_hash RENAME TO idx_tokens_token;
+ALTER TABLE tokens RENAME CONSTRAINT tokens_token_hash_key TO tokens_token_key;
+ALTER TABLE tokens RENAME COLUMN token_hash TO token;
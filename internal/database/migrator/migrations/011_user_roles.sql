@@ -0,0 +1,7 @@
+-- +goose Up
+-- Роли пользователей вместо общего ADMIN_TOKEN: администраторы создаются командой cmd/admin
+ALTER TABLE users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'user'
+    CHECK (role IN ('admin', 'user', 'readonly', 'service'));
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS role;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Одноразовые токены сброса пароля; хранится только SHA-256 токена
+CREATE TABLE password_reset_tokens (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_by VARCHAR(36) REFERENCES users(id) ON DELETE SET NULL, -- Администратор; NULL - запрос самого пользователя
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_password_reset_tokens_user_id ON password_reset_tokens(user_id);
+CREATE INDEX idx_password_reset_tokens_expires_at ON password_reset_tokens(expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS password_reset_tokens;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Долгоживущие API-ключи для автоматизации; хранится только SHA-256 ключа
+CREATE TABLE api_keys (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,
+    key_prefix VARCHAR(16) NOT NULL, -- Начало ключа для узнавания в списке
+    scopes TEXT[] NOT NULL,
+    allowed_cidrs TEXT[] NOT NULL DEFAULT '{}', -- Пустой список - любой адрес
+    expires_at TIMESTAMP, -- NULL - бессрочный ключ
+    last_used_at TIMESTAMP,
+    last_used_ip VARCHAR(45),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_api_keys_user_id ON api_keys(user_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,11 @@
+-- +goose Up
+-- Защита входа от подбора пароля: счетчик неудачных попыток и временная блокировка
+-- хранятся в БД, чтобы переживать перезапуск и действовать на всех экземплярах
+ALTER TABLE users ADD COLUMN failed_logins INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN last_failed_login_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN locked_until TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS locked_until;
+ALTER TABLE users DROP COLUMN IF EXISTS last_failed_login_at;
+ALTER TABLE users DROP COLUMN IF EXISTS failed_logins;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- Учетные записи провайдера OpenID Connect, привязанные к пользователям
+CREATE TABLE user_identities (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    issuer VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    last_login_at TIMESTAMP,
+    UNIQUE (issuer, subject)
+);
+
+CREATE INDEX idx_user_identities_user_id ON user_identities(user_id);
+
+-- Незавершенные входы через OIDC: хранится SHA-256 параметра state, nonce и PKCE code_verifier
+CREATE TABLE oidc_states (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    state_hash VARCHAR(64) NOT NULL UNIQUE,
+    nonce VARCHAR(128) NOT NULL,
+    code_verifier VARCHAR(128) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_oidc_states_expires_at ON oidc_states(expires_at);
+
+-- +goose Down
+DROP TABLE IF EXISTS oidc_states;
+DROP TABLE IF EXISTS user_identities;
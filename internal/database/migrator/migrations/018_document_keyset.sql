@@ -0,0 +1,16 @@
+-- +goose Up
+-- Keyset-пагинация списка документов: ключ сортировки (поле, created_at, id) не должен содержать NULL
+UPDATE documents SET created_at = CURRENT_TIMESTAMP WHERE created_at IS NULL;
+ALTER TABLE documents ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX idx_documents_user_name_key ON documents(user_id, name, created_at, id);
+CREATE INDEX idx_documents_user_created_key ON documents(user_id, created_at, id);
+CREATE INDEX idx_documents_user_size_key ON documents(user_id, size_bytes, created_at, id);
+CREATE INDEX idx_documents_user_mime_key ON documents(user_id, (COALESCE(mime_type, '')), created_at, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_documents_user_mime_key;
+DROP INDEX IF EXISTS idx_documents_user_size_key;
+DROP INDEX IF EXISTS idx_documents_user_created_key;
+DROP INDEX IF EXISTS idx_documents_user_name_key;
+ALTER TABLE documents ALTER COLUMN created_at DROP NOT NULL;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- Полнотекстовый поиск: текст, извлеченный фоновым индексатором из содержимого текущей версии
+-- (text/plain, JSON, PDF) или из JSON данных документа, и вектор по имени и этому тексту
+ALTER TABLE documents ADD COLUMN content_text TEXT;
+ALTER TABLE documents ADD COLUMN indexed_version INT NOT NULL DEFAULT 0;
+ALTER TABLE documents ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', name), 'A') ||
+    setweight(to_tsvector('simple', COALESCE(content_text, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_documents_search_vector ON documents USING GIN(search_vector);
+-- Очередь индексатора: документы, текущая версия которых еще не проиндексирована
+CREATE INDEX idx_documents_unindexed ON documents(updated_at) WHERE indexed_version <> current_version;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_documents_unindexed;
+DROP INDEX IF EXISTS idx_documents_search_vector;
+ALTER TABLE documents DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE documents DROP COLUMN IF EXISTS indexed_version;
+ALTER TABLE documents DROP COLUMN IF EXISTS content_text;
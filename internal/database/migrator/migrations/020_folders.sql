@@ -0,0 +1,34 @@
+-- +goose Up
+-- Папки документов: дерево на parent_id с материализованным путем из ID ("/<корень>/.../<id>/"),
+-- по которому одним запросом находятся предки (наследование доступа) и потомки (перемещение, удаление)
+CREATE TABLE folders (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_id VARCHAR(36) REFERENCES folders(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    path TEXT NOT NULL,
+    is_public BOOLEAN NOT NULL DEFAULT false,
+    grants JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Имя папки уникально среди соседних папок без учета регистра
+CREATE UNIQUE INDEX idx_folders_sibling_name ON folders(user_id, (COALESCE(parent_id, '')), LOWER(name));
+CREATE INDEX idx_folders_parent_id ON folders(parent_id);
+CREATE INDEX idx_folders_path ON folders(path text_pattern_ops);
+
+-- Документ без папки находится в корне владельца; непустую папку удаляет только рекурсивное удаление
+ALTER TABLE documents ADD COLUMN folder_id VARCHAR(36) REFERENCES folders(id) ON DELETE RESTRICT;
+CREATE INDEX idx_documents_folder_name ON documents(user_id, folder_id, LOWER(name));
+CREATE INDEX idx_documents_folder_key ON documents(folder_id, name, created_at, id);
+
+-- Папка, в которую попадет документ после завершения загрузки (проверяется при завершении)
+ALTER TABLE upload_sessions ADD COLUMN folder_id VARCHAR(36);
+
+-- +goose Down
+ALTER TABLE upload_sessions DROP COLUMN IF EXISTS folder_id;
+DROP INDEX IF EXISTS idx_documents_folder_key;
+DROP INDEX IF EXISTS idx_documents_folder_name;
+ALTER TABLE documents DROP COLUMN IF EXISTS folder_id;
+DROP TABLE IF EXISTS folders;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Теги документов: словарь тегов владельца (имена в нижнем регистре) и связь многие-ко-многим с документами
+CREATE TABLE tags (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+CREATE TABLE document_tags (
+    document_id VARCHAR(36) NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    tag_id VARCHAR(36) NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (document_id, tag_id)
+);
+
+-- Фильтр по тегу и подсчет документов тега идут от тега к документам
+CREATE INDEX idx_document_tags_tag_id ON document_tags(tag_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS document_tags;
+DROP TABLE IF EXISTS tags;
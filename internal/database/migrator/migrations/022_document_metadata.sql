@@ -0,0 +1,9 @@
+-- +goose Up
+-- Пользовательские метаданные документа: плоский JSON объект ключ -> строка, число, логическое значение
+-- или дата (строка RFC 3339 в UTC). GIN индекс обслуживает проверку равенства по любому ключу (@>)
+ALTER TABLE documents ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
+CREATE INDEX idx_documents_metadata ON documents USING GIN(metadata jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_documents_metadata;
+ALTER TABLE documents DROP COLUMN IF EXISTS metadata;
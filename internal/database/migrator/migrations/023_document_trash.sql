@@ -0,0 +1,13 @@
+-- +goose Up
+-- Корзина: удаленный документ остается в таблице с отметкой времени удаления до восстановления,
+-- очистки корзины или окончательного удаления фоновой задачей по истечении срока хранения
+ALTER TABLE documents ADD COLUMN deleted_at TIMESTAMP;
+
+-- Корзина пользователя и очередь окончательного удаления читают только удаленные документы
+CREATE INDEX idx_documents_trash ON documents(user_id, deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_documents_deleted_at ON documents(deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_documents_deleted_at;
+DROP INDEX IF EXISTS idx_documents_trash;
+ALTER TABLE documents DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Публичные ссылки на документы: доступ по случайному slug без сессии, с необязательными сроком действия,
+-- паролем (bcrypt) и лимитом скачиваний. Отозванная ссылка остается в таблице вместе со счетчиками
+CREATE TABLE share_links (
+    id VARCHAR(36) PRIMARY KEY DEFAULT uuid_generate_v4()::text,
+    document_id VARCHAR(36) NOT NULL REFERENCES documents(id) ON DELETE CASCADE,
+    user_id VARCHAR(36) NOT NULL REFERENCES users(id) ON DELETE CASCADE, -- Создатель ссылки
+    slug VARCHAR(64) NOT NULL UNIQUE,
+    password_hash VARCHAR(255), -- NULL - ссылка без пароля
+    expires_at TIMESTAMP, -- NULL - бессрочная ссылка
+    max_downloads INTEGER, -- NULL - без ограничения
+    download_count INTEGER NOT NULL DEFAULT 0,
+    last_accessed_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_share_links_document_id ON share_links(document_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS share_links;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- Имя документа уникально среди документов владельца в папке без учета регистра; документы в корзине
+-- не учитываются. Проверка в сервисе дает понятную ошибку, индекс исключает гонку проверки и вставки
+-- Совпадения, появившиеся до индекса, получают суффикс из ID документа перед расширением
+UPDATE documents d SET name = regexp_replace(LEFT(d.name, 240), '(\.[^.]+)?$', ' (' || LEFT(d.id, 8) || ')\1')
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, COALESCE(folder_id, ''), LOWER(name) ORDER BY created_at, id) AS n
+    FROM documents
+    WHERE deleted_at IS NULL
+) duplicates
+WHERE d.id = duplicates.id AND duplicates.n > 1;
+
+CREATE UNIQUE INDEX idx_documents_unique_name ON documents(user_id, (COALESCE(folder_id, '')), LOWER(name))
+    WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_documents_unique_name;
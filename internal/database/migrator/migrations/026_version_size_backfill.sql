@@ -0,0 +1,14 @@
+-- +goose Up
+-- Миграция 005 перенесла уже загруженные файлы в первую версию с size_bytes = 0: настоящий размер
+-- знает только хранилище. Очередь разбирает фоновая задача SizeBackfill через Stat; пустые файлы
+-- неотличимы от перенесенных и проходят ту же проверку
+CREATE TABLE size_backfill (
+    version_id VARCHAR(36) PRIMARY KEY REFERENCES document_versions(id) ON DELETE CASCADE
+);
+
+INSERT INTO size_backfill (version_id)
+SELECT id FROM document_versions
+WHERE COALESCE(file_path, '') <> '' AND size_bytes = 0;
+
+-- +goose Down
+DROP TABLE IF EXISTS size_backfill;
@@ -0,0 +1,128 @@
+package extract
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// textTypes - MIME-типы, содержимое которых индексируется как обычный текст
+var textTypes = map[string]bool{
+	"text/plain":    true,
+	"text/markdown": true,
+	"text/csv":      true,
+}
+
+// Supported - из содержимого такого MIME-типа извлекается текст
+func Supported(mimeType string) bool {
+	switch mediaType(mimeType) {
+	case "application/json", "application/pdf":
+		return true
+	default:
+		return textTypes[mediaType(mimeType)]
+	}
+}
+
+// Text - извлечение текста из содержимого файла; результат очищен от управляющих символов
+// и обрезан до maxText байт. Для неподдерживаемого типа возвращается пустая строка
+func Text(mimeType string, r io.Reader, maxText int) (string, error) {
+	switch media := mediaType(mimeType); {
+	case textTypes[media]:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		return Clean(string(data), maxText), nil
+	case media == "application/json":
+		var data any
+		if err := json.NewDecoder(r).Decode(&data); err != nil {
+			return "", fmt.Errorf("invalid JSON: %w", err)
+		}
+		return JSONText(data, maxText), nil
+	case media == "application/pdf":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return "", err
+		}
+		text, err := pdfText(data)
+		if err != nil {
+			return "", err
+		}
+		return Clean(text, maxText), nil
+	}
+	return "", nil
+}
+
+// JSONText - строковые и числовые значения JSON (без ключей) через перевод строки
+func JSONText(data any, maxText int) string {
+	var b strings.Builder
+	collectJSON(&b, data, maxText)
+	return Clean(b.String(), maxText)
+}
+
+// collectJSON - обход JSON в порядке ключей, пока текст не превысит лимит
+func collectJSON(b *strings.Builder, value any, maxText int) {
+	if b.Len() > maxText {
+		return
+	}
+	switch v := value.(type) {
+	case string:
+		b.WriteString(v)
+		b.WriteByte('\n')
+	case float64:
+		b.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+		b.WriteByte('\n')
+	case []any:
+		for _, item := range v {
+			collectJSON(b, item, maxText)
+		}
+	case map[string]any:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			collectJSON(b, v[key], maxText)
+		}
+	}
+}
+
+// Clean - текст в корректном UTF-8 без управляющих символов (кроме перевода строки и табуляции),
+// не длиннее maxText байт
+func Clean(text string, maxText int) string {
+	text = strings.ToValidUTF8(text, "")
+	text = strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return r
+		}
+		if unicode.IsControl(r) || r == utf8.RuneError {
+			return -1
+		}
+		return r
+	}, text)
+
+	if maxText > 0 && len(text) > maxText {
+		cut := maxText
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		text = text[:cut]
+	}
+	return strings.TrimSpace(text)
+}
+
+// mediaType - MIME-тип без параметров в нижнем регистре
+func mediaType(mimeType string) string {
+	media, _, err := mime.ParseMediaType(mimeType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(mimeType))
+	}
+	return media
+}
@@ -0,0 +1,337 @@
+package extract
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxPDFStream - предел размера распакованного потока (защита от zip-бомб)
+const maxPDFStream = 32 << 20
+
+// pdfSkipStreams - признаки потоков, в которых нет текста страниц (изображения, шрифты, служебные таблицы)
+var pdfSkipStreams = [][]byte{
+	[]byte("/Image"), []byte("/XRef"), []byte("/ObjStm"), []byte("/Metadata"),
+	[]byte("/Length1"), []byte("/Length2"), []byte("/Length3"), []byte("/EmbeddedFile"),
+}
+
+// pdfOperand - операнд оператора потока содержимого
+type pdfOperand struct {
+	text     string  // Строка (для строковых операндов)
+	number   float64 // Число (для числовых операндов)
+	isString bool
+	isNumber bool
+}
+
+// pdfText - текст из потоков содержимого PDF: строки операторов Tj, TJ, ' и " внутри BT/ET.
+// Поддерживаются несжатые потоки и FlateDecode; текст шрифтов с собственной кодировкой
+// (CID-шрифты без стандартной кодировки) не восстанавливается
+func pdfText(data []byte) (string, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("%PDF-")) {
+		return "", fmt.Errorf("not a PDF file")
+	}
+
+	var out strings.Builder
+	pos := 0
+	for {
+		i := bytes.Index(data[pos:], []byte("stream"))
+		if i < 0 {
+			break
+		}
+		start := pos + i
+		if start >= 3 && string(data[start-3:start]) == "end" {
+			pos = start + len("stream")
+			continue
+		}
+
+		// Словарь потока - от заголовка "N 0 obj" до ключевого слова stream
+		dict := data[:start]
+		if header := bytes.LastIndex(dict, []byte("obj")); header >= 0 {
+			dict = dict[header:]
+		}
+
+		body := start + len("stream")
+		if body < len(data) && data[body] == '\r' {
+			body++
+		}
+		if body < len(data) && data[body] == '\n' {
+			body++
+		}
+		end := bytes.Index(data[body:], []byte("endstream"))
+		if end < 0 {
+			break
+		}
+		pos = body + end + len("endstream")
+
+		content, ok := decodePDFStream(dict, data[body:body+end])
+		if !ok {
+			continue
+		}
+		if text := pdfContentText(content); text != "" {
+			out.WriteString(text)
+			out.WriteByte('\n')
+		}
+	}
+	return out.String(), nil
+}
+
+// decodePDFStream - содержимое потока; false - поток не содержит текста или сжат неподдерживаемым фильтром
+func decodePDFStream(dict, raw []byte) ([]byte, bool) {
+	for _, marker := range pdfSkipStreams {
+		if bytes.Contains(dict, marker) {
+			return nil, false
+		}
+	}
+	if !bytes.Contains(dict, []byte("/Filter")) {
+		return raw, true
+	}
+
+	// Из фильтров поддерживается только FlateDecode без предиктора
+	filters := bytes.Count(dict, []byte("Decode"))
+	if filters != 1 || !bytes.Contains(dict, []byte("/FlateDecode")) || bytes.Contains(dict, []byte("/Predictor")) {
+		return nil, false
+	}
+	zr, err := zlib.NewReader(bytes.NewReader(raw))
+	if err != nil {
+		return nil, false
+	}
+	defer zr.Close()
+
+	// Поток с поврежденным концом все равно отдает распакованное начало
+	content, err := io.ReadAll(io.LimitReader(zr, maxPDFStream))
+	if err != nil && len(content) == 0 {
+		return nil, false
+	}
+	return content, true
+}
+
+// pdfContentText - строки операторов показа текста из потока содержимого страницы
+func pdfContentText(content []byte) string {
+	if !bytes.Contains(content, []byte("BT")) {
+		return ""
+	}
+
+	var out strings.Builder
+	var operands []pdfOperand
+	inText := false
+	for i := 0; i < len(content); {
+		c := content[i]
+		switch {
+		case isPDFSpace(c):
+			i++
+		case c == '%':
+			for i < len(content) && content[i] != '\n' && content[i] != '\r' {
+				i++
+			}
+		case c == '(':
+			text, n := pdfLiteralString(content[i:])
+			operands = append(operands, pdfOperand{text: text, isString: true})
+			i += n
+		case c == '<' && i+1 < len(content) && content[i+1] == '<':
+			i += 2
+		case c == '<':
+			text, n := pdfHexString(content[i:])
+			operands = append(operands, pdfOperand{text: text, isString: true})
+			i += n
+		case c == '>' || c == '[' || c == ']' || c == '{' || c == '}':
+			i++
+		case c == '/':
+			i++
+			for i < len(content) && !isPDFSpace(content[i]) && !isPDFDelimiter(content[i]) {
+				i++
+			}
+		default:
+			start := i
+			for i < len(content) && !isPDFSpace(content[i]) && !isPDFDelimiter(content[i]) {
+				i++
+			}
+			if i == start {
+				i++
+				continue
+			}
+			token := string(content[start:i])
+			if number, err := strconv.ParseFloat(token, 64); err == nil {
+				operands = append(operands, pdfOperand{number: number, isNumber: true})
+				continue
+			}
+
+			switch token {
+			case "BT":
+				inText = true
+			case "ET":
+				inText = false
+				out.WriteByte('\n')
+			case "Tj", "'", "\"":
+				if inText {
+					if token != "Tj" {
+						out.WriteByte('\n')
+					}
+					if last := len(operands) - 1; last >= 0 && operands[last].isString {
+						out.WriteString(operands[last].text)
+					}
+				}
+			case "TJ":
+				if inText {
+					for _, op := range operands {
+						switch {
+						case op.isString:
+							out.WriteString(op.text)
+						case op.isNumber && op.number < -200:
+							// Большой сдвиг между фрагментами - пробел между словами
+							out.WriteByte(' ')
+						}
+					}
+				}
+			case "Td", "TD", "Tm":
+				if inText {
+					out.WriteByte(' ')
+				}
+			case "T*":
+				if inText {
+					out.WriteByte('\n')
+				}
+			case "BI":
+				i = skipInlineImage(content, i)
+			}
+			operands = operands[:0]
+		}
+	}
+	return out.String()
+}
+
+// pdfLiteralString - строка в круглых скобках с учетом вложенности и escape-последовательностей
+func pdfLiteralString(data []byte) (string, int) {
+	var raw []byte
+	depth := 0
+	i := 0
+	for i < len(data) {
+		c := data[i]
+		i++
+		switch c {
+		case '(':
+			if depth > 0 {
+				raw = append(raw, c)
+			}
+			depth++
+			continue
+		case ')':
+			depth--
+			if depth == 0 {
+				return decodePDFString(raw), i
+			}
+			raw = append(raw, c)
+			continue
+		case '\\':
+		default:
+			raw = append(raw, c)
+			continue
+		}
+
+		if i >= len(data) {
+			break
+		}
+		e := data[i]
+		i++
+		switch e {
+		case 'n':
+			raw = append(raw, '\n')
+		case 'r':
+			raw = append(raw, '\r')
+		case 't':
+			raw = append(raw, '\t')
+		case 'b':
+			raw = append(raw, '\b')
+		case 'f':
+			raw = append(raw, '\f')
+		case '\r':
+			// Перенос строки внутри строки не входит в нее
+			if i < len(data) && data[i] == '\n' {
+				i++
+			}
+		case '\n':
+		default:
+			if e >= '0' && e <= '7' {
+				value := int(e - '0')
+				for n := 0; n < 2 && i < len(data) && data[i] >= '0' && data[i] <= '7'; n++ {
+					value = value*8 + int(data[i]-'0')
+					i++
+				}
+				raw = append(raw, byte(value))
+			} else {
+				raw = append(raw, e)
+			}
+		}
+	}
+	return decodePDFString(raw), i
+}
+
+// pdfHexString - строка в угловых скобках из шестнадцатеричных цифр
+func pdfHexString(data []byte) (string, int) {
+	var raw []byte
+	var digits []byte
+	i := 1
+	for i < len(data) && data[i] != '>' {
+		if value, ok := hexValue(data[i]); ok {
+			digits = append(digits, value)
+		}
+		i++
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, 0)
+	}
+	for j := 0; j < len(digits); j += 2 {
+		raw = append(raw, digits[j]<<4|digits[j+1])
+	}
+	return decodePDFString(raw), i + 1
+}
+
+// decodePDFString - строка PDF в UTF-8: UTF-16BE с BOM или однобайтовая кодировка (PDFDocEncoding ~ Latin-1)
+func decodePDFString(raw []byte) string {
+	if len(raw) >= 2 && raw[0] == 0xFE && raw[1] == 0xFF {
+		units := make([]uint16, 0, len(raw)/2)
+		for j := 2; j+1 < len(raw); j += 2 {
+			units = append(units, uint16(raw[j])<<8|uint16(raw[j+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+
+	runes := make([]rune, len(raw))
+	for j, b := range raw {
+		runes[j] = rune(b)
+	}
+	return string(runes)
+}
+
+// skipInlineImage - позиция после данных встроенного изображения (BI ... ID <данные> EI)
+func skipInlineImage(content []byte, i int) int {
+	for j := i; j+2 < len(content); j++ {
+		if content[j] == 'E' && content[j+1] == 'I' && isPDFSpace(content[j-1]) && (j+2 == len(content) || isPDFSpace(content[j+2])) {
+			return j + 2
+		}
+	}
+	return len(content)
+}
+
+func isPDFSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
@@ -0,0 +1,182 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// ReconcileReport - результат сверки хранилища и БД
+type ReconcileReport struct {
+	OrphanObjects    []string           // Объекты хранилища, на которые не ссылается ни одна строка
+	StaleTempFiles   []string           // Временные файлы записей, прерванных сбоем до переименования
+	MissingContent   []model.StoredFile // Версии документов, содержимого которых нет в хранилище
+	BlobRefMismatch  int                // Расхождения счетчиков ссылок в blobs
+	DeletedObjects   int                // Удалено осиротевших объектов (repair)
+	DeletedTempFiles int                // Удалено временных файлов (repair)
+	DeletedDocuments int                // Удалено документов без содержимого текущей версии (repair)
+}
+
+// HasProblems - найдено хотя бы одно расхождение
+func (r ReconcileReport) HasProblems() bool {
+	return len(r.OrphanObjects) > 0 || len(r.StaleTempFiles) > 0 || len(r.MissingContent) > 0 || r.BlobRefMismatch > 0
+}
+
+// Reconciler - сверка объектов хранилища со строками documents/document_versions/blobs
+type Reconciler struct {
+	service  service.FileServerService
+	storage  storage.BlobStore
+	interval time.Duration
+	grace    time.Duration
+	repair   bool
+}
+
+func NewReconciler(service service.FileServerService, storage storage.BlobStore, cfg config.ReconcileConfig) *Reconciler {
+	return &Reconciler{
+		service:  service,
+		storage:  storage,
+		interval: cfg.Interval,
+		grace:    cfg.Grace,
+		repair:   cfg.Repair,
+	}
+}
+
+// Run - периодическая сверка до отмены контекста
+func (r *Reconciler) Run(ctx context.Context) {
+	log.Printf("🧹 Jobs: Сверка хранилища и БД каждые %s (исправление: %t)", r.interval, r.repair)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		report, err := r.Reconcile(ctx)
+		if err != nil {
+			log.Printf("🚨 Jobs: Ошибка сверки хранилища: %v", err)
+			continue
+		}
+		if report.HasProblems() {
+			log.Printf("🧹 Jobs: Сверка: осиротевших объектов %d, временных файлов %d, версий без содержимого %d, расхождений ссылок %d; удалено объектов %d, временных файлов %d, документов %d",
+				len(report.OrphanObjects), len(report.StaleTempFiles), len(report.MissingContent), report.BlobRefMismatch,
+				report.DeletedObjects, report.DeletedTempFiles, report.DeletedDocuments)
+		}
+	}
+}
+
+// Reconcile - однократная сверка; при включенном исправлении удаляет осиротевшие объекты,
+// документы без содержимого текущей версии и пересчитывает ссылки на содержимое
+func (r *Reconciler) Reconcile(ctx context.Context) (ReconcileReport, error) {
+	var report ReconcileReport
+
+	// Пересчет идет первым: записи blobs без ссылок удаляются, и их объекты попадают в осиротевшие ниже
+	mismatches, err := r.service.ReconcileBlobReferences(ctx, r.repair)
+	if err != nil {
+		return report, err
+	}
+	report.BlobRefMismatch = mismatches
+
+	// Ссылки читаются до листинга хранилища: объект, созданный после чтения, защищен grace-периодом
+	files, err := r.service.GetStoredFiles(ctx)
+	if err != nil {
+		return report, err
+	}
+	referenced := make(map[string]bool, len(files))
+	for _, f := range files {
+		referenced[f.Key] = true
+	}
+
+	objects, err := r.storage.List(ctx, "")
+	if err != nil {
+		return report, err
+	}
+	existing := make(map[string]bool, len(objects))
+	cutoff := time.Now().Add(-r.grace)
+	for _, obj := range objects {
+		existing[obj.Key] = true
+
+		// Временный файл записи, прерванной сбоем: ссылок на него быть не может, возраст проверило хранилище
+		if storage.IsTempKey(obj.Key) {
+			report.StaleTempFiles = append(report.StaleTempFiles, obj.Key)
+			continue
+		}
+		// Фрагменты незавершенных загрузок принадлежат сессиям и удаляются UploadCleaner
+		if strings.HasPrefix(obj.Key, "uploads/") || referenced[obj.Key] || obj.ModTime.After(cutoff) {
+			continue
+		}
+		report.OrphanObjects = append(report.OrphanObjects, obj.Key)
+	}
+
+	for _, f := range files {
+		if !existing[f.Key] {
+			report.MissingContent = append(report.MissingContent, f)
+		}
+	}
+
+	if r.repair {
+		report.DeletedObjects = r.deleteOrphanObjects(ctx, report.OrphanObjects)
+		report.DeletedTempFiles = r.deleteTempFiles(ctx, report.StaleTempFiles)
+		report.DeletedDocuments = r.deleteBrokenDocuments(ctx, report.MissingContent)
+	}
+
+	return report, nil
+}
+
+// deleteOrphanObjects - удаление объектов, ссылка на которые так и не появилась
+// (содержимое могло быть переиспользовано новой загрузкой после чтения ссылок - это проверяет DeleteReleasedObjects)
+func (r *Reconciler) deleteOrphanObjects(ctx context.Context, keys []string) int {
+	return r.service.DeleteReleasedObjects(ctx, keys, r.storage.Delete)
+}
+
+// deleteTempFiles - удаление временных файлов прерванных записей напрямую: содержимое под ними
+// не принадлежит ни одному ключу, поэтому проверка ссылок не нужна
+func (r *Reconciler) deleteTempFiles(ctx context.Context, keys []string) int {
+	deleted := 0
+	for _, key := range keys {
+		if err := r.storage.Delete(ctx, key); err != nil {
+			log.Printf("🚨 Jobs: Не удалось удалить временный файл %s: %v", key, err)
+			continue
+		}
+		deleted++
+	}
+	return deleted
+}
+
+// deleteBrokenDocuments - удаление документов, содержимое текущей версии которых потеряно.
+// Потерянные старые версии только попадают в отчет: документ остается рабочим
+func (r *Reconciler) deleteBrokenDocuments(ctx context.Context, missing []model.StoredFile) int {
+	deleted := 0
+	for _, f := range missing {
+		if !f.Current {
+			continue
+		}
+		// Объект мог появиться или документ мог получить новую версию после листинга
+		if _, err := r.storage.Stat(ctx, f.Key); !errors.Is(err, model.ErrNotFound) {
+			continue
+		}
+		doc, err := r.service.GetDocument(ctx, f.DocumentID)
+		if err != nil || doc.FilePath != f.Key {
+			continue
+		}
+
+		released, err := r.service.PurgeDocument(ctx, f.DocumentID)
+		if err != nil {
+			log.Printf("🚨 Jobs: Не удалось удалить документ %s без содержимого: %v", f.DocumentID, err)
+			continue
+		}
+		r.service.DeleteReleasedObjects(ctx, released, r.storage.Delete)
+		deleted++
+	}
+	return deleted
+}
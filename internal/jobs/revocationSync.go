@@ -0,0 +1,43 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/service"
+)
+
+// RevocationSync - периодическая загрузка отозванных JWT из БД, чтобы выход на одном экземпляре
+// сервиса действовал и на остальных
+type RevocationSync struct {
+	service  service.FileServerService
+	interval time.Duration
+}
+
+func NewRevocationSync(service service.FileServerService, interval time.Duration) *RevocationSync {
+	return &RevocationSync{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run - синхронизация до отмены контекста (первая - сразу при запуске)
+func (j *RevocationSync) Run(ctx context.Context) {
+	log.Printf("🧹 Jobs: Синхронизация отозванных токенов каждые %s", j.interval)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.service.SyncRevokedTokens(ctx); err != nil {
+			log.Printf("🚨 Jobs: Ошибка синхронизации отозванных токенов: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
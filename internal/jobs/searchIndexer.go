@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/extract"
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// SearchIndexer - фоновое извлечение текста из документов для полнотекстового поиска,
+// чтобы загрузка и изменение документов не ждали разбора содержимого
+type SearchIndexer struct {
+	service     service.FileServerService
+	storage     storage.BlobStore
+	interval    time.Duration
+	batch       int
+	maxFileSize int64
+	maxText     int
+}
+
+func NewSearchIndexer(service service.FileServerService, storage storage.BlobStore, cfg config.SearchConfig) *SearchIndexer {
+	return &SearchIndexer{
+		service:     service,
+		storage:     storage,
+		interval:    cfg.IndexInterval,
+		batch:       cfg.BatchSize,
+		maxFileSize: cfg.MaxFileSize,
+		maxText:     cfg.MaxTextSize,
+	}
+}
+
+// Run - периодическая индексация до отмены контекста
+func (i *SearchIndexer) Run(ctx context.Context) {
+	log.Printf("🔎 Jobs: Индексация документов для поиска каждые %s", i.interval)
+
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		if indexed, err := i.IndexPending(ctx); err != nil {
+			log.Printf("🚨 Jobs: Ошибка индексации документов: %v", err)
+		} else if indexed > 0 {
+			log.Printf("🔎 Jobs: Проиндексировано документов: %d", indexed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// IndexPending - индексация очереди пачками, пока она не опустеет или пачка не перестанет продвигаться.
+// Документ, содержимое которого не удалось прочитать, остается в очереди до следующего прохода
+func (i *SearchIndexer) IndexPending(ctx context.Context) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		docs, err := i.service.GetDocumentsToIndex(ctx, i.batch)
+		if err != nil {
+			return total, err
+		}
+
+		indexed := 0
+		for _, doc := range docs {
+			text, err := i.documentText(ctx, doc)
+			if err != nil {
+				log.Printf("🚨 Jobs: Не удалось прочитать документ %s для индексации: %v", doc.ID, err)
+				continue
+			}
+			if err := i.service.SetDocumentText(ctx, doc.ID, doc.Version, text); err != nil {
+				log.Printf("🚨 Jobs: Не удалось сохранить текст документа %s: %v", doc.ID, err)
+				continue
+			}
+			indexed++
+		}
+		total += indexed
+
+		if len(docs) < i.batch || indexed == 0 {
+			break
+		}
+	}
+	return total, nil
+}
+
+// documentText - текст текущей версии: строки JSON данных или содержимое поддерживаемого файла.
+// Ошибка возвращается только при недоступном хранилище; неразборчивое содержимое индексируется без текста
+func (i *SearchIndexer) documentText(ctx context.Context, doc model.Document) (string, error) {
+	if !doc.IsFile {
+		return extract.JSONText(map[string]any(doc.JSONData), i.maxText), nil
+	}
+	if !extract.Supported(doc.MimeType) || doc.Size > i.maxFileSize || doc.FilePath == "" {
+		return "", nil
+	}
+
+	r, _, err := i.storage.Get(ctx, doc.FilePath)
+	if errors.Is(err, model.ErrNotFound) {
+		// Потерянное содержимое - забота сверки хранилища, индексировать нечего
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	text, err := extract.Text(doc.MimeType, io.LimitReader(r, i.maxFileSize), i.maxText)
+	if err != nil {
+		log.Printf("🔎 Jobs: Текст документа %s (%s) не извлечен: %v", doc.ID, doc.MimeType, err)
+		return "", nil
+	}
+	return text, nil
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// sizeBackfillBatch - версий за один запрос к очереди
+const sizeBackfillBatch = 100
+
+// SizeBackfill - разовое заполнение размеров версий, перенесенных миграцией 005 с size_bytes = 0,
+// по метаданным объектов хранилища (очередь size_backfill, см. миграцию 026)
+type SizeBackfill struct {
+	service service.FileServerService
+	storage storage.BlobStore
+}
+
+func NewSizeBackfill(service service.FileServerService, storage storage.BlobStore) *SizeBackfill {
+	return &SizeBackfill{
+		service: service,
+		storage: storage,
+	}
+}
+
+// Run - разбор очереди до конца; версии, объект которых не удалось прочитать, останутся
+// в очереди до следующего запуска сервера
+func (b *SizeBackfill) Run(ctx context.Context) {
+	filled, err := b.Backfill(ctx)
+	if err != nil {
+		log.Printf("🚨 Jobs: Ошибка заполнения размеров версий: %v", err)
+	}
+	if filled > 0 {
+		log.Printf("🧹 Jobs: Заполнены размеры версий: %d", filled)
+	}
+}
+
+// Backfill - заполнение размеров пачками, пока очередь не опустеет или пачка не перестанет продвигаться.
+// Возвращает число обработанных версий
+func (b *SizeBackfill) Backfill(ctx context.Context) (int, error) {
+	total := 0
+	for ctx.Err() == nil {
+		versions, err := b.service.GetVersionsToSize(ctx, sizeBackfillBatch)
+		if err != nil {
+			return total, err
+		}
+
+		filled := 0
+		for _, v := range versions {
+			info, err := b.storage.Stat(ctx, v.FilePath)
+			if errors.Is(err, model.ErrNotFound) {
+				// Потерянное содержимое - забота сверки хранилища, размер остается нулевым
+				log.Printf("🧹 Jobs: Объект %s версии %d документа %s не найден, размер не заполнен", v.FilePath, v.Version, v.DocumentID)
+				info.Size = 0
+			} else if err != nil {
+				log.Printf("🚨 Jobs: Не удалось получить размер объекта %s: %v", v.FilePath, err)
+				continue
+			}
+
+			if err := b.service.SetVersionSize(ctx, v, info.Size); err != nil {
+				log.Printf("🚨 Jobs: Не удалось сохранить размер версии %d документа %s: %v", v.Version, v.DocumentID, err)
+				continue
+			}
+			filled++
+		}
+		total += filled
+
+		if len(versions) < sizeBackfillBatch || filled == 0 {
+			break
+		}
+	}
+	return total, nil
+}
@@ -0,0 +1,77 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// TrashPurger - фоновое окончательное удаление документов, пролежавших в корзине дольше срока хранения,
+// вместе с файлами, на которые больше не ссылается ни один документ
+type TrashPurger struct {
+	service   service.FileServerService
+	storage   storage.BlobStore
+	retention time.Duration
+	interval  time.Duration
+	batch     int
+}
+
+func NewTrashPurger(service service.FileServerService, storage storage.BlobStore, cfg config.TrashConfig) *TrashPurger {
+	return &TrashPurger{
+		service:   service,
+		storage:   storage,
+		retention: cfg.Retention,
+		interval:  cfg.PurgeInterval,
+		batch:     cfg.BatchSize,
+	}
+}
+
+// Run - периодическая очистка корзин до отмены контекста
+func (p *TrashPurger) Run(ctx context.Context) {
+	log.Printf("🧹 Jobs: Удаление документов из корзины старше %s каждые %s", p.retention, p.interval)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if purged, err := p.Purge(ctx); err != nil {
+			log.Printf("🚨 Jobs: Ошибка очистки корзины: %v", err)
+		} else if purged > 0 {
+			log.Printf("🧹 Jobs: Окончательно удалено документов из корзины: %d", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge - однократное удаление всех документов с истекшим сроком хранения пачками,
+// возвращает количество удаленных
+func (p *TrashPurger) Purge(ctx context.Context) (int, error) {
+	before := time.Now().UTC().Add(-p.retention)
+
+	total := 0
+	for ctx.Err() == nil {
+		result, err := p.service.PurgeExpiredTrash(ctx, before, p.batch)
+
+		// Строки документов уже удалены: их файлы удаляются и при ошибке на следующем документе
+		p.service.DeleteReleasedObjects(ctx, result.Released, p.storage.Delete)
+		total += len(result.PurgedDocuments)
+
+		if err != nil {
+			return total, err
+		}
+		// Неполная пачка - очередь пуста (или часть документов успели восстановить)
+		if len(result.PurgedDocuments) < p.batch {
+			break
+		}
+	}
+	return total, nil
+}
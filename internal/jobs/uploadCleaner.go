@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/service"
+	"github.com/NarthurN/FileServerService/internal/storage"
+)
+
+// UploadCleaner - фоновое удаление истекших сессий загрузки вместе с их фрагментами
+type UploadCleaner struct {
+	service  service.FileServerService
+	storage  storage.BlobStore
+	interval time.Duration
+}
+
+func NewUploadCleaner(service service.FileServerService, storage storage.BlobStore, interval time.Duration) *UploadCleaner {
+	return &UploadCleaner{
+		service:  service,
+		storage:  storage,
+		interval: interval,
+	}
+}
+
+// Run - периодическая очистка до отмены контекста
+func (c *UploadCleaner) Run(ctx context.Context) {
+	log.Printf("🧹 Jobs: Очистка истекших загрузок каждые %s", c.interval)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		if removed, err := c.Cleanup(ctx); err != nil {
+			log.Printf("🚨 Jobs: Ошибка очистки загрузок: %v", err)
+		} else if removed > 0 {
+			log.Printf("🧹 Jobs: Удалено истекших загрузок: %d", removed)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Cleanup - однократное удаление всех истекших сессий, возвращает количество удаленных
+func (c *UploadCleaner) Cleanup(ctx context.Context) (int, error) {
+	sessions, err := c.service.GetExpiredUploadSessions(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, session := range sessions {
+		// Сначала удаляем фрагменты: если упадем между шагами, сессия останется и будет обработана повторно
+		if err := storage.DeletePrefix(ctx, c.storage, storage.UploadPrefix(session.ID)); err != nil {
+			log.Printf("🚨 Jobs: Не удалось удалить фрагменты загрузки %s: %v", session.ID, err)
+			continue
+		}
+		if err := c.service.DeleteUploadSession(ctx, session.ID); err != nil {
+			log.Printf("🚨 Jobs: Не удалось удалить сессию загрузки %s: %v", session.ID, err)
+			continue
+		}
+		removed++
+	}
+
+	return removed, nil
+}
@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// Scope - разрешение API-ключа на группу операций
+type Scope string
+
+const (
+	ScopeDocsRead   Scope = "docs:read"   // Чтение документов и их версий
+	ScopeDocsWrite  Scope = "docs:write"  // Создание и изменение документов, загрузки
+	ScopeDocsDelete Scope = "docs:delete" // Удаление документов
+	ScopeAdmin      Scope = "admin"       // Управление пользователями (только для администраторов)
+)
+
+// Valid - разрешение входит в список известных
+func (s Scope) Valid() bool {
+	switch s {
+	case ScopeDocsRead, ScopeDocsWrite, ScopeDocsDelete, ScopeAdmin:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey - долгоживущий ключ доступа для автоматизации, принадлежащий пользователю
+type APIKey struct {
+	ID           string     `json:"id" db:"id"`
+	UserID       string     `json:"user_id" db:"user_id"`
+	Name         string     `json:"name" db:"name"`
+	KeyHash      string     `json:"-" db:"key_hash"`        // SHA-256 ключа
+	KeyPrefix    string     `json:"prefix" db:"key_prefix"` // Начало ключа для узнавания в списке
+	Scopes       []Scope    `json:"scopes" db:"scopes"`
+	AllowedCIDRs []string   `json:"allowed_cidrs" db:"allowed_cidrs"` // Пустой список - любой адрес
+	ExpiresAt    *time.Time `json:"expires_at" db:"expires_at"`       // nil - бессрочный ключ
+	LastUsedAt   *time.Time `json:"last_used_at" db:"last_used_at"`
+	LastUsedIP   string     `json:"last_used_ip" db:"last_used_ip"`
+	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
+}
+
+// HasScope - ключ дает разрешение scope
+func (k APIKey) HasScope(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAPIKey - выпущенный API-ключ (значение ключа известно только в момент выпуска)
+type NewAPIKey struct {
+	APIKey APIKey
+	Key    string
+}
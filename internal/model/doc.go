@@ -1,28 +1,137 @@
 package model
 
 import (
+	"context"
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 )
 
 // Document - репозиторная модель документа
 type Document struct {
-	ID        string      `db:"id" json:"id"`                    // ID документа
-	UserID    string      `db:"user_id" json:"-"`                // ID пользователя
-	Name      string      `db:"name" json:"name"`                // Название документа
-	MimeType  string      `db:"mime_type" json:"mime"`           // MIME-тип документа
-	FilePath  string      `db:"file_path" json:"-"`              // Путь к файлу
-	IsFile    bool        `db:"is_file" json:"file"`             // Флаг, является ли файл
-	IsPublic  bool        `db:"is_public" json:"public"`         // Флаг, является ли документ публичным
-	JSONData  JSONData    `db:"json_data" json:"json,omitempty"` // JSON данные документа
-	Grants    StringArray `db:"grants" json:"grant"`             // Массив логинов с доступом
-	CreatedAt time.Time   `db:"created_at" json:"created"`       // Дата создания документа
-	UpdatedAt time.Time   `db:"updated_at" json:"-"`             // Дата обновления документа
+	ID        string      `db:"id" json:"id"`                        // ID документа
+	UserID    string      `db:"user_id" json:"-"`                    // ID пользователя
+	Name      string      `db:"name" json:"name"`                    // Название документа
+	MimeType  string      `db:"mime_type" json:"mime"`               // MIME-тип документа
+	FilePath  string      `db:"file_path" json:"-"`                  // Путь к файлу
+	IsFile    bool        `db:"is_file" json:"file"`                 // Флаг, является ли файл
+	IsPublic  bool        `db:"is_public" json:"public"`             // Флаг, является ли документ публичным
+	JSONData  JSONData    `db:"json_data" json:"json,omitempty"`     // JSON данные документа
+	Grants    StringArray `db:"grants" json:"grant"`                 // Массив логинов с доступом
+	Version   int         `db:"current_version" json:"version"`      // Номер текущей версии
+	Size      int64       `db:"size_bytes" json:"size"`              // Размер текущей версии в байтах
+	Digest    string      `db:"digest" json:"digest,omitempty"`      // SHA-256 содержимого текущей версии (hex)
+	CreatedAt time.Time   `db:"created_at" json:"created"`           // Дата создания документа
+	UpdatedAt time.Time   `db:"updated_at" json:"-"`                 // Дата обновления документа
+	FolderID  string      `db:"folder_id" json:"folder,omitempty"`   // ID папки (пустой - корень владельца)
+	Tags      StringArray `db:"tags" json:"tags"`                    // Теги документа по алфавиту
+	Metadata  JSONData    `db:"metadata" json:"metadata"`            // Пользовательские метаданные ключ -> значение
+	DeletedAt *time.Time  `db:"deleted_at" json:"deleted,omitempty"` // Дата перемещения в корзину (nil - документ не удален)
 }
 
+// DocumentUpdate - частичное обновление документа (nil - поле не меняется)
+type DocumentUpdate struct {
+	Name     *string   // Новое имя документа
+	IsPublic *bool     // Новый флаг публичности
+	Grants   *[]string // Новый список логинов с доступом
+	JSONData JSONData  // Новые JSON данные (создают новую версию JSON документа)
+	FolderID *string   // Новая папка ("" - перенос в корень владельца)
+	Metadata JSONData  // Изменения метаданных (значение nil удаляет ключ)
+}
+
+// IsEmpty - в запросе на обновление нет ни одного поля
+func (u DocumentUpdate) IsEmpty() bool {
+	return u.Name == nil && u.IsPublic == nil && u.Grants == nil && u.JSONData == nil && u.FolderID == nil && u.Metadata == nil
+}
+
+// DocumentSortField - поле сортировки списка документов
+type DocumentSortField string
+
+const (
+	DocumentSortName    DocumentSortField = "name"    // По имени
+	DocumentSortCreated DocumentSortField = "created" // По дате создания
+	DocumentSortSize    DocumentSortField = "size"    // По размеру текущей версии
+	DocumentSortMime    DocumentSortField = "mime"    // По MIME-типу
+)
+
+// DocumentSort - порядок списка документов. При равенстве поля документы упорядочиваются
+// по дате создания и ID в том же направлении
+type DocumentSort struct {
+	Field DocumentSortField
+	Desc  bool
+}
+
+// ParseDocumentSort - разбор параметра сортировки вида "name", "created:desc".
+// Пустая строка - сортировка по имени по возрастанию
+func ParseDocumentSort(raw string) (DocumentSort, error) {
+	if raw == "" {
+		return DocumentSort{Field: DocumentSortName}, nil
+	}
+
+	field, direction, _ := strings.Cut(raw, ":")
+	sort := DocumentSort{Field: DocumentSortField(field)}
+	switch sort.Field {
+	case DocumentSortName, DocumentSortCreated, DocumentSortSize, DocumentSortMime:
+	default:
+		return DocumentSort{}, NewValidationError("Неизвестное поле сортировки", ErrInvalidInput)
+	}
+	switch direction {
+	case "", "asc":
+	case "desc":
+		sort.Desc = true
+	default:
+		return DocumentSort{}, NewValidationError("Неизвестное направление сортировки", ErrInvalidInput)
+	}
+	return sort, nil
+}
+
+// String - каноническая запись сортировки вида "name:asc"
+func (s DocumentSort) String() string {
+	if s.Desc {
+		return string(s.Field) + ":desc"
+	}
+	return string(s.Field) + ":asc"
+}
+
+// DocumentCursor - ключ сортировки последнего документа страницы, после которого продолжается выборка
+type DocumentCursor struct {
+	Sort      string    `json:"s"`           // Сортировка, для которой выдан курсор
+	Name      string    `json:"n,omitempty"` // Имя документа
+	MimeType  string    `json:"m,omitempty"` // MIME-тип документа
+	Size      int64     `json:"z,omitempty"` // Размер документа
+	CreatedAt time.Time `json:"c"`           // Дата создания документа
+	ID        string    `json:"i"`           // ID документа
+}
+
+// DocumentQuery - параметры выборки страницы документов
+type DocumentQuery struct {
+	OwnerID     string              // Владелец документов (пустой - любые владельцы, см. HasScopeFilter)
+	ViewerID    string              // Запрашивающий пользователь
+	ViewerLogin string              // Логин запрашивающего (для grants)
+	ViewAll     bool                // Запрашивающий видит все документы, а не только свои, публичные и выданные ему
+	Filters     []DocumentCondition // Условия фильтра, объединяемые через И
+	Sort        DocumentSort        // Порядок выдачи
+	Cursor      string              // Непрозрачный курсор предыдущей страницы
+	After       *DocumentCursor     // Разобранный курсор (заполняется сервисом)
+	Limit       int                 // Размер страницы
+	WithTotal   bool                // Посчитать общее количество подходящих документов
+}
+
+// DocumentPage - страница списка документов
+type DocumentPage struct {
+	Docs       []Document
+	NextCursor string // Пустой - страница последняя
+	Total      *int   // Общее количество документов (nil - не запрашивалось)
+	Limit      int
+}
+
+// CommitHook - действие, выполняемое внутри транзакции БД непосредственно перед фиксацией.
+// Ошибка хука откатывает транзакцию
+type CommitHook func(ctx context.Context) error
+
 // JSONData - тип для хранения JSON данных
 type JSONData map[string]any
 
@@ -1,6 +1,10 @@
 package model
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 // Стандартные ошибки репозитория
 var (
@@ -11,10 +15,15 @@ var (
 // Бизнес-ошибки сервисного слоя
 var (
 	// Ошибки аутентификации
-	ErrInvalidCredentials = errors.New("invalid credentials")
-	ErrInvalidToken       = errors.New("invalid token")
-	ErrTokenExpired       = errors.New("token expired")
-	ErrInvalidAdminToken  = errors.New("invalid admin token")
+	ErrInvalidCredentials    = errors.New("invalid credentials")
+	ErrInvalidToken          = errors.New("invalid token")
+	ErrTokenExpired          = errors.New("token expired")
+	ErrAccountDisabled       = errors.New("account disabled")
+	ErrPasswordResetRequired = errors.New("password reset required")
+	ErrTooManyAttempts       = errors.New("too many login attempts")
+	ErrAccountLocked         = errors.New("account temporarily locked")
+	ErrSecondFactorInvalid   = errors.New("invalid second factor code")
+	ErrOIDCNotConfigured     = errors.New("oidc login is not configured")
 
 	// Ошибки валидации пользователя
 	ErrLoginTooShort      = errors.New("login too short")
@@ -36,9 +45,16 @@ var (
 	ErrDocumentNoContent    = errors.New("document must have either file or JSON data")
 	ErrDocumentInvalidGrant = errors.New("invalid grant user")
 
+	// Ошибки публичных ссылок
+	ErrShareLinkExpired   = errors.New("share link expired or download limit reached")
+	ErrSharePasswordWrong = errors.New("share link password required or invalid")
+
 	// Ошибки прав доступа
 	ErrAccessDenied      = errors.New("access denied")
 	ErrOwnershipRequired = errors.New("only document owner can perform this action")
+	ErrSelfModification  = errors.New("administrator cannot disable, demote or delete own account")
+	// Роль требует 2FA: пока она не включена, разрешения роли не действуют
+	ErrSecondFactorRequired = fmt.Errorf("%w: two-factor authentication required for role", ErrAccessDenied)
 
 	// Общие ошибки валидации
 	ErrRequired     = errors.New("required field is missing")
@@ -95,3 +111,17 @@ func NewBusinessError(message string, cause error) BusinessError {
 		Cause:   cause,
 	}
 }
+
+// ThrottleError - попытка отклонена, повторить можно через RetryAfter
+type ThrottleError struct {
+	Cause      error
+	RetryAfter time.Duration
+}
+
+func (e ThrottleError) Error() string {
+	return fmt.Sprintf("%v, retry after %s", e.Cause, e.RetryAfter)
+}
+
+func (e ThrottleError) Unwrap() error {
+	return e.Cause
+}
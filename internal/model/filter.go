@@ -0,0 +1,232 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxDocumentFilters - максимальное количество условий в одном запросе списка документов
+const MaxDocumentFilters = 20
+
+// FilterOp - оператор условия фильтра документов
+type FilterOp string
+
+const (
+	FilterEq       FilterOp = "="  // Равно
+	FilterNe       FilterOp = "!=" // Не равно
+	FilterPrefix   FilterOp = "^=" // Начинается с (без учета регистра)
+	FilterContains FilterOp = "~"  // Содержит (без учета регистра)
+	FilterGt       FilterOp = ">"  // Больше
+	FilterGe       FilterOp = ">=" // Больше или равно
+	FilterLt       FilterOp = "<"  // Меньше
+	FilterLe       FilterOp = "<=" // Меньше или равно
+)
+
+// filterOps - операторы в порядке разбора (двухсимвольные раньше односимвольных)
+var filterOps = []FilterOp{FilterNe, FilterPrefix, FilterGe, FilterLe, FilterEq, FilterContains, FilterGt, FilterLt}
+
+// Поля фильтра документов
+const (
+	FilterFieldName    = "name"    // Имя: =, !=, ^=, ~
+	FilterFieldMime    = "mime"    // MIME-тип: =, != (допускается маска image/*)
+	FilterFieldCreated = "created" // Дата создания: =, >, >=, <, <=
+	FilterFieldUpdated = "updated" // Дата изменения: =, >, >=, <, <=
+	FilterFieldSize    = "size"    // Размер в байтах: =, !=, >, >=, <, <=
+	FilterFieldOwner   = "owner"   // Логин владельца: =
+	FilterFieldGranted = "granted" // Выдан запрашивающему через grants: =true
+	FilterFieldPublic  = "public"  // Публичность: =true, =false
+	FilterFieldFile    = "file"    // Файл или JSON документ: =true, =false
+	FilterFieldFolder  = "folder"  // Папка: =<ID папки> или =root (документы вне папок)
+	FilterFieldTag     = "tag"     // Тег: =a,b (любой из тегов), != (ни одного из тегов)
+	FilterFieldJSON    = "json"    // Поле JSON данных: json.<путь>
+	FilterFieldMeta    = "meta"    // Ключ метаданных: meta.<ключ>
+)
+
+// filterFieldOps - допустимые операторы для полей фильтра
+var filterFieldOps = map[string][]FilterOp{
+	FilterFieldName:    {FilterEq, FilterNe, FilterPrefix, FilterContains},
+	FilterFieldMime:    {FilterEq, FilterNe},
+	FilterFieldCreated: {FilterEq, FilterGt, FilterGe, FilterLt, FilterLe},
+	FilterFieldUpdated: {FilterEq, FilterGt, FilterGe, FilterLt, FilterLe},
+	FilterFieldSize:    {FilterEq, FilterNe, FilterGt, FilterGe, FilterLt, FilterLe},
+	FilterFieldOwner:   {FilterEq},
+	FilterFieldGranted: {FilterEq},
+	FilterFieldPublic:  {FilterEq},
+	FilterFieldFile:    {FilterEq},
+	FilterFieldFolder:  {FilterEq},
+	FilterFieldTag:     {FilterEq, FilterNe},
+	FilterFieldJSON:    {FilterEq, FilterNe, FilterContains, FilterGt, FilterGe, FilterLt, FilterLe},
+	FilterFieldMeta:    {FilterEq, FilterNe, FilterContains, FilterGt, FilterGe, FilterLt, FilterLe},
+}
+
+// filterTimeLayouts - форматы дат фильтра; точность формата задает ширину интервала для "="
+var filterTimeLayouts = []struct {
+	layout    string
+	precision time.Duration
+}{
+	{time.RFC3339Nano, time.Microsecond},
+	{"2006-01-02 15:04:05", time.Second},
+	{"2006-01-02T15:04:05", time.Second},
+	{"2006-01-02", 24 * time.Hour},
+}
+
+// jsonPathSegment - допустимый сегмент пути в JSON данных
+var jsonPathSegment = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// jsonNumber - число по грамматике JSON (без ведущих нулей и точки без дробной части);
+// такую запись принимает и numeric в PostgreSQL
+var jsonNumber = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+
+// DocumentCondition - одно условие фильтра списка документов вида <поле><оператор><значение>
+type DocumentCondition struct {
+	Field    string   // Поле фильтра
+	JSONPath []string // Путь в JSON данных (для поля json) или ключ метаданных (для meta)
+	Op       FilterOp // Оператор
+	Value    string   // Значение как в запросе
+
+	Bool    bool      // Значение для public, file и granted
+	Size    int64     // Значение для size
+	Numeric bool      // Значение для json и meta - число
+	Date    bool      // Значение для meta - дата (интервал From/Until)
+	Tags    []string  // Нормализованные теги для tag
+	From    time.Time // Начало интервала для created, updated и даты в meta
+	Until   time.Time // Конец интервала (не включая) для created, updated и даты в meta
+}
+
+// String - каноническая запись условия
+func (c DocumentCondition) String() string {
+	field := c.Field
+	if field == FilterFieldJSON || field == FilterFieldMeta {
+		field += "." + strings.Join(c.JSONPath, ".")
+	}
+	return field + string(c.Op) + c.Value
+}
+
+// ParseDocumentFilter - разбор условия фильтра, например "name^=report", "mime=image/*",
+// "size>=1024", "created>=2024-01-01", "json.status=done", "tag=draft,review", "meta.due<2025-01-01"
+func ParseDocumentFilter(raw string) (DocumentCondition, error) {
+	field, op, value, ok := splitFilter(raw)
+	if !ok {
+		return DocumentCondition{}, NewValidationError("Некорректное условие фильтра '"+raw+"'", ErrInvalidInput)
+	}
+
+	cond := DocumentCondition{Field: field, Op: op, Value: value}
+	if path, found := strings.CutPrefix(field, FilterFieldJSON+"."); found {
+		cond.Field = FilterFieldJSON
+		cond.JSONPath = strings.Split(path, ".")
+		for _, segment := range cond.JSONPath {
+			if !jsonPathSegment.MatchString(segment) {
+				return DocumentCondition{}, NewValidationError("Некорректный путь JSON в фильтре '"+raw+"'", ErrInvalidInput)
+			}
+		}
+	}
+	if key, found := strings.CutPrefix(field, FilterFieldMeta+"."); found {
+		if !jsonPathSegment.MatchString(key) {
+			return DocumentCondition{}, NewValidationError("Некорректный ключ метаданных в фильтре '"+raw+"'", ErrInvalidInput)
+		}
+		cond.Field = FilterFieldMeta
+		cond.JSONPath = []string{key}
+	}
+
+	ops, known := filterFieldOps[cond.Field]
+	if !known {
+		return DocumentCondition{}, NewValidationError("Неизвестное поле фильтра '"+field+"'", ErrInvalidInput)
+	}
+	allowed := false
+	for _, candidate := range ops {
+		allowed = allowed || candidate == op
+	}
+	if !allowed {
+		return DocumentCondition{}, NewValidationError("Оператор "+string(op)+" не поддерживается для поля '"+field+"'", ErrInvalidInput)
+	}
+	if value == "" {
+		return DocumentCondition{}, NewValidationError("Пустое значение в фильтре '"+raw+"'", ErrInvalidInput)
+	}
+
+	switch cond.Field {
+	case FilterFieldPublic, FilterFieldFile, FilterFieldGranted:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil || (cond.Field == FilterFieldGranted && !parsed) {
+			return DocumentCondition{}, NewValidationError("Некорректное логическое значение в фильтре '"+raw+"'", ErrInvalidInput)
+		}
+		cond.Bool = parsed
+	case FilterFieldSize:
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || size < 0 {
+			return DocumentCondition{}, NewValidationError("Некорректный размер в фильтре '"+raw+"'", ErrInvalidInput)
+		}
+		cond.Size = size
+	case FilterFieldCreated, FilterFieldUpdated:
+		from, until, ok := parseFilterTime(value)
+		if !ok {
+			return DocumentCondition{}, NewValidationError("Некорректная дата в фильтре '"+raw+"'", ErrInvalidInput)
+		}
+		cond.From, cond.Until = from, until
+	case FilterFieldTag:
+		tags, err := NormalizeTags(strings.Split(value, ","))
+		if err != nil {
+			return DocumentCondition{}, NewValidationError("Некорректный тег в фильтре '"+raw+"'", ErrInvalidInput)
+		}
+		cond.Tags = tags
+	case FilterFieldMeta:
+		cond.Numeric = jsonNumber.MatchString(value)
+		// Даты в метаданных хранятся с точностью до секунды, поэтому интервал не уже секунды
+		if from, until, ok := parseFilterTime(value); ok {
+			cond.Date = true
+			cond.From, cond.Until = from.Truncate(time.Second), until
+			if cond.Until.Before(cond.From.Add(time.Second)) {
+				cond.Until = cond.From.Add(time.Second)
+			}
+		}
+		if !cond.Numeric && !cond.Date && op != FilterEq && op != FilterNe && op != FilterContains {
+			return DocumentCondition{}, NewValidationError("Сравнение в фильтре '"+raw+"' требует числа или даты", ErrInvalidInput)
+		}
+	case FilterFieldJSON:
+		cond.Numeric = jsonNumber.MatchString(value)
+		if !cond.Numeric && op != FilterEq && op != FilterNe && op != FilterContains {
+			return DocumentCondition{}, NewValidationError("Сравнение в фильтре '"+raw+"' требует числового значения", ErrInvalidInput)
+		}
+	}
+	return cond, nil
+}
+
+// HasScopeFilter - фильтр выбирает документы других владельцев (owner, granted или папка,
+// которая может принадлежать другому пользователю), а не только собственные документы пользователя
+func HasScopeFilter(conditions []DocumentCondition) bool {
+	for _, cond := range conditions {
+		switch {
+		case cond.Field == FilterFieldOwner, cond.Field == FilterFieldGranted:
+			return true
+		case cond.Field == FilterFieldFolder && cond.Value != RootFolder:
+			return true
+		}
+	}
+	return false
+}
+
+// splitFilter - разделение условия на поле, оператор и значение
+func splitFilter(raw string) (string, FilterOp, string, bool) {
+	end := strings.IndexAny(raw, "!^=~<>")
+	if end <= 0 {
+		return "", "", "", false
+	}
+	for _, op := range filterOps {
+		if strings.HasPrefix(raw[end:], string(op)) {
+			return raw[:end], op, raw[end+len(op):], true
+		}
+	}
+	return "", "", "", false
+}
+
+// parseFilterTime - разбор даты фильтра (UTC) в интервал [from, until) шириной в точность формата
+func parseFilterTime(value string) (time.Time, time.Time, bool) {
+	for _, format := range filterTimeLayouts {
+		if parsed, err := time.ParseInLocation(format.layout, value, time.UTC); err == nil {
+			parsed = parsed.UTC().Truncate(format.precision)
+			return parsed, parsed.Add(format.precision), true
+		}
+	}
+	return time.Time{}, time.Time{}, false
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseDocumentFilterNumbers(t *testing.T) {
+	tests := []struct {
+		raw     string
+		numeric bool
+		wantErr bool
+	}{
+		{raw: "meta.count=0", numeric: true},
+		{raw: "meta.count=-0", numeric: true},
+		{raw: "meta.count=12", numeric: true},
+		{raw: "meta.count=-3.25", numeric: true},
+		{raw: "meta.count=1e10", numeric: true},
+		{raw: "meta.count=2.5E-3", numeric: true},
+		{raw: "json.total>=0.5", numeric: true},
+
+		// Не JSON числа сравниваются как строки, а для сравнений > < отклоняются
+		{raw: "meta.count=007", numeric: false},
+		{raw: "meta.count=1.", numeric: false},
+		{raw: "meta.count=.5", numeric: false},
+		{raw: "meta.count=+1", numeric: false},
+		{raw: "meta.count>007", wantErr: true},
+		{raw: "meta.count<1.", wantErr: true},
+		{raw: "json.total>=-01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			cond, err := ParseDocumentFilter(tt.raw)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidInput) {
+					t.Fatalf("ParseDocumentFilter(%q) error = %v, want ErrInvalidInput", tt.raw, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDocumentFilter(%q) unexpected error: %v", tt.raw, err)
+			}
+			if cond.Numeric != tt.numeric {
+				t.Errorf("ParseDocumentFilter(%q).Numeric = %t, want %t", tt.raw, cond.Numeric, tt.numeric)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// MaxFolderDepth - максимальная вложенность папок
+const MaxFolderDepth = 32
+
+// RootFolder - значение фильтра folder для документов вне папок (в корне владельца)
+const RootFolder = "root"
+
+// Folder - папка документов пользователя
+type Folder struct {
+	ID        string      `db:"id" json:"id"`              // ID папки
+	UserID    string      `db:"user_id" json:"-"`          // ID владельца
+	ParentID  string      `db:"parent_id" json:"parent"`   // ID родительской папки (пустой - корень)
+	Name      string      `db:"name" json:"name"`          // Имя папки
+	Path      string      `db:"path" json:"-"`             // Материализованный путь из ID: "/<корень>/.../<id>/"
+	IsPublic  bool        `db:"is_public" json:"public"`   // Содержимое папки доступно всем
+	Grants    StringArray `db:"grants" json:"grant"`       // Логины с доступом к содержимому папки
+	CreatedAt time.Time   `db:"created_at" json:"created"` // Дата создания
+	UpdatedAt time.Time   `db:"updated_at" json:"-"`       // Дата изменения
+	NamePath  string      `db:"-" json:"path"`             // Путь из имен "/Отчеты/2024" (заполняется сервисом)
+}
+
+// PathIDs - ID папок пути от корня до самой папки включительно
+func (f Folder) PathIDs() []string {
+	trimmed := strings.Trim(f.Path, "/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
+
+// Depth - уровень вложенности папки (папка в корне - 1)
+func (f Folder) Depth() int {
+	return len(f.PathIDs())
+}
+
+// Contains - папка id находится на пути папки (сама папка или ее предок)
+func (f Folder) Contains(id string) bool {
+	return strings.Contains(f.Path, "/"+id+"/")
+}
+
+// FolderPath - материализованный путь папки id внутри родителя (parent == nil - корень)
+func FolderPath(parent *Folder, id string) string {
+	if parent == nil {
+		return "/" + id + "/"
+	}
+	return parent.Path + id + "/"
+}
+
+// FolderUpdate - частичное изменение папки (nil - поле не меняется)
+type FolderUpdate struct {
+	Name     *string   // Новое имя
+	ParentID *string   // Новая родительская папка ("" - перенос в корень)
+	IsPublic *bool     // Новый флаг публичности
+	Grants   *[]string // Новый список логинов с доступом
+}
+
+// IsEmpty - в запросе на изменение нет ни одного поля
+func (u FolderUpdate) IsEmpty() bool {
+	return u.Name == nil && u.ParentID == nil && u.IsPublic == nil && u.Grants == nil
+}
+
+// FolderListing - содержимое папки: вложенные папки и страница документов
+type FolderListing struct {
+	Folder  *Folder  // Сама папка (nil - корень пользователя)
+	Folders []Folder // Вложенные папки по имени
+	Docs    DocumentPage
+}
+
+// FolderDeletion - результат удаления папки
+type FolderDeletion struct {
+	DeletedFolders   []string // ID удаленных папок (сама папка и вложенные)
+	DeletedDocuments []string // ID документов, перемещенных в корзину
+}
@@ -0,0 +1,77 @@
+package model
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxMetadataKeys - максимальное количество ключей метаданных документа
+const MaxMetadataKeys = 50
+
+// MaxMetadataValueLength - максимальная длина строкового значения метаданных в символах
+const MaxMetadataValueLength = 1024
+
+// MetadataDateLayout - формат, к которому приводятся даты в метаданных: строки одного формата
+// в UTC сравниваются как даты
+const MetadataDateLayout = time.RFC3339
+
+// MetadataDatePattern - регулярное выражение PostgreSQL для даты в формате MetadataDateLayout
+const MetadataDatePattern = `^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`
+
+// NormalizeMetadata - проверка метаданных документа: ключи как сегмент пути json фильтра, значения -
+// строка, число или логическое значение. Строки-даты (форматы фильтра created) приводятся к RFC 3339 UTC.
+// nil превращается в пустой объект
+func NormalizeMetadata(meta JSONData) (JSONData, error) {
+	if len(meta) > MaxMetadataKeys {
+		return nil, NewValidationError(fmt.Sprintf("Не более %d ключей метаданных", MaxMetadataKeys), ErrInvalidInput)
+	}
+
+	normalized := make(JSONData, len(meta))
+	for key, value := range meta {
+		if !jsonPathSegment.MatchString(key) {
+			return nil, NewValidationError("Некорректный ключ метаданных '"+key+"'", ErrInvalidInput)
+		}
+		switch v := value.(type) {
+		case float64, bool:
+			normalized[key] = v
+		case string:
+			if utf8.RuneCountInString(v) > MaxMetadataValueLength {
+				return nil, NewValidationError("Значение метаданных '"+key+"' слишком длинное", ErrInvalidInput)
+			}
+			if date, ok := parseMetadataDate(v); ok {
+				normalized[key] = date.Format(MetadataDateLayout)
+				continue
+			}
+			normalized[key] = v
+		default:
+			return nil, NewValidationError("Значение метаданных '"+key+"' должно быть строкой, числом, логическим значением или датой", ErrInvalidInput)
+		}
+	}
+	return normalized, nil
+}
+
+// MergeMetadata - метаданные после частичного изменения: значение nil удаляет ключ, остальные заменяют
+func MergeMetadata(current, changes JSONData) JSONData {
+	merged := make(JSONData, len(current)+len(changes))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range changes {
+		if value == nil {
+			delete(merged, key)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// parseMetadataDate - строка в одном из форматов дат фильтра (UTC, точность до секунды)
+func parseMetadataDate(value string) (time.Time, bool) {
+	from, _, ok := parseFilterTime(value)
+	if !ok {
+		return time.Time{}, false
+	}
+	return from.Truncate(time.Second), true
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// OIDCState - незавершенный вход через OpenID Connect: параметры запроса авторизации
+// до возврата пользователя от провайдера
+type OIDCState struct {
+	ID           string    `json:"id" db:"id"`
+	StateHash    string    `json:"-" db:"state_hash"` // SHA-256 параметра state
+	Nonce        string    `json:"-" db:"nonce"`      // Ожидаемое значение nonce в ID-токене
+	CodeVerifier string    `json:"-" db:"code_verifier"`
+	ExpiresAt    time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// UserIdentity - привязка учетной записи провайдера OIDC (издатель + subject) к пользователю
+type UserIdentity struct {
+	ID          string     `json:"id" db:"id"`
+	UserID      string     `json:"user_id" db:"user_id"`
+	Issuer      string     `json:"issuer" db:"issuer"`
+	Subject     string     `json:"subject" db:"subject"`
+	Email       string     `json:"email" db:"email"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at" db:"last_login_at"`
+}
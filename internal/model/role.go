@@ -0,0 +1,21 @@
+package model
+
+// Role - роль пользователя, определяющая набор разрешений
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"    // Все документы и управление пользователями
+	RoleUser     Role = "user"     // Чтение доступных документов, создание и изменение своих
+	RoleReadOnly Role = "readonly" // Только чтение доступных документов
+	RoleService  Role = "service"  // Чтение всех документов (интеграции, резервное копирование), изменение своих
+)
+
+// Valid - роль входит в список известных
+func (r Role) Valid() bool {
+	switch r {
+	case RoleAdmin, RoleUser, RoleReadOnly, RoleService:
+		return true
+	default:
+		return false
+	}
+}
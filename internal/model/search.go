@@ -0,0 +1,34 @@
+package model
+
+// Границы совпадения в сниппете, которые репозиторий передает в ts_headline. Управляющие символы
+// удаляются из индексируемого текста, поэтому не встречаются в нем и заменяются на разметку после экранирования
+const (
+	SnippetStart = "\x02"
+	SnippetStop  = "\x03"
+)
+
+// DocumentSearch - параметры полнотекстового поиска по документам
+type DocumentSearch struct {
+	Query       string              // Поисковый запрос (синтаксис websearch: слова, "фраза", or, -исключение)
+	ViewerID    string              // Запрашивающий пользователь
+	ViewerLogin string              // Логин запрашивающего (для grants)
+	ViewAll     bool                // Запрашивающий видит все документы
+	Filters     []DocumentCondition // Дополнительные условия фильтра
+	Limit       int
+	Offset      int
+}
+
+// SearchHit - найденный документ
+type SearchHit struct {
+	Document Document
+	Rank     float64 // Релевантность (больше - выше)
+	Snippet  string  // Фрагменты имени и текста с выделенными совпадениями
+}
+
+// SearchPage - страница результатов поиска в порядке убывания релевантности
+type SearchPage struct {
+	Hits   []SearchHit
+	Total  int // Количество найденных документов
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+const (
+	ShareSlugBytes         = 16      // Случайных байт в slug ссылки (base64url - 22 символа)
+	MaxSharePasswordLength = 72      // Предел bcrypt в байтах
+	MaxShareDownloads      = 1000000 // Максимальный лимит скачиваний по одной ссылке
+)
+
+// ShareLink - публичная ссылка на документ, доступная без сессии по /s/{slug}
+type ShareLink struct {
+	ID             string     `json:"id" db:"id"`
+	DocumentID     string     `json:"document" db:"document_id"`
+	UserID         string     `json:"-" db:"user_id"` // Создатель ссылки
+	Slug           string     `json:"slug" db:"slug"`
+	PasswordHash   string     `json:"-" db:"password_hash"`             // bcrypt пароля; пустой - без пароля
+	ExpiresAt      *time.Time `json:"expires" db:"expires_at"`          // nil - бессрочная ссылка
+	MaxDownloads   *int       `json:"max_downloads" db:"max_downloads"` // nil - без ограничения
+	Downloads      int        `json:"downloads" db:"download_count"`
+	LastAccessedAt *time.Time `json:"last_access" db:"last_accessed_at"`
+	RevokedAt      *time.Time `json:"revoked" db:"revoked_at"`
+	CreatedAt      time.Time  `json:"created" db:"created_at"`
+}
+
+// HasPassword - для доступа по ссылке нужен пароль
+func (l ShareLink) HasPassword() bool {
+	return l.PasswordHash != ""
+}
+
+// Expired - срок действия ссылки истек к моменту now
+func (l ShareLink) Expired(now time.Time) bool {
+	return l.ExpiresAt != nil && !now.Before(*l.ExpiresAt)
+}
+
+// Exhausted - лимит скачиваний по ссылке исчерпан
+func (l ShareLink) Exhausted() bool {
+	return l.MaxDownloads != nil && l.Downloads >= *l.MaxDownloads
+}
+
+// Active - ссылка не отозвана, не истекла и лимит скачиваний не исчерпан
+func (l ShareLink) Active(now time.Time) bool {
+	return l.RevokedAt == nil && !l.Expired(now) && !l.Exhausted()
+}
+
+// ShareLinkSpec - параметры создаваемой ссылки
+type ShareLinkSpec struct {
+	Password     string     // Пустой - без пароля
+	ExpiresAt    *time.Time // nil - бессрочная ссылка
+	MaxDownloads *int       // nil - без ограничения
+}
+
+// SharedDocument - документ, открытый по ссылке, и сама ссылка
+type SharedDocument struct {
+	Link     ShareLink
+	Document Document
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxDocumentTags - максимальное количество тегов у одного документа
+const MaxDocumentTags = 50
+
+// MaxTagLength - максимальная длина тега в символах
+const MaxTagLength = 64
+
+// Tag - тег пользователя и количество его документов с этим тегом
+type Tag struct {
+	Name  string `json:"name"`  // Имя тега (в нижнем регистре)
+	Count int    `json:"count"` // Количество документов с тегом
+}
+
+// NormalizeTag - тег без пробелов по краям в нижнем регистре. Запятая недопустима:
+// в фильтре tag она разделяет альтернативы
+func NormalizeTag(raw string) (string, error) {
+	tag := strings.ToLower(strings.TrimSpace(raw))
+	switch {
+	case tag == "":
+		return "", NewValidationError("Тег не может быть пустым", ErrInvalidInput)
+	case utf8.RuneCountInString(tag) > MaxTagLength:
+		return "", NewValidationError("Тег '"+tag+"' слишком длинный", ErrInvalidInput)
+	case strings.ContainsRune(tag, ','), strings.IndexFunc(tag, unicode.IsControl) >= 0:
+		return "", NewValidationError("Тег '"+tag+"' содержит недопустимые символы", ErrInvalidInput)
+	}
+	return tag, nil
+}
+
+// NormalizeTags - нормализованные теги без повторов в исходном порядке
+func NormalizeTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	seen := make(map[string]bool, len(raw))
+	for _, item := range raw {
+		tag, err := NormalizeTag(item)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
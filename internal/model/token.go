@@ -1,13 +1,73 @@
 package model
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Token - модель токена авторизации
 type Token struct {
 	ID        string    `json:"id" db:"id"`
 	UserID    string    `json:"user_id" db:"user_id"`
-	Token     string    `json:"token" db:"token"`
+	TokenHash string    `json:"-" db:"token_hash"` // SHA-256 токена; пусто у сессий JWT
 	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	IsActive  bool      `json:"is_active" db:"is_active"`
+	UserAgent string    `json:"user_agent" db:"user_agent"` // User-Agent клиента при входе
+	IPAddress string    `json:"ip_address" db:"ip_address"` // IP-адрес клиента при входе
+}
+
+// ClientInfo - сведения о клиенте, сохраняемые вместе с сессией
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+type clientInfoKey struct{}
+
+// WithClientInfo - контекст запроса со сведениями о клиенте
+func WithClientInfo(ctx context.Context, client ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey{}, client)
+}
+
+// ClientInfoFromContext - сведения о клиенте текущего запроса (IP нужен для проверки API-ключей)
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	client, _ := ctx.Value(clientInfoKey{}).(ClientInfo)
+	return client
+}
+
+// Session - активная сессия пользователя (токен в таблице tokens)
+type Session struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	ExpiresAt time.Time `json:"expires_at"`
+	UserAgent string    `json:"user_agent"`
+	IPAddress string    `json:"ip_address"`
+	Current   bool      `json:"current"` // Сессия токена, которым выполнен запрос
+}
+
+// RevokedToken - отозванный до истечения срока JWT (выход из системы)
+type RevokedToken struct {
+	JTI       string    `json:"jti" db:"jti"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"` // После этого момента запись не нужна
+	RevokedAt time.Time `json:"revoked_at" db:"revoked_at"`
+}
+
+// PasswordResetToken - одноразовый токен сброса пароля
+type PasswordResetToken struct {
+	ID        string     `json:"id" db:"id"`
+	UserID    string     `json:"user_id" db:"user_id"`
+	TokenHash string     `json:"-" db:"token_hash"`          // SHA-256 токена
+	CreatedBy string     `json:"created_by" db:"created_by"` // ID администратора; пусто - запрос самого пользователя
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt    *time.Time `json:"used_at" db:"used_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// PasswordReset - выпущенный токен сброса пароля (значение токена известно только в момент выпуска)
+type PasswordReset struct {
+	User      User
+	Token     string
+	ExpiresAt time.Time
 }
@@ -0,0 +1,7 @@
+package model
+
+// TrashPurge - результат окончательного удаления документов из корзины
+type TrashPurge struct {
+	PurgedDocuments []string // ID окончательно удаленных документов
+	Released        []string // Ключи хранилища, на которые больше никто не ссылается
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// LoginResult - результат проверки пароля: токен сессии или, при включенной 2FA, токен второго шага входа
+type LoginResult struct {
+	Token              string    // Токен сессии; пусто - требуется код второго фактора
+	Challenge          string    // Токен второго шага входа
+	ChallengeExpiresAt time.Time // Срок действия токена второго шага
+}
+
+// LoginChallenge - незавершенный вход: пароль проверен, ожидается код второго фактора
+type LoginChallenge struct {
+	ID        string    `json:"id" db:"id"`
+	UserID    string    `json:"user_id" db:"user_id"`
+	TokenHash string    `json:"-" db:"token_hash"` // SHA-256 токена второго шага
+	Attempts  int       `json:"attempts" db:"attempts"`
+	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// TOTPEnrollment - ожидающий подтверждения секрет TOTP для приложения-аутентификатора
+type TOTPEnrollment struct {
+	Secret string // Секрет в base32 для ручного ввода
+	URI    string // otpauth:// URI для QR-кода
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// UploadSession - сессия возобновляемой загрузки файла по частям
+type UploadSession struct {
+	ID        string      `db:"id" json:"id"`                // ID сессии
+	UserID    string      `db:"user_id" json:"-"`            // ID владельца загрузки
+	Name      string      `db:"name" json:"name"`            // Имя будущего документа
+	MimeType  string      `db:"mime_type" json:"mime"`       // MIME-тип файла
+	IsPublic  bool        `db:"is_public" json:"public"`     // Флаг публичности будущего документа
+	Grants    StringArray `db:"grants" json:"grant"`         // Логины с доступом к будущему документу
+	FolderID  string      `db:"folder_id" json:"folder"`     // Папка будущего документа (пустой - корень)
+	Size      int64       `db:"size_bytes" json:"size"`      // Полный размер файла в байтах
+	Offset    int64       `db:"upload_offset" json:"offset"` // Количество принятых байт
+	Chunks    StringArray `db:"chunks" json:"-"`             // Ключи принятых фрагментов в хранилище по порядку
+	ExpiresAt time.Time   `db:"expires_at" json:"expires"`   // Время истечения сессии
+	CreatedAt time.Time   `db:"created_at" json:"created"`   // Дата создания сессии
+	UpdatedAt time.Time   `db:"updated_at" json:"-"`         // Дата последнего принятого фрагмента
+}
+
+// IsComplete - все байты файла приняты
+func (u UploadSession) IsComplete() bool {
+	return u.Offset == u.Size
+}
+
+// IsExpired - сессия истекла и подлежит удалению
+func (u UploadSession) IsExpired(now time.Time) bool {
+	return !u.ExpiresAt.After(now)
+}
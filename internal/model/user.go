@@ -4,9 +4,74 @@ import "time"
 
 // User - модель пользователя
 type User struct {
-	ID        string    `json:"id" db:"id"`
-	Login     string    `json:"login" db:"login"`
-	Password  string    `json:"-" db:"password_hash"`
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ID                    string     `json:"id" db:"id"`
+	Login                 string     `json:"login" db:"login"`
+	Password              string     `json:"-" db:"password_hash"`
+	Role                  Role       `json:"role" db:"role"`
+	Disabled              bool       `json:"disabled" db:"disabled"`
+	PasswordResetRequired bool       `json:"password_reset_required" db:"password_reset_required"`
+	FailedLogins          int        `json:"failed_logins" db:"failed_logins"` // Неудачных попыток входа подряд
+	LastFailedLoginAt     *time.Time `json:"last_failed_login_at" db:"last_failed_login_at"`
+	LockedUntil           *time.Time `json:"locked_until" db:"locked_until"` // Временная блокировка после подбора пароля
+	TOTPEnabled           bool       `json:"totp_enabled" db:"totp_enabled"` // Вход требует кода TOTP
+	TOTPSecret            string     `json:"-" db:"totp_secret"`             // Секрет TOTP (base32); до подтверждения - ожидающий
+	TOTPLastStep          int64      `json:"-" db:"totp_last_step"`          // Последний принятый интервал TOTP (защита от повтора кода)
+	CreatedAt             time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt             time.Time  `json:"updated_at" db:"updated_at"`
+
+	// APIKey - ключ, которым выполнен запрос; nil - запрос выполнен токеном сессии
+	APIKey *APIKey `json:"-" db:"-"`
+}
+
+// HasScope - запрос пользователя разрешен для группы операций scope.
+// Токен сессии дает все разрешения роли, API-ключ - только перечисленные в нем
+func (u User) HasScope(scope Scope) bool {
+	if u.APIKey == nil {
+		return true
+	}
+	return u.APIKey.HasScope(scope)
+}
+
+// UserFilter - параметры поиска пользователей администратором
+type UserFilter struct {
+	Query    string // Подстрока логина
+	Role     Role   // Пустая роль - любая
+	Disabled *bool  // nil - любые учетные записи
+	Limit    int
+	Offset   int
+}
+
+// UserPage - страница результатов поиска пользователей
+type UserPage struct {
+	Users  []User
+	Total  int // Количество пользователей, подходящих под фильтр
+	Limit  int
+	Offset int
+}
+
+// UserUpdate - изменение учетной записи администратором; nil-поля не меняются
+type UserUpdate struct {
+	Role     *Role
+	Disabled *bool
+}
+
+// UserDeletion - результат удаления пользователя
+type UserDeletion struct {
+	DeletedDocuments     []string // ID удаленных документов
+	TransferredDocuments []string // ID документов, переданных другому владельцу
+	Released             []string // Ключи хранилища, на которые больше никто не ссылается
+}
+
+// Locked - учетная запись временно заблокирована после неудачных попыток входа
+func (u User) Locked(now time.Time) bool {
+	return u.LockedUntil != nil && now.Before(*u.LockedUntil)
+}
+
+// LoginThrottle - пороги учета неудачных попыток входа: блокировка после MaxFailures попыток подряд
+// на Lockout и пауза BackoffBase * 2^(попыток-1), не больше BackoffMax, между попытками
+type LoginThrottle struct {
+	MaxFailures int
+	Lockout     time.Duration
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
 }
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// DocumentVersion - версия содержимого документа
+type DocumentVersion struct {
+	ID          string    `db:"id" json:"id"`                    // ID версии
+	DocumentID  string    `db:"document_id" json:"document_id"`  // ID документа
+	Version     int       `db:"version" json:"version"`          // Номер версии (начиная с 1)
+	FilePath    string    `db:"file_path" json:"-"`              // Ключ содержимого в хранилище
+	MimeType    string    `db:"mime_type" json:"mime"`           // MIME-тип версии
+	Size        int64     `db:"size_bytes" json:"size"`          // Размер содержимого в байтах
+	Digest      string    `db:"digest" json:"digest,omitempty"`  // SHA-256 содержимого (hex)
+	JSONData    JSONData  `db:"json_data" json:"json,omitempty"` // JSON данные версии
+	AuthorID    string    `db:"author_id" json:"-"`              // ID автора версии
+	AuthorLogin string    `db:"-" json:"author"`                 // Логин автора версии
+	CreatedAt   time.Time `db:"created_at" json:"created"`       // Дата создания версии
+}
+
+// StoredFile - ссылка версии документа на объект хранилища (для сверки хранилища и БД)
+type StoredFile struct {
+	DocumentID string // ID документа
+	Version    int    // Номер версии
+	Key        string // Ключ объекта в хранилище
+	Current    bool   // Версия является текущей
+}
@@ -0,0 +1,48 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FileNotifier - запись уведомлений в файл JSON Lines, который читает внешний отправитель
+type FileNotifier struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNotifier - создание драйвера file; директория файла создается при необходимости
+func NewFileNotifier(path string) (*FileNotifier, error) {
+	if path == "" {
+		return nil, fmt.Errorf("notify file path is required")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create notify directory: %w", err)
+	}
+	return &FileNotifier{path: path}, nil
+}
+
+func (n *FileNotifier) Send(_ context.Context, notification Notification) error {
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// Файл содержит секреты, поэтому доступен только владельцу процесса
+	f, err := os.OpenFile(n.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open notify file: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write notification: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,20 @@
+package notify
+
+import (
+	"context"
+	"log"
+)
+
+// LogNotifier - вывод уведомлений в журнал приложения (локальная разработка)
+type LogNotifier struct{}
+
+// NewLogNotifier - создание драйвера log
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{}
+}
+
+func (n *LogNotifier) Send(_ context.Context, notification Notification) error {
+	log.Printf("📨 Notify: %s для %s: токен %s действует до %s",
+		notification.Type, notification.Login, notification.Token, notification.ExpiresAt.Format("2006-01-02 15:04:05"))
+	return nil
+}
@@ -0,0 +1,47 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+)
+
+// Поддерживаемые драйверы уведомлений
+const (
+	DriverLog  = "log"
+	DriverFile = "file"
+)
+
+// Типы уведомлений
+const (
+	TypePasswordReset = "password_reset" // Токен сброса пароля
+)
+
+// Notification - уведомление пользователя
+type Notification struct {
+	Type      string    `json:"type"`
+	UserID    string    `json:"user_id"`
+	Login     string    `json:"login"`
+	Token     string    `json:"token,omitempty"`      // Секрет, который нужно передать пользователю
+	ExpiresAt time.Time `json:"expires_at,omitempty"` // Срок действия секрета
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Notifier - доставка уведомлений пользователям (почта, мессенджер, локальный файл)
+type Notifier interface {
+	Send(ctx context.Context, n Notification) error
+}
+
+// New - создание драйвера уведомлений по настройкам конфигурации
+func New(cfg config.NotifyConfig) (Notifier, error) {
+	switch cfg.Driver {
+	case "", DriverLog:
+		return NewLogNotifier(), nil
+	case DriverFile:
+		return NewFileNotifier(cfg.File)
+	default:
+		return nil, fmt.Errorf("unknown notify driver %q", cfg.Driver)
+	}
+}
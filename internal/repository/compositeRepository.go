@@ -2,69 +2,305 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 	"github.com/NarthurN/FileServerService/internal/repository/doc"
 	"github.com/NarthurN/FileServerService/internal/repository/token"
+	"github.com/NarthurN/FileServerService/internal/repository/upload"
 	"github.com/NarthurN/FileServerService/internal/repository/user"
 )
 
 var _ FileServerRepository = (*CompositeRepository)(nil)
 
 type docRepository interface {
-	CreateDocument(ctx context.Context, doc buisnesModel.Document) (buisnesModel.Document, error)
+	CreateDocument(ctx context.Context, doc buisnesModel.Document, commit buisnesModel.CommitHook) (buisnesModel.Document, error)
 	GetDocument(ctx context.Context, id string) (buisnesModel.Document, error)
-	GetListDocuments(ctx context.Context, userID string) ([]buisnesModel.Document, error)
-	DeleteDocument(ctx context.Context, id string) error
+	DocumentNameExists(ctx context.Context, userID, folderID, name, excludeID string) (bool, error)
+	ListDocuments(ctx context.Context, query buisnesModel.DocumentQuery) ([]buisnesModel.Document, int, error)
+	SearchDocuments(ctx context.Context, search buisnesModel.DocumentSearch) ([]buisnesModel.SearchHit, int, error)
+	GetDocumentsToIndex(ctx context.Context, limit int) ([]buisnesModel.Document, error)
+	SetDocumentText(ctx context.Context, id string, version int, text string) error
+	DeleteDocument(ctx context.Context, id string) ([]string, error)
+	UpdateDocument(ctx context.Context, doc buisnesModel.Document, version *buisnesModel.DocumentVersion) (buisnesModel.Document, error)
+
+	CreateDocumentVersion(ctx context.Context, version buisnesModel.DocumentVersion, commit buisnesModel.CommitHook) (buisnesModel.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, documentID string, version int) (buisnesModel.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, documentID string) ([]buisnesModel.DocumentVersion, error)
+	GetVersionsToSize(ctx context.Context, limit int) ([]buisnesModel.DocumentVersion, error)
+	SetVersionSize(ctx context.Context, versionID string, size int64) error
+
+	GetStoredFiles(ctx context.Context) ([]buisnesModel.StoredFile, error)
+	DeleteStorageObject(ctx context.Context, key string, remove buisnesModel.CommitHook) (bool, error)
+	ReconcileBlobReferences(ctx context.Context, repair bool) (int, error)
+
+	CreateFolder(ctx context.Context, folder buisnesModel.Folder) (buisnesModel.Folder, error)
+	GetFolder(ctx context.Context, id string) (buisnesModel.Folder, error)
+	GetFolderPath(ctx context.Context, folder buisnesModel.Folder) ([]buisnesModel.Folder, error)
+	ListFolders(ctx context.Context, userID, parentID string) ([]buisnesModel.Folder, error)
+	GetFolderTreeDepth(ctx context.Context, folder buisnesModel.Folder) (int, error)
+	UpdateFolder(ctx context.Context, folder buisnesModel.Folder, oldPath string) (buisnesModel.Folder, error)
+	DeleteFolder(ctx context.Context, folder buisnesModel.Folder, recursive bool) (buisnesModel.FolderDeletion, error)
+
+	// Теги
+	AddDocumentTags(ctx context.Context, doc buisnesModel.Document, tags []string) error
+	RemoveDocumentTag(ctx context.Context, doc buisnesModel.Document, tag string) error
+	ListTags(ctx context.Context, userID string) ([]buisnesModel.Tag, error)
+
+	// Корзина
+	TrashDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	GetTrashedDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	ListTrash(ctx context.Context, userID string) ([]buisnesModel.Document, error)
+	GetExpiredTrash(ctx context.Context, before time.Time, limit int) ([]buisnesModel.Document, error)
+	RestoreDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	DeleteTrashedDocument(ctx context.Context, id string) ([]string, error)
+
+	// Публичные ссылки
+	CreateShareLink(ctx context.Context, link buisnesModel.ShareLink) (buisnesModel.ShareLink, error)
+	GetShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error)
+	GetShareLinkBySlug(ctx context.Context, slug string) (buisnesModel.ShareLink, error)
+	ListShareLinks(ctx context.Context, documentID string) ([]buisnesModel.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error)
+	RecordShareDownload(ctx context.Context, id string, now time.Time) (buisnesModel.ShareLink, error)
+
+	DeleteUser(ctx context.Context, userID, transferTo string) (buisnesModel.UserDeletion, error)
 }
 
 type userRepository interface {
 	CreateUser(ctx context.Context, user buisnesModel.User) (buisnesModel.User, error)
 	GetUserByLogin(ctx context.Context, login string) (buisnesModel.User, error)
 	GetUserByID(ctx context.Context, userID string) (buisnesModel.User, error)
+	UpdateUserRole(ctx context.Context, userID string, role buisnesModel.Role) error
+	SetUserDisabled(ctx context.Context, userID string, disabled bool) error
+	SetUserPassword(ctx context.Context, userID, passwordHash string, resetRequired bool) error
+	ListUsers(ctx context.Context, filter buisnesModel.UserFilter) ([]buisnesModel.User, int, error)
+	SetPasswordResetRequired(ctx context.Context, userID string, required bool) error
+	ClaimLoginAttempt(ctx context.Context, userID string, throttle buisnesModel.LoginThrottle) (buisnesModel.User, error)
+	RestoreLoginFailures(ctx context.Context, previous, claimed buisnesModel.User) error
+	ResetLoginFailures(ctx context.Context, userID string) error
+	SetTOTPSecret(ctx context.Context, userID, secret string) error
+	SetTOTPEnabled(ctx context.Context, userID string, enabled bool, step int64) error
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (buisnesModel.User, error)
+	CreateUserIdentity(ctx context.Context, identity buisnesModel.UserIdentity) error
+	TouchUserIdentity(ctx context.Context, issuer, subject, email string) error
 }
 
 type tokenRepository interface {
 	CreateToken(ctx context.Context, token buisnesModel.Token) (buisnesModel.Token, error)
-	GetTokenByValue(ctx context.Context, tokenValue string) (buisnesModel.Token, error)
-	DeactivateToken(ctx context.Context, tokenValue string) error
+	GetTokenByHash(ctx context.Context, tokenHash string) (buisnesModel.Token, error)
+	GetTokenByID(ctx context.Context, id string) (buisnesModel.Token, error)
+	GetTokensByUserID(ctx context.Context, userID string) ([]buisnesModel.Token, error)
+	DeactivateTokenByHash(ctx context.Context, tokenHash string) error
+	DeactivateTokenByID(ctx context.Context, id string) error
 	DeactivateUserTokens(ctx context.Context, userID string) error
+	RevokeToken(ctx context.Context, revoked buisnesModel.RevokedToken) error
+	GetRevokedTokens(ctx context.Context) ([]buisnesModel.RevokedToken, error)
+	DeleteExpiredRevokedTokens(ctx context.Context) (int64, error)
+	CreatePasswordResetToken(ctx context.Context, token buisnesModel.PasswordResetToken) error
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (buisnesModel.PasswordResetToken, error)
+	CreateAPIKey(ctx context.Context, key buisnesModel.APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (buisnesModel.APIKey, error)
+	GetAPIKeysByUserID(ctx context.Context, userID string) ([]buisnesModel.APIKey, error)
+	DeleteAPIKey(ctx context.Context, id, userID string) error
+	TouchAPIKey(ctx context.Context, id, ipAddress string) error
+	CreateLoginChallenge(ctx context.Context, challenge buisnesModel.LoginChallenge) error
+	GetLoginChallenge(ctx context.Context, tokenHash string) (buisnesModel.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, id string) error
+	FailLoginChallenge(ctx context.Context, id string, maxAttempts int) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+	CreateOIDCState(ctx context.Context, state buisnesModel.OIDCState) error
+	ConsumeOIDCState(ctx context.Context, stateHash string) (buisnesModel.OIDCState, error)
+}
+
+type uploadRepository interface {
+	CreateUploadSession(ctx context.Context, session buisnesModel.UploadSession) (buisnesModel.UploadSession, error)
+	GetUploadSession(ctx context.Context, id string) (buisnesModel.UploadSession, error)
+	GetExpiredUploadSessions(ctx context.Context, before time.Time) ([]buisnesModel.UploadSession, error)
+	AdvanceUploadSession(ctx context.Context, id string, from, to int64, chunkKey string, expiresAt time.Time) (buisnesModel.UploadSession, error)
+	DeleteUploadSession(ctx context.Context, id string) error
 }
 
 // CompositeRepository - композитный репозиторий, объединяющий все репозитории
 type CompositeRepository struct {
-	userRepo  userRepository
-	docRepo   docRepository
-	tokenRepo tokenRepository
+	userRepo   userRepository
+	docRepo    docRepository
+	tokenRepo  tokenRepository
+	uploadRepo uploadRepository
 }
 
 func NewCompositeRepository(pool *pgxpool.Pool) *CompositeRepository {
 	return &CompositeRepository{
-		userRepo:  user.NewRepository(pool),
-		docRepo:   doc.NewRepository(pool),
-		tokenRepo: token.NewRepository(pool),
+		userRepo:   user.NewRepository(pool),
+		docRepo:    doc.NewRepository(pool),
+		tokenRepo:  token.NewRepository(pool),
+		uploadRepo: upload.NewRepository(pool),
 	}
 }
 
 // Методы для работы с документами (делегируем в docRepo)
-func (r *CompositeRepository) CreateDocument(ctx context.Context, doc buisnesModel.Document) (buisnesModel.Document, error) {
-	return r.docRepo.CreateDocument(ctx, doc)
+func (r *CompositeRepository) CreateDocument(ctx context.Context, doc buisnesModel.Document, commit buisnesModel.CommitHook) (buisnesModel.Document, error) {
+	return r.docRepo.CreateDocument(ctx, doc, commit)
 }
 
 func (r *CompositeRepository) GetDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
 	return r.docRepo.GetDocument(ctx, id)
 }
 
-func (r *CompositeRepository) GetListDocuments(ctx context.Context, userID string) ([]buisnesModel.Document, error) {
-	return r.docRepo.GetListDocuments(ctx, userID)
+func (r *CompositeRepository) DocumentNameExists(ctx context.Context, userID, folderID, name, excludeID string) (bool, error) {
+	return r.docRepo.DocumentNameExists(ctx, userID, folderID, name, excludeID)
+}
+
+func (r *CompositeRepository) ListDocuments(ctx context.Context, query buisnesModel.DocumentQuery) ([]buisnesModel.Document, int, error) {
+	return r.docRepo.ListDocuments(ctx, query)
 }
 
-func (r *CompositeRepository) DeleteDocument(ctx context.Context, id string) error {
+func (r *CompositeRepository) SearchDocuments(ctx context.Context, search buisnesModel.DocumentSearch) ([]buisnesModel.SearchHit, int, error) {
+	return r.docRepo.SearchDocuments(ctx, search)
+}
+
+func (r *CompositeRepository) GetDocumentsToIndex(ctx context.Context, limit int) ([]buisnesModel.Document, error) {
+	return r.docRepo.GetDocumentsToIndex(ctx, limit)
+}
+
+func (r *CompositeRepository) SetDocumentText(ctx context.Context, id string, version int, text string) error {
+	return r.docRepo.SetDocumentText(ctx, id, version, text)
+}
+
+func (r *CompositeRepository) DeleteDocument(ctx context.Context, id string) ([]string, error) {
 	return r.docRepo.DeleteDocument(ctx, id)
 }
 
+func (r *CompositeRepository) UpdateDocument(ctx context.Context, doc buisnesModel.Document, version *buisnesModel.DocumentVersion) (buisnesModel.Document, error) {
+	return r.docRepo.UpdateDocument(ctx, doc, version)
+}
+
+func (r *CompositeRepository) CreateDocumentVersion(ctx context.Context, version buisnesModel.DocumentVersion, commit buisnesModel.CommitHook) (buisnesModel.DocumentVersion, error) {
+	return r.docRepo.CreateDocumentVersion(ctx, version, commit)
+}
+
+func (r *CompositeRepository) GetDocumentVersion(ctx context.Context, documentID string, version int) (buisnesModel.DocumentVersion, error) {
+	return r.docRepo.GetDocumentVersion(ctx, documentID, version)
+}
+
+func (r *CompositeRepository) GetDocumentVersions(ctx context.Context, documentID string) ([]buisnesModel.DocumentVersion, error) {
+	return r.docRepo.GetDocumentVersions(ctx, documentID)
+}
+
+func (r *CompositeRepository) GetVersionsToSize(ctx context.Context, limit int) ([]buisnesModel.DocumentVersion, error) {
+	return r.docRepo.GetVersionsToSize(ctx, limit)
+}
+
+func (r *CompositeRepository) SetVersionSize(ctx context.Context, versionID string, size int64) error {
+	return r.docRepo.SetVersionSize(ctx, versionID, size)
+}
+
+func (r *CompositeRepository) GetStoredFiles(ctx context.Context) ([]buisnesModel.StoredFile, error) {
+	return r.docRepo.GetStoredFiles(ctx)
+}
+
+func (r *CompositeRepository) DeleteStorageObject(ctx context.Context, key string, remove buisnesModel.CommitHook) (bool, error) {
+	return r.docRepo.DeleteStorageObject(ctx, key, remove)
+}
+
+func (r *CompositeRepository) ReconcileBlobReferences(ctx context.Context, repair bool) (int, error) {
+	return r.docRepo.ReconcileBlobReferences(ctx, repair)
+}
+
+// Методы для работы с папками (делегируем в docRepo)
+func (r *CompositeRepository) CreateFolder(ctx context.Context, folder buisnesModel.Folder) (buisnesModel.Folder, error) {
+	return r.docRepo.CreateFolder(ctx, folder)
+}
+
+func (r *CompositeRepository) GetFolder(ctx context.Context, id string) (buisnesModel.Folder, error) {
+	return r.docRepo.GetFolder(ctx, id)
+}
+
+func (r *CompositeRepository) GetFolderPath(ctx context.Context, folder buisnesModel.Folder) ([]buisnesModel.Folder, error) {
+	return r.docRepo.GetFolderPath(ctx, folder)
+}
+
+func (r *CompositeRepository) ListFolders(ctx context.Context, userID, parentID string) ([]buisnesModel.Folder, error) {
+	return r.docRepo.ListFolders(ctx, userID, parentID)
+}
+
+func (r *CompositeRepository) GetFolderTreeDepth(ctx context.Context, folder buisnesModel.Folder) (int, error) {
+	return r.docRepo.GetFolderTreeDepth(ctx, folder)
+}
+
+func (r *CompositeRepository) UpdateFolder(ctx context.Context, folder buisnesModel.Folder, oldPath string) (buisnesModel.Folder, error) {
+	return r.docRepo.UpdateFolder(ctx, folder, oldPath)
+}
+
+func (r *CompositeRepository) DeleteFolder(ctx context.Context, folder buisnesModel.Folder, recursive bool) (buisnesModel.FolderDeletion, error) {
+	return r.docRepo.DeleteFolder(ctx, folder, recursive)
+}
+
+// Методы для работы с тегами (делегируем в docRepo)
+
+func (r *CompositeRepository) AddDocumentTags(ctx context.Context, doc buisnesModel.Document, tags []string) error {
+	return r.docRepo.AddDocumentTags(ctx, doc, tags)
+}
+
+func (r *CompositeRepository) RemoveDocumentTag(ctx context.Context, doc buisnesModel.Document, tag string) error {
+	return r.docRepo.RemoveDocumentTag(ctx, doc, tag)
+}
+
+func (r *CompositeRepository) ListTags(ctx context.Context, userID string) ([]buisnesModel.Tag, error) {
+	return r.docRepo.ListTags(ctx, userID)
+}
+
+func (r *CompositeRepository) TrashDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	return r.docRepo.TrashDocument(ctx, id)
+}
+
+func (r *CompositeRepository) GetTrashedDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	return r.docRepo.GetTrashedDocument(ctx, id)
+}
+
+func (r *CompositeRepository) ListTrash(ctx context.Context, userID string) ([]buisnesModel.Document, error) {
+	return r.docRepo.ListTrash(ctx, userID)
+}
+
+func (r *CompositeRepository) GetExpiredTrash(ctx context.Context, before time.Time, limit int) ([]buisnesModel.Document, error) {
+	return r.docRepo.GetExpiredTrash(ctx, before, limit)
+}
+
+func (r *CompositeRepository) RestoreDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	return r.docRepo.RestoreDocument(ctx, id)
+}
+
+func (r *CompositeRepository) DeleteTrashedDocument(ctx context.Context, id string) ([]string, error) {
+	return r.docRepo.DeleteTrashedDocument(ctx, id)
+}
+
+func (r *CompositeRepository) CreateShareLink(ctx context.Context, link buisnesModel.ShareLink) (buisnesModel.ShareLink, error) {
+	return r.docRepo.CreateShareLink(ctx, link)
+}
+
+func (r *CompositeRepository) GetShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error) {
+	return r.docRepo.GetShareLink(ctx, id)
+}
+
+func (r *CompositeRepository) GetShareLinkBySlug(ctx context.Context, slug string) (buisnesModel.ShareLink, error) {
+	return r.docRepo.GetShareLinkBySlug(ctx, slug)
+}
+
+func (r *CompositeRepository) ListShareLinks(ctx context.Context, documentID string) ([]buisnesModel.ShareLink, error) {
+	return r.docRepo.ListShareLinks(ctx, documentID)
+}
+
+func (r *CompositeRepository) RevokeShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error) {
+	return r.docRepo.RevokeShareLink(ctx, id)
+}
+
+func (r *CompositeRepository) RecordShareDownload(ctx context.Context, id string, now time.Time) (buisnesModel.ShareLink, error) {
+	return r.docRepo.RecordShareDownload(ctx, id, now)
+}
+
 // Методы для работы с пользователями (делегируем в userRepo)
 func (r *CompositeRepository) CreateUser(ctx context.Context, user buisnesModel.User) (buisnesModel.User, error) {
 	return r.userRepo.CreateUser(ctx, user)
@@ -78,19 +314,185 @@ func (r *CompositeRepository) GetUserByID(ctx context.Context, userID string) (b
 	return r.userRepo.GetUserByID(ctx, userID)
 }
 
+func (r *CompositeRepository) UpdateUserRole(ctx context.Context, userID string, role buisnesModel.Role) error {
+	return r.userRepo.UpdateUserRole(ctx, userID, role)
+}
+
+func (r *CompositeRepository) SetUserDisabled(ctx context.Context, userID string, disabled bool) error {
+	return r.userRepo.SetUserDisabled(ctx, userID, disabled)
+}
+
+func (r *CompositeRepository) SetUserPassword(ctx context.Context, userID, passwordHash string, resetRequired bool) error {
+	return r.userRepo.SetUserPassword(ctx, userID, passwordHash, resetRequired)
+}
+
+func (r *CompositeRepository) SetPasswordResetRequired(ctx context.Context, userID string, required bool) error {
+	return r.userRepo.SetPasswordResetRequired(ctx, userID, required)
+}
+
+func (r *CompositeRepository) ClaimLoginAttempt(ctx context.Context, userID string, throttle buisnesModel.LoginThrottle) (buisnesModel.User, error) {
+	return r.userRepo.ClaimLoginAttempt(ctx, userID, throttle)
+}
+
+func (r *CompositeRepository) RestoreLoginFailures(ctx context.Context, previous, claimed buisnesModel.User) error {
+	return r.userRepo.RestoreLoginFailures(ctx, previous, claimed)
+}
+
+func (r *CompositeRepository) ResetLoginFailures(ctx context.Context, userID string) error {
+	return r.userRepo.ResetLoginFailures(ctx, userID)
+}
+
+func (r *CompositeRepository) SetTOTPSecret(ctx context.Context, userID, secret string) error {
+	return r.userRepo.SetTOTPSecret(ctx, userID, secret)
+}
+
+func (r *CompositeRepository) SetTOTPEnabled(ctx context.Context, userID string, enabled bool, step int64) error {
+	return r.userRepo.SetTOTPEnabled(ctx, userID, enabled, step)
+}
+
+func (r *CompositeRepository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	return r.userRepo.UseTOTPStep(ctx, userID, step)
+}
+
+func (r *CompositeRepository) GetUserByIdentity(ctx context.Context, issuer, subject string) (buisnesModel.User, error) {
+	return r.userRepo.GetUserByIdentity(ctx, issuer, subject)
+}
+
+func (r *CompositeRepository) CreateUserIdentity(ctx context.Context, identity buisnesModel.UserIdentity) error {
+	return r.userRepo.CreateUserIdentity(ctx, identity)
+}
+
+func (r *CompositeRepository) TouchUserIdentity(ctx context.Context, issuer, subject, email string) error {
+	return r.userRepo.TouchUserIdentity(ctx, issuer, subject, email)
+}
+
+func (r *CompositeRepository) ListUsers(ctx context.Context, filter buisnesModel.UserFilter) ([]buisnesModel.User, int, error) {
+	return r.userRepo.ListUsers(ctx, filter)
+}
+
+// DeleteUser - удаление пользователя выполняет репозиторий документов (снятие ссылок на содержимое)
+func (r *CompositeRepository) DeleteUser(ctx context.Context, userID, transferTo string) (buisnesModel.UserDeletion, error) {
+	return r.docRepo.DeleteUser(ctx, userID, transferTo)
+}
+
 // Методы для работы с токенами (делегируем в tokenRepo)
 func (r *CompositeRepository) CreateToken(ctx context.Context, token buisnesModel.Token) (buisnesModel.Token, error) {
 	return r.tokenRepo.CreateToken(ctx, token)
 }
 
-func (r *CompositeRepository) GetTokenByValue(ctx context.Context, tokenValue string) (buisnesModel.Token, error) {
-	return r.tokenRepo.GetTokenByValue(ctx, tokenValue)
+func (r *CompositeRepository) GetTokenByHash(ctx context.Context, tokenHash string) (buisnesModel.Token, error) {
+	return r.tokenRepo.GetTokenByHash(ctx, tokenHash)
+}
+
+func (r *CompositeRepository) GetTokenByID(ctx context.Context, id string) (buisnesModel.Token, error) {
+	return r.tokenRepo.GetTokenByID(ctx, id)
 }
 
-func (r *CompositeRepository) DeactivateToken(ctx context.Context, tokenValue string) error {
-	return r.tokenRepo.DeactivateToken(ctx, tokenValue)
+func (r *CompositeRepository) GetTokensByUserID(ctx context.Context, userID string) ([]buisnesModel.Token, error) {
+	return r.tokenRepo.GetTokensByUserID(ctx, userID)
+}
+
+func (r *CompositeRepository) DeactivateTokenByHash(ctx context.Context, tokenHash string) error {
+	return r.tokenRepo.DeactivateTokenByHash(ctx, tokenHash)
+}
+
+func (r *CompositeRepository) DeactivateTokenByID(ctx context.Context, id string) error {
+	return r.tokenRepo.DeactivateTokenByID(ctx, id)
 }
 
 func (r *CompositeRepository) DeactivateUserTokens(ctx context.Context, userID string) error {
 	return r.tokenRepo.DeactivateUserTokens(ctx, userID)
 }
+
+func (r *CompositeRepository) RevokeToken(ctx context.Context, revoked buisnesModel.RevokedToken) error {
+	return r.tokenRepo.RevokeToken(ctx, revoked)
+}
+
+func (r *CompositeRepository) GetRevokedTokens(ctx context.Context) ([]buisnesModel.RevokedToken, error) {
+	return r.tokenRepo.GetRevokedTokens(ctx)
+}
+
+func (r *CompositeRepository) DeleteExpiredRevokedTokens(ctx context.Context) (int64, error) {
+	return r.tokenRepo.DeleteExpiredRevokedTokens(ctx)
+}
+
+func (r *CompositeRepository) CreatePasswordResetToken(ctx context.Context, token buisnesModel.PasswordResetToken) error {
+	return r.tokenRepo.CreatePasswordResetToken(ctx, token)
+}
+
+func (r *CompositeRepository) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (buisnesModel.PasswordResetToken, error) {
+	return r.tokenRepo.ConsumePasswordResetToken(ctx, tokenHash)
+}
+
+func (r *CompositeRepository) CreateAPIKey(ctx context.Context, key buisnesModel.APIKey) error {
+	return r.tokenRepo.CreateAPIKey(ctx, key)
+}
+
+func (r *CompositeRepository) GetAPIKeyByHash(ctx context.Context, keyHash string) (buisnesModel.APIKey, error) {
+	return r.tokenRepo.GetAPIKeyByHash(ctx, keyHash)
+}
+
+func (r *CompositeRepository) GetAPIKeysByUserID(ctx context.Context, userID string) ([]buisnesModel.APIKey, error) {
+	return r.tokenRepo.GetAPIKeysByUserID(ctx, userID)
+}
+
+func (r *CompositeRepository) DeleteAPIKey(ctx context.Context, id, userID string) error {
+	return r.tokenRepo.DeleteAPIKey(ctx, id, userID)
+}
+
+func (r *CompositeRepository) TouchAPIKey(ctx context.Context, id, ipAddress string) error {
+	return r.tokenRepo.TouchAPIKey(ctx, id, ipAddress)
+}
+
+func (r *CompositeRepository) CreateLoginChallenge(ctx context.Context, challenge buisnesModel.LoginChallenge) error {
+	return r.tokenRepo.CreateLoginChallenge(ctx, challenge)
+}
+
+func (r *CompositeRepository) GetLoginChallenge(ctx context.Context, tokenHash string) (buisnesModel.LoginChallenge, error) {
+	return r.tokenRepo.GetLoginChallenge(ctx, tokenHash)
+}
+
+func (r *CompositeRepository) DeleteLoginChallenge(ctx context.Context, id string) error {
+	return r.tokenRepo.DeleteLoginChallenge(ctx, id)
+}
+
+func (r *CompositeRepository) FailLoginChallenge(ctx context.Context, id string, maxAttempts int) error {
+	return r.tokenRepo.FailLoginChallenge(ctx, id, maxAttempts)
+}
+
+func (r *CompositeRepository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	return r.tokenRepo.ReplaceRecoveryCodes(ctx, userID, codeHashes)
+}
+
+func (r *CompositeRepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	return r.tokenRepo.UseRecoveryCode(ctx, userID, codeHash)
+}
+
+func (r *CompositeRepository) CreateOIDCState(ctx context.Context, state buisnesModel.OIDCState) error {
+	return r.tokenRepo.CreateOIDCState(ctx, state)
+}
+
+func (r *CompositeRepository) ConsumeOIDCState(ctx context.Context, stateHash string) (buisnesModel.OIDCState, error) {
+	return r.tokenRepo.ConsumeOIDCState(ctx, stateHash)
+}
+
+// Методы для работы с сессиями загрузки (делегируем в uploadRepo)
+func (r *CompositeRepository) CreateUploadSession(ctx context.Context, session buisnesModel.UploadSession) (buisnesModel.UploadSession, error) {
+	return r.uploadRepo.CreateUploadSession(ctx, session)
+}
+
+func (r *CompositeRepository) GetUploadSession(ctx context.Context, id string) (buisnesModel.UploadSession, error) {
+	return r.uploadRepo.GetUploadSession(ctx, id)
+}
+
+func (r *CompositeRepository) GetExpiredUploadSessions(ctx context.Context, before time.Time) ([]buisnesModel.UploadSession, error) {
+	return r.uploadRepo.GetExpiredUploadSessions(ctx, before)
+}
+
+func (r *CompositeRepository) AdvanceUploadSession(ctx context.Context, id string, from, to int64, chunkKey string, expiresAt time.Time) (buisnesModel.UploadSession, error) {
+	return r.uploadRepo.AdvanceUploadSession(ctx, id, from, to, chunkKey, expiresAt)
+}
+
+func (r *CompositeRepository) DeleteUploadSession(ctx context.Context, id string) error {
+	return r.uploadRepo.DeleteUploadSession(ctx, id)
+}
@@ -0,0 +1,105 @@
+package doc
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// nullString - пустая строка записывается в БД как NULL
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// lockStorageKey - блокировка объекта хранилища до конца транзакции. Ее берут и загрузка перед
+// проверкой существующего объекта, и удаление перед проверкой ссылок, поэтому удаление не может
+// попасть между переиспользованием объекта новой загрузкой и фиксацией ее ссылки
+const lockStorageKey = `SELECT pg_advisory_xact_lock(hashtext($1))`
+
+// retainBlob - учет новой ссылки версии на содержимое (для файлов без digest ничего не делает)
+func (r *Repository) retainBlob(ctx context.Context, tx pgx.Tx, digest, storageKey string, size int64) error {
+	if digest == "" {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, lockStorageKey, storageKey); err != nil {
+		return err
+	}
+
+	query, args, err := r.sb.Insert("blobs").
+		Columns("digest", "storage_key", "size_bytes", "ref_count").
+		Values(digest, storageKey, size, 1).
+		Suffix("ON CONFLICT (digest) DO UPDATE SET ref_count = blobs.ref_count + 1").
+		ToSql()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx, query, args...)
+	return err
+}
+
+// releaseDocumentBlobs - снятие ссылок всех версий документа на содержимое.
+// Возвращает ключи хранилища, на которые больше никто не ссылается
+func (r *Repository) releaseDocumentBlobs(ctx context.Context, tx pgx.Tx, documentID string) ([]string, error) {
+	var released []string
+
+	// Файлы, загруженные до адресации по содержимому, принадлежат только своему документу
+	legacyQuery := `SELECT DISTINCT file_path FROM document_versions
+		WHERE document_id = $1 AND digest IS NULL AND file_path IS NOT NULL AND file_path <> ''`
+	rows, err := tx.Query(ctx, legacyQuery, documentID)
+	if err != nil {
+		return nil, err
+	}
+	legacy, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	released = append(released, legacy...)
+
+	// Строки blobs блокируются в порядке digest, чтобы параллельные удаления не взаимоблокировались
+	lockQuery := `SELECT digest FROM blobs
+		WHERE digest IN (SELECT digest FROM document_versions WHERE document_id = $1)
+		ORDER BY digest FOR UPDATE`
+	if _, err := tx.Exec(ctx, lockQuery, documentID); err != nil {
+		return nil, err
+	}
+
+	releaseQuery := `UPDATE blobs b SET ref_count = b.ref_count - refs.cnt
+		FROM (
+			SELECT digest, COUNT(*) AS cnt FROM document_versions
+			WHERE document_id = $1 AND digest IS NOT NULL
+			GROUP BY digest
+		) refs
+		WHERE b.digest = refs.digest
+		RETURNING b.digest, b.storage_key, b.ref_count`
+	rows, err = tx.Query(ctx, releaseQuery, documentID)
+	if err != nil {
+		return nil, err
+	}
+	var unused []string
+	for rows.Next() {
+		var digest, key string
+		var refCount int
+		if err := rows.Scan(&digest, &key, &refCount); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if refCount <= 0 {
+			unused = append(unused, digest)
+			released = append(released, key)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(unused) > 0 {
+		if _, err := tx.Exec(ctx, `DELETE FROM blobs WHERE digest = ANY($1) AND ref_count <= 0`, unused); err != nil {
+			return nil, err
+		}
+	}
+	return released, nil
+}
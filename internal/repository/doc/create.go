@@ -2,27 +2,76 @@ package doc
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 )
 
-// CreateDocument - создание документа
-func (r *Repository) CreateDocument(ctx context.Context, doc buisnesModel.Document) (buisnesModel.Document, error) {
+// CreateDocument - создание документа вместе с его первой версией.
+// commit (если задан) фиксирует содержимое в хранилище до фиксации транзакции
+func (r *Repository) CreateDocument(ctx context.Context, doc buisnesModel.Document, commit buisnesModel.CommitHook) (buisnesModel.Document, error) {
 	log.Printf("RepLayer: Начало загрузки документа %s\n", doc.Name)
+	doc.Version = 1
+	if doc.Metadata == nil {
+		doc.Metadata = buisnesModel.JSONData{}
+	}
+
 	query, args, err := r.sb.Insert("documents").
-		Columns("id", "user_id", "name", "mime_type", "file_path", "is_file", "is_public", "json_data", "grants", "created_at", "updated_at").
-		Values(doc.ID, doc.UserID, doc.Name, doc.MimeType, doc.FilePath, doc.IsFile, doc.IsPublic, doc.JSONData, doc.Grants, doc.CreatedAt, doc.UpdatedAt).
+		Columns("id", "user_id", "name", "mime_type", "file_path", "is_file", "is_public", "json_data", "grants", "current_version", "size_bytes", "digest", "created_at", "updated_at", "folder_id", "metadata").
+		Values(doc.ID, doc.UserID, doc.Name, doc.MimeType, doc.FilePath, doc.IsFile, doc.IsPublic, doc.JSONData, doc.Grants, doc.Version, doc.Size, nullString(doc.Digest), doc.CreatedAt, doc.UpdatedAt, nullString(doc.FolderID), doc.Metadata).
 		ToSql()
 	if err != nil {
 		log.Printf("RepLayer: ошибка подготовки запроса загрзки документа%s: %v \n", doc.Name, err)
 		return buisnesModel.Document{}, err
 	}
+
+	versionQuery, versionArgs, err := r.sb.Insert("document_versions").
+		Columns("document_id", "version", "file_path", "mime_type", "size_bytes", "digest", "json_data", "author_id", "created_at").
+		Values(doc.ID, doc.Version, doc.FilePath, doc.MimeType, doc.Size, nullString(doc.Digest), doc.JSONData, doc.UserID, doc.CreatedAt).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания версии документа %s: %v \n", doc.Name, err)
+		return buisnesModel.Document{}, err
+	}
 	log.Printf("RepLayer: Запрос для загрузки документа %s подготовлен \n", doc.Name)
-	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v \n", err)
+		return buisnesModel.Document{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		if isUniqueViolation(err) {
+			// Документ с тем же именем создан параллельно после проверки в сервисе
+			return buisnesModel.Document{}, fmt.Errorf("%w: '%s'", buisnesModel.ErrDocumentNameExists, doc.Name)
+		}
 		log.Printf("RepLayer: ошибка загрузки документа %s: %v \n", doc.Name, err)
 		return buisnesModel.Document{}, err
 	}
+	if _, err := tx.Exec(ctx, versionQuery, versionArgs...); err != nil {
+		log.Printf("RepLayer: ошибка создания первой версии документа %s: %v \n", doc.Name, err)
+		return buisnesModel.Document{}, err
+	}
+	if err := r.retainBlob(ctx, tx, doc.Digest, doc.FilePath, doc.Size); err != nil {
+		log.Printf("RepLayer: ошибка учета ссылки на содержимое документа %s: %v \n", doc.Name, err)
+		return buisnesModel.Document{}, err
+	}
+
+	if commit != nil {
+		if err := commit(ctx); err != nil {
+			log.Printf("RepLayer: ошибка фиксации содержимого документа %s: %v \n", doc.Name, err)
+			return buisnesModel.Document{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v \n", err)
+		return buisnesModel.Document{}, err
+	}
+
 	log.Printf("RepLayer: Документ %s загружен \n", doc.Name)
 	return doc, nil
 }
@@ -0,0 +1,117 @@
+package doc
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateDocumentVersion - добавление новой версии и перенос ее содержимого в documents как текущего.
+// commit (если задан) фиксирует содержимое в хранилище до фиксации транзакции
+func (r *Repository) CreateDocumentVersion(ctx context.Context, version buisnesModel.DocumentVersion, commit buisnesModel.CommitHook) (buisnesModel.DocumentVersion, error) {
+	log.Printf("RepLayer: Начало создания версии документа %s\n", version.DocumentID)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return buisnesModel.DocumentVersion{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	version, current, err := r.addVersion(ctx, tx, version)
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, err
+	}
+
+	if commit != nil {
+		if err := commit(ctx); err != nil {
+			log.Printf("RepLayer: ошибка фиксации содержимого версии %d: %v\n", version.Version, err)
+			return buisnesModel.DocumentVersion{}, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return buisnesModel.DocumentVersion{}, err
+	}
+
+	log.Printf("RepLayer: Версия %d документа %s создана (предыдущая текущая: %d)\n", version.Version, version.DocumentID, current)
+	return version, nil
+}
+
+// addVersion - вставка новой версии в транзакции tx и перенос ее содержимого в documents как текущего.
+// Возвращает созданную версию и номер предыдущей текущей версии
+func (r *Repository) addVersion(ctx context.Context, tx pgx.Tx, version buisnesModel.DocumentVersion) (buisnesModel.DocumentVersion, int, error) {
+	// Блокируем строку документа, чтобы параллельные загрузки не получили одинаковый номер
+	lockQuery, lockArgs, err := r.sb.Select("current_version").
+		From("documents").
+		Where(squirrel.Eq{"id": version.DocumentID, "deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	var current int
+	if err := tx.QueryRow(ctx, lockQuery, lockArgs...).Scan(&current); err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.DocumentVersion{}, 0, buisnesModel.ErrNotFound
+		}
+		log.Printf("RepLayer: ошибка блокировки документа %s: %v\n", version.DocumentID, err)
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+
+	maxQuery, maxArgs, err := r.sb.Select("COALESCE(MAX(version), 0)").
+		From("document_versions").
+		Where(squirrel.Eq{"document_id": version.DocumentID}).
+		ToSql()
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	var latest int
+	if err := tx.QueryRow(ctx, maxQuery, maxArgs...).Scan(&latest); err != nil {
+		log.Printf("RepLayer: ошибка получения номера последней версии: %v\n", err)
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	version.Version = latest + 1
+
+	insertQuery, insertArgs, err := r.sb.Insert("document_versions").
+		Columns("document_id", "version", "file_path", "mime_type", "size_bytes", "digest", "json_data", "author_id", "created_at").
+		Values(version.DocumentID, version.Version, version.FilePath, version.MimeType, version.Size, nullString(version.Digest), version.JSONData, version.AuthorID, version.CreatedAt).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	if err := tx.QueryRow(ctx, insertQuery, insertArgs...).Scan(&version.ID); err != nil {
+		log.Printf("RepLayer: ошибка вставки версии %d: %v\n", version.Version, err)
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	if err := r.retainBlob(ctx, tx, version.Digest, version.FilePath, version.Size); err != nil {
+		log.Printf("RepLayer: ошибка учета ссылки на содержимое версии %d: %v\n", version.Version, err)
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+
+	updateQuery, updateArgs, err := r.sb.Update("documents").
+		Set("current_version", version.Version).
+		Set("file_path", version.FilePath).
+		Set("mime_type", version.MimeType).
+		Set("size_bytes", version.Size).
+		Set("digest", nullString(version.Digest)).
+		Set("json_data", version.JSONData).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": version.DocumentID}).
+		ToSql()
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+	if _, err := tx.Exec(ctx, updateQuery, updateArgs...); err != nil {
+		log.Printf("RepLayer: ошибка обновления текущей версии документа: %v\n", err)
+		return buisnesModel.DocumentVersion{}, 0, err
+	}
+
+	return version, current, nil
+}
@@ -8,32 +8,63 @@ import (
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 )
 
-// DeleteDocument - удаление документа по ID
-func (r *Repository) DeleteDocument(ctx context.Context, id string) error {
+// DeleteDocument - окончательное удаление документа по ID (в том числе из корзины).
+// Возвращает ключи хранилища, которые после удаления больше не используются
+func (r *Repository) DeleteDocument(ctx context.Context, id string) ([]string, error) {
+	return r.deleteDocument(ctx, squirrel.Eq{"id": id}, id)
+}
+
+// DeleteTrashedDocument - окончательное удаление документа, только если он все еще в корзине
+// (иначе model.ErrNotFound: документ успели восстановить). Возвращает освободившиеся ключи хранилища
+func (r *Repository) DeleteTrashedDocument(ctx context.Context, id string) ([]string, error) {
+	return r.deleteDocument(ctx, squirrel.And{squirrel.Eq{"id": id}, squirrel.NotEq{"deleted_at": nil}}, id)
+}
+
+// deleteDocument - удаление строки документа по условию where вместе со ссылками на содержимое
+func (r *Repository) deleteDocument(ctx context.Context, where squirrel.Sqlizer, id string) ([]string, error) {
 	log.Printf("RepLayer: Начало удаления документа %s\n", id)
 
 	query, args, err := r.sb.Delete("documents").
-		Where(squirrel.Eq{"id": id}).
+		Where(where).
 		ToSql()
 	if err != nil {
 		log.Printf("RepLayer: ошибка подготовки запроса удаления документа %s: %v\n", id, err)
-		return err
+		return nil, err
 	}
 
 	log.Printf("RepLayer: Запрос для удаления документа %s подготовлен\n", id)
 
-	result, err := r.pool.Exec(ctx, query, args...)
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Ссылки снимаются до удаления, пока версии документа еще существуют
+	released, err := r.releaseDocumentBlobs(ctx, tx, id)
+	if err != nil {
+		log.Printf("RepLayer: ошибка снятия ссылок на содержимое документа %s: %v\n", id, err)
+		return nil, err
+	}
+
+	result, err := tx.Exec(ctx, query, args...)
 	if err != nil {
 		log.Printf("RepLayer: ошибка удаления документа %s: %v\n", id, err)
-		return err
+		return nil, err
 	}
 
-	// Проверяем, что документ был удален
+	// Проверяем, что документ был удален; иначе снятие ссылок откатывается вместе с транзакцией
 	if result.RowsAffected() == 0 {
 		log.Printf("RepLayer: документ %s не найден для удаления\n", id)
-		return buisnesModel.ErrNotFound
+		return nil, buisnesModel.ErrNotFound
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return nil, err
 	}
 
-	log.Printf("RepLayer: Документ %s удален\n", id)
-	return nil
+	log.Printf("RepLayer: Документ %s удален, освобождено объектов хранилища: %d\n", id, len(released))
+	return released, nil
 }
@@ -0,0 +1,121 @@
+package doc
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// DeleteUser - удаление пользователя вместе с его документами и папками или с передачей документов transferTo.
+// Находится в репозитории документов, так как удаление документов снимает ссылки на содержимое;
+// токены, сессии загрузки и отозванные JWT удаляются каскадом
+func (r *Repository) DeleteUser(ctx context.Context, userID, transferTo string) (buisnesModel.UserDeletion, error) {
+	log.Printf("RepLayer: Начало удаления пользователя %s\n", userID)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return buisnesModel.UserDeletion{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем пользователя, чтобы он не создал документы во время удаления
+	var locked string
+	if err := tx.QueryRow(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&locked); err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.UserDeletion{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.UserDeletion{}, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT id FROM documents WHERE user_id = $1 ORDER BY id`, userID)
+	if err != nil {
+		return buisnesModel.UserDeletion{}, err
+	}
+	documentIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return buisnesModel.UserDeletion{}, err
+	}
+
+	var result buisnesModel.UserDeletion
+	if transferTo != "" {
+		// Папки удаляются вместе с пользователем, поэтому переданные документы попадают в корень нового владельца,
+		// а документы из корзины - в его корзину. Имена, совпавшие с документами в корне нового владельца
+		// или между собой, получают суффикс из ID документа перед расширением
+		if _, err := tx.Exec(ctx, `UPDATE documents d SET name = regexp_replace(LEFT(d.name, 240), '(\.[^.]+)?$', ' (' || LEFT(d.id, 8) || ')\1')
+			FROM (
+				SELECT id, name, ROW_NUMBER() OVER (PARTITION BY LOWER(name) ORDER BY folder_id IS NOT NULL, created_at, id) AS n
+				FROM documents WHERE user_id = $2 AND deleted_at IS NULL
+			) moved
+			WHERE d.id = moved.id AND (moved.n > 1 OR EXISTS (
+				SELECT 1 FROM documents t
+				WHERE t.user_id = $1 AND t.folder_id IS NULL AND t.deleted_at IS NULL AND LOWER(t.name) = LOWER(moved.name)
+			))`, transferTo, userID); err != nil {
+			log.Printf("RepLayer: ошибка переименования передаваемых документов пользователя %s: %v\n", userID, err)
+			return buisnesModel.UserDeletion{}, err
+		}
+		if _, err := tx.Exec(ctx, `UPDATE documents SET user_id = $1, folder_id = NULL, updated_at = CURRENT_TIMESTAMP WHERE user_id = $2`, transferTo, userID); err != nil {
+			if isUniqueViolation(err) {
+				// Новый владелец параллельно создал документ с тем же именем в корне
+				return buisnesModel.UserDeletion{}, buisnesModel.ErrConflict
+			}
+			log.Printf("RepLayer: ошибка передачи документов пользователя %s: %v\n", userID, err)
+			return buisnesModel.UserDeletion{}, err
+		}
+		// Теги переходят в словарь нового владельца, чтобы документы сохранили свои теги
+		if _, err := tx.Exec(ctx, `INSERT INTO tags (user_id, name) SELECT $1, name FROM tags WHERE user_id = $2
+			ON CONFLICT (user_id, name) DO NOTHING`, transferTo, userID); err != nil {
+			log.Printf("RepLayer: ошибка передачи тегов пользователя %s: %v\n", userID, err)
+			return buisnesModel.UserDeletion{}, err
+		}
+		if _, err := tx.Exec(ctx, `UPDATE document_tags dt SET tag_id = n.id FROM tags o
+			JOIN tags n ON n.user_id = $1 AND n.name = o.name
+			WHERE dt.tag_id = o.id AND o.user_id = $2`, transferTo, userID); err != nil {
+			log.Printf("RepLayer: ошибка передачи тегов документов пользователя %s: %v\n", userID, err)
+			return buisnesModel.UserDeletion{}, err
+		}
+		result.TransferredDocuments = documentIDs
+	} else {
+		for _, id := range documentIDs {
+			released, err := r.releaseDocumentBlobs(ctx, tx, id)
+			if err != nil {
+				log.Printf("RepLayer: ошибка снятия ссылок на содержимое документа %s: %v\n", id, err)
+				return buisnesModel.UserDeletion{}, err
+			}
+			result.Released = append(result.Released, released...)
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM documents WHERE user_id = $1`, userID); err != nil {
+			log.Printf("RepLayer: ошибка удаления документов пользователя %s: %v\n", userID, err)
+			return buisnesModel.UserDeletion{}, err
+		}
+		result.DeletedDocuments = documentIDs
+	}
+
+	// Фрагменты незавершенных загрузок больше никому не нужны
+	rows, err = tx.Query(ctx, `SELECT jsonb_array_elements_text(chunks) FROM upload_sessions WHERE user_id = $1`, userID)
+	if err != nil {
+		return buisnesModel.UserDeletion{}, err
+	}
+	chunks, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return buisnesModel.UserDeletion{}, err
+	}
+	result.Released = append(result.Released, chunks...)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM users WHERE id = $1`, userID); err != nil {
+		log.Printf("RepLayer: ошибка удаления пользователя %s: %v\n", userID, err)
+		return buisnesModel.UserDeletion{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return buisnesModel.UserDeletion{}, err
+	}
+
+	log.Printf("RepLayer: Пользователь %s удален: документов удалено %d, передано %d, освобождено объектов хранилища %d\n",
+		userID, len(result.DeletedDocuments), len(result.TransferredDocuments), len(result.Released))
+	return result, nil
+}
@@ -0,0 +1,232 @@
+package doc
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// likeEscaper - экранирование спецсимволов LIKE в значении фильтра
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// documentConditions - условия выборки без учета курсора: документ не в корзине, владелец, видимость для запрашивающего и фильтр
+func documentConditions(query buisnesModel.DocumentQuery) squirrel.And {
+	where := squirrel.And{squirrel.Eq{"deleted_at": nil}}
+	if query.OwnerID != "" {
+		where = append(where, squirrel.Eq{"user_id": query.OwnerID})
+	}
+	if !query.ViewAll && query.OwnerID != query.ViewerID {
+		where = append(where, squirrel.Or{
+			squirrel.Eq{"user_id": query.ViewerID},
+			squirrel.Eq{"is_public": true},
+			grantedTo(query.ViewerLogin),
+			sharedByFolder(query.ViewerLogin),
+		})
+	}
+
+	for _, cond := range query.Filters {
+		where = append(where, filterCondition(cond, query.ViewerLogin))
+	}
+	return where
+}
+
+// filterCondition - SQL для одного условия фильтра
+func filterCondition(cond buisnesModel.DocumentCondition, viewerLogin string) squirrel.Sqlizer {
+	switch cond.Field {
+	case buisnesModel.FilterFieldName:
+		switch cond.Op {
+		case buisnesModel.FilterPrefix:
+			return squirrel.ILike{"name": likeEscaper.Replace(cond.Value) + "%"}
+		case buisnesModel.FilterContains:
+			return squirrel.ILike{"name": "%" + likeEscaper.Replace(cond.Value) + "%"}
+		case buisnesModel.FilterNe:
+			return squirrel.NotEq{"name": cond.Value}
+		}
+		return squirrel.Eq{"name": cond.Value}
+	case buisnesModel.FilterFieldMime:
+		// Маска image/* - любой подтип, сравнение MIME-типов без учета регистра
+		pattern := strings.ReplaceAll(likeEscaper.Replace(cond.Value), "*", "%")
+		if cond.Op == buisnesModel.FilterNe {
+			return squirrel.Expr("COALESCE(mime_type, '') NOT ILIKE ?", pattern)
+		}
+		return squirrel.ILike{"mime_type": pattern}
+	case buisnesModel.FilterFieldCreated:
+		return timeCondition("created_at", cond)
+	case buisnesModel.FilterFieldUpdated:
+		return timeCondition("updated_at", cond)
+	case buisnesModel.FilterFieldSize:
+		return compare("size_bytes", cond.Op, cond.Size)
+	case buisnesModel.FilterFieldOwner:
+		return squirrel.Expr("user_id IN (SELECT id FROM users WHERE login = ?)", cond.Value)
+	case buisnesModel.FilterFieldGranted:
+		return grantedTo(viewerLogin)
+	case buisnesModel.FilterFieldPublic:
+		return squirrel.Eq{"is_public": cond.Bool}
+	case buisnesModel.FilterFieldFile:
+		return squirrel.Eq{"is_file": cond.Bool}
+	case buisnesModel.FilterFieldFolder:
+		if cond.Value == buisnesModel.RootFolder {
+			return squirrel.Eq{"folder_id": nil}
+		}
+		return squirrel.Eq{"folder_id": cond.Value}
+	case buisnesModel.FilterFieldTag:
+		if cond.Op == buisnesModel.FilterNe {
+			return squirrel.Expr("NOT ?", taggedWith(cond.Tags))
+		}
+		return taggedWith(cond.Tags)
+	case buisnesModel.FilterFieldJSON:
+		return jsonCondition(cond)
+	case buisnesModel.FilterFieldMeta:
+		return metaCondition(cond)
+	}
+	return squirrel.Expr("FALSE")
+}
+
+// grantedTo - документ выдан логину через grants
+func grantedTo(login string) squirrel.Sqlizer {
+	return squirrel.Expr("grants @> jsonb_build_array(?::text)", login)
+}
+
+// sharedByFolder - документ лежит в папке, которая сама или через одного из предков публична
+// или выдана логину: доступ к папке наследуется всем ее содержимым
+func sharedByFolder(login string) squirrel.Sqlizer {
+	return squirrel.Expr(`EXISTS (SELECT 1 FROM folders f
+		JOIN folders a ON a.id = ANY(string_to_array(btrim(f.path, '/'), '/'))
+		WHERE f.id = documents.folder_id AND (a.is_public OR a.grants @> jsonb_build_array(?::text)))`, login)
+}
+
+// taggedWith - у документа есть хотя бы один из тегов
+func taggedWith(tags []string) squirrel.Sqlizer {
+	return squirrel.Expr(`EXISTS (SELECT 1 FROM document_tags dt JOIN tags t ON t.id = dt.tag_id
+		WHERE dt.document_id = documents.id AND t.name = ANY(?::text[]))`, tags)
+}
+
+// timeCondition - сравнение даты с интервалом [From, Until), заданным точностью значения фильтра
+func timeCondition(column string, cond buisnesModel.DocumentCondition) squirrel.Sqlizer {
+	switch cond.Op {
+	case buisnesModel.FilterGt:
+		return squirrel.GtOrEq{column: cond.Until}
+	case buisnesModel.FilterGe:
+		return squirrel.GtOrEq{column: cond.From}
+	case buisnesModel.FilterLt:
+		return squirrel.Lt{column: cond.From}
+	case buisnesModel.FilterLe:
+		return squirrel.Lt{column: cond.Until}
+	}
+	return squirrel.And{squirrel.GtOrEq{column: cond.From}, squirrel.Lt{column: cond.Until}}
+}
+
+// compare - сравнение колонки со значением оператором фильтра
+func compare(column string, op buisnesModel.FilterOp, value any) squirrel.Sqlizer {
+	switch op {
+	case buisnesModel.FilterNe:
+		return squirrel.NotEq{column: value}
+	case buisnesModel.FilterGt:
+		return squirrel.Gt{column: value}
+	case buisnesModel.FilterGe:
+		return squirrel.GtOrEq{column: value}
+	case buisnesModel.FilterLt:
+		return squirrel.Lt{column: value}
+	case buisnesModel.FilterLe:
+		return squirrel.LtOrEq{column: value}
+	}
+	return squirrel.Eq{column: value}
+}
+
+// jsonCondition - условие на поле json_data по пути. Числа сравниваются как numeric только
+// у числовых значений JSON, строковое равенство сравнивает текстовое представление
+func jsonCondition(cond buisnesModel.DocumentCondition) squirrel.Sqlizer {
+	text := squirrel.Expr("json_data #>> ?::text[]", cond.JSONPath)
+	number := squirrel.Expr(
+		"CASE WHEN jsonb_typeof(json_data #> ?::text[]) = 'number' THEN (json_data #>> ?::text[])::numeric END",
+		cond.JSONPath, cond.JSONPath,
+	)
+
+	switch cond.Op {
+	case buisnesModel.FilterContains:
+		return squirrel.Expr("? ILIKE ?", text, "%"+likeEscaper.Replace(cond.Value)+"%")
+	case buisnesModel.FilterGt, buisnesModel.FilterGe, buisnesModel.FilterLt, buisnesModel.FilterLe:
+		return squirrel.Expr("? "+string(cond.Op)+" ?::numeric", number, cond.Value)
+	}
+
+	equal := squirrel.Sqlizer(squirrel.Expr("? = ?", text, cond.Value))
+	if cond.Numeric {
+		equal = squirrel.Or{equal, squirrel.Expr("? = ?::numeric", number, cond.Value)}
+	}
+	if cond.Op == buisnesModel.FilterNe {
+		// Документы без поля тоже считаются неравными
+		return squirrel.Expr("NOT COALESCE(?, FALSE)", equal)
+	}
+	return equal
+}
+
+// metaCondition - условие на ключ метаданных. Равенство проверяется вхождением (@>), которое обслуживает
+// GIN индекс по metadata; сравнения берут только числовые значения или строки-даты в формате RFC 3339 UTC
+func metaCondition(cond buisnesModel.DocumentCondition) squirrel.Sqlizer {
+	key := cond.JSONPath[0]
+	text := squirrel.Expr("metadata ->> ?::text", key)
+	date := squirrel.Expr("CASE WHEN metadata ->> ?::text ~ ? THEN metadata ->> ?::text END", key, buisnesModel.MetadataDatePattern, key)
+	number := squirrel.Expr(
+		"CASE WHEN jsonb_typeof(metadata -> ?::text) = 'number' THEN (metadata ->> ?::text)::numeric END",
+		key, key,
+	)
+	from := cond.From.Format(buisnesModel.MetadataDateLayout)
+	until := cond.Until.Format(buisnesModel.MetadataDateLayout)
+
+	switch cond.Op {
+	case buisnesModel.FilterContains:
+		return squirrel.Expr("? ILIKE ?", text, "%"+likeEscaper.Replace(cond.Value)+"%")
+	case buisnesModel.FilterGt, buisnesModel.FilterGe, buisnesModel.FilterLt, buisnesModel.FilterLe:
+		if !cond.Date {
+			return squirrel.Expr("? "+string(cond.Op)+" ?::numeric", number, cond.Value)
+		}
+		// Интервал даты как в timeCondition: "> 2024-01-31" - начиная со следующего дня
+		switch cond.Op {
+		case buisnesModel.FilterGt:
+			return squirrel.Expr("? >= ?", date, until)
+		case buisnesModel.FilterGe:
+			return squirrel.Expr("? >= ?", date, from)
+		case buisnesModel.FilterLt:
+			return squirrel.Expr("? < ?", date, from)
+		}
+		return squirrel.Expr("? < ?", date, until)
+	}
+
+	equal := squirrel.Or{metaContains(key, cond.Value)}
+	if cond.Numeric {
+		equal = append(equal, metaContains(key, json.RawMessage(cond.Value)))
+	}
+	if cond.Value == "true" || cond.Value == "false" {
+		equal = append(equal, metaContains(key, cond.Value == "true"))
+	}
+	if cond.Date {
+		equal = append(equal, squirrel.Expr("(? >= ? AND ? < ?)", date, from, date, until))
+	}
+	if cond.Op == buisnesModel.FilterNe {
+		// Документы без ключа тоже считаются неравными
+		return squirrel.Expr("NOT COALESCE(?, FALSE)", equal)
+	}
+	return equal
+}
+
+// metaContains - метаданные содержат пару ключ-значение
+func metaContains(key string, value any) squirrel.Sqlizer {
+	pair, err := json.Marshal(map[string]any{key: value})
+	if err != nil {
+		return invalidCondition{buisnesModel.NewValidationError("Некорректное значение ключа метаданных '"+key+"' в фильтре", buisnesModel.ErrInvalidInput)}
+	}
+	return squirrel.Expr("metadata @> ?::jsonb", string(pair))
+}
+
+// invalidCondition - условие, значение которого нельзя передать в SQL: построение запроса
+// завершается ошибкой валидации (400), а не ошибкой БД
+type invalidCondition struct {
+	err error
+}
+
+func (c invalidCondition) ToSql() (string, []any, error) {
+	return "", nil, c.err
+}
@@ -0,0 +1,107 @@
+package doc
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+func TestFilterConditionMeta(t *testing.T) {
+	tests := []struct {
+		raw      string
+		wantSQL  []string // Фрагменты, которые должны быть в SQL
+		wantArgs []any    // Аргументы, которые должны быть среди аргументов
+	}{
+		{
+			raw:      "meta.count=12",
+			wantSQL:  []string{"metadata @> ?::jsonb"},
+			wantArgs: []any{`{"count":"12"}`, `{"count":12}`},
+		},
+		{
+			raw:      "meta.count=007",
+			wantSQL:  []string{"metadata @> ?::jsonb"},
+			wantArgs: []any{`{"count":"007"}`},
+		},
+		{
+			raw:      "meta.done=true",
+			wantArgs: []any{`{"done":"true"}`, `{"done":true}`},
+		},
+		{
+			raw:     "meta.count!=5",
+			wantSQL: []string{"NOT COALESCE("},
+		},
+		{
+			raw:      "meta.count>=2.5",
+			wantSQL:  []string{">= ?::numeric"},
+			wantArgs: []any{"2.5"},
+		},
+		{
+			raw:      "meta.due<2025-01-01",
+			wantSQL:  []string{"metadata ->> ?::text ~ ?"},
+			wantArgs: []any{"2025-01-01T00:00:00Z"},
+		},
+		{
+			raw:      "meta.title~50%",
+			wantSQL:  []string{"ILIKE ?"},
+			wantArgs: []any{`%50\%%`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			cond, err := buisnesModel.ParseDocumentFilter(tt.raw)
+			if err != nil {
+				t.Fatalf("ParseDocumentFilter(%q): %v", tt.raw, err)
+			}
+
+			sql, args, err := filterCondition(cond, "viewer").ToSql()
+			if err != nil {
+				t.Fatalf("ToSql(%q): %v", tt.raw, err)
+			}
+			for _, fragment := range tt.wantSQL {
+				if !strings.Contains(sql, fragment) {
+					t.Errorf("SQL %q does not contain %q", sql, fragment)
+				}
+			}
+			for _, want := range tt.wantArgs {
+				if !containsArg(args, want) {
+					t.Errorf("args %v do not contain %v", args, want)
+				}
+			}
+			// Каждое значение для @> должно быть корректным JSON
+			for _, arg := range args {
+				if s, ok := arg.(string); ok && strings.HasPrefix(s, "{") && !json.Valid([]byte(s)) {
+					t.Errorf("invalid jsonb argument %q", s)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterConditionInvalidMetaValue(t *testing.T) {
+	// Значение, которое разбор принял за число, но которое не является JSON, дает ошибку валидации, а не SQL с ''::jsonb
+	cond := buisnesModel.DocumentCondition{
+		Field:    buisnesModel.FilterFieldMeta,
+		JSONPath: []string{"count"},
+		Op:       buisnesModel.FilterEq,
+		Value:    "007",
+		Numeric:  true,
+	}
+
+	_, _, err := filterCondition(cond, "viewer").ToSql()
+	if !errors.Is(err, buisnesModel.ErrInvalidInput) {
+		t.Fatalf("ToSql error = %v, want ErrInvalidInput", err)
+	}
+}
+
+func containsArg(args []any, want any) bool {
+	for _, arg := range args {
+		if arg == want {
+			return true
+		}
+	}
+	return false
+}
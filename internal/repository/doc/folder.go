@@ -0,0 +1,311 @@
+package doc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// folderColumns - колонки таблицы folders в порядке сканирования в scanFolder
+var folderColumns = []string{
+	"id", "user_id", "COALESCE(parent_id, '')", "name", "path", "is_public", "grants", "created_at", "updated_at",
+}
+
+// CreateFolder - создание папки. Папка с тем же именем среди соседних - model.ErrConflict
+func (r *Repository) CreateFolder(ctx context.Context, folder buisnesModel.Folder) (buisnesModel.Folder, error) {
+	log.Printf("RepLayer: Начало создания папки %s\n", folder.Name)
+
+	query, args, err := r.sb.Insert("folders").
+		Columns("id", "user_id", "parent_id", "name", "path", "is_public", "grants", "created_at", "updated_at").
+		Values(folder.ID, folder.UserID, nullString(folder.ParentID), folder.Name, folder.Path, folder.IsPublic, folder.Grants, folder.CreatedAt, folder.CreatedAt).
+		Suffix("RETURNING " + strings.Join(folderColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания папки %s: %v\n", folder.Name, err)
+		return buisnesModel.Folder{}, err
+	}
+
+	created, err := scanFolder(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return buisnesModel.Folder{}, buisnesModel.ErrConflict
+		}
+		log.Printf("RepLayer: ошибка создания папки %s: %v\n", folder.Name, err)
+		return buisnesModel.Folder{}, err
+	}
+
+	log.Printf("RepLayer: Папка %s создана с ID %s\n", created.Name, created.ID)
+	return created, nil
+}
+
+// GetFolder - получение папки по ID
+func (r *Repository) GetFolder(ctx context.Context, id string) (buisnesModel.Folder, error) {
+	query, args, err := r.sb.Select(folderColumns...).From("folders").Where(squirrel.Eq{"id": id}).ToSql()
+	if err != nil {
+		return buisnesModel.Folder{}, err
+	}
+
+	folder, err := scanFolder(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.Folder{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.Folder{}, err
+	}
+	return folder, nil
+}
+
+// GetFolderPath - папки пути от корня до folder включительно
+func (r *Repository) GetFolderPath(ctx context.Context, folder buisnesModel.Folder) ([]buisnesModel.Folder, error) {
+	query, args, err := r.sb.Select(folderColumns...).
+		From("folders").
+		Where(squirrel.Eq{"id": folder.PathIDs()}).
+		OrderBy("LENGTH(path)").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения пути папки %s: %v", folder.ID, err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Folder, error) {
+		return scanFolder(row)
+	})
+}
+
+// ListFolders - вложенные папки parentID (пустой - папки в корне пользователя userID) по имени
+func (r *Repository) ListFolders(ctx context.Context, userID, parentID string) ([]buisnesModel.Folder, error) {
+	where := squirrel.Eq{"parent_id": parentID}
+	if parentID == "" {
+		where = squirrel.Eq{"user_id": userID, "parent_id": nil}
+	}
+
+	query, args, err := r.sb.Select(folderColumns...).From("folders").Where(where).OrderBy("LOWER(name)", "id").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения вложенных папок: %v", err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Folder, error) {
+		return scanFolder(row)
+	})
+}
+
+// GetFolderTreeDepth - уровень самой глубокой папки поддерева folder (включая ее саму)
+func (r *Repository) GetFolderTreeDepth(ctx context.Context, folder buisnesModel.Folder) (int, error) {
+	var depth int
+	err := r.pool.QueryRow(ctx,
+		`SELECT COALESCE(MAX(array_length(string_to_array(btrim(path, '/'), '/'), 1)), 0) FROM folders WHERE path LIKE $1`,
+		likeEscaper.Replace(folder.Path)+"%",
+	).Scan(&depth)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения глубины папки %s: %v", folder.ID, err)
+		return 0, err
+	}
+	return depth, nil
+}
+
+// UpdateFolder - изменение имени, родителя, публичности и grants папки. При переносе пути
+// вложенных папок переписываются в той же транзакции; если путь новой родительской папки изменился
+// после проверки в сервисе (параллельный перенос), возвращается model.ErrConflict
+func (r *Repository) UpdateFolder(ctx context.Context, folder buisnesModel.Folder, oldPath string) (buisnesModel.Folder, error) {
+	log.Printf("RepLayer: Начало изменения папки %s\n", folder.ID)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return buisnesModel.Folder{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Изменения дерева одного владельца выполняются по очереди
+	if err := lockFolderOwner(ctx, tx, folder.UserID); err != nil {
+		return buisnesModel.Folder{}, err
+	}
+
+	if folder.Path != oldPath && folder.ParentID != "" {
+		var parentPath string
+		if err := tx.QueryRow(ctx, `SELECT path FROM folders WHERE id = $1`, folder.ParentID).Scan(&parentPath); err != nil {
+			if err == pgx.ErrNoRows {
+				return buisnesModel.Folder{}, buisnesModel.ErrConflict
+			}
+			return buisnesModel.Folder{}, err
+		}
+		if parentPath+folder.ID+"/" != folder.Path {
+			log.Printf("RepLayer: путь папки %s изменился во время переноса\n", folder.ParentID)
+			return buisnesModel.Folder{}, buisnesModel.ErrConflict
+		}
+	}
+
+	query, args, err := r.sb.Update("folders").
+		Set("name", folder.Name).
+		Set("parent_id", nullString(folder.ParentID)).
+		Set("path", folder.Path).
+		Set("is_public", folder.IsPublic).
+		Set("grants", folder.Grants).
+		Set("updated_at", squirrel.Expr("CURRENT_TIMESTAMP")).
+		Where(squirrel.Eq{"id": folder.ID, "path": oldPath}).
+		Suffix("RETURNING " + strings.Join(folderColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса изменения папки %s: %v\n", folder.ID, err)
+		return buisnesModel.Folder{}, err
+	}
+
+	updated, err := scanFolder(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		switch {
+		case err == pgx.ErrNoRows:
+			// Папка удалена или перенесена, пока сервис проверял изменение
+			return buisnesModel.Folder{}, buisnesModel.ErrConflict
+		case isUniqueViolation(err):
+			return buisnesModel.Folder{}, buisnesModel.ErrConflict
+		}
+		log.Printf("RepLayer: ошибка изменения папки %s: %v\n", folder.ID, err)
+		return buisnesModel.Folder{}, err
+	}
+
+	if folder.Path != oldPath {
+		moved, err := tx.Exec(ctx,
+			`UPDATE folders SET path = $1 || substr(path, length($2) + 1) WHERE path LIKE $3 AND id <> $4`,
+			folder.Path, oldPath, likeEscaper.Replace(oldPath)+"%", folder.ID,
+		)
+		if err != nil {
+			log.Printf("RepLayer: ошибка переноса вложенных папок %s: %v\n", folder.ID, err)
+			return buisnesModel.Folder{}, err
+		}
+		log.Printf("RepLayer: Перенесено вложенных папок: %d\n", moved.RowsAffected())
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return buisnesModel.Folder{}, err
+	}
+
+	log.Printf("RepLayer: Папка %s изменена\n", folder.ID)
+	return updated, nil
+}
+
+// DeleteFolder - удаление папки. Без recursive непустая папка не удаляется (model.ErrConflict);
+// с recursive удаляются все вложенные папки, а документы перемещаются в корзину. Документы,
+// уже лежащие в корзине, переносятся в корень владельца и при восстановлении попадут туда
+func (r *Repository) DeleteFolder(ctx context.Context, folder buisnesModel.Folder, recursive bool) (buisnesModel.FolderDeletion, error) {
+	log.Printf("RepLayer: Начало удаления папки %s (рекурсивно: %t)\n", folder.ID, recursive)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return buisnesModel.FolderDeletion{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockFolderOwner(ctx, tx, folder.UserID); err != nil {
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	// Путь читается заново: папку могли перенести после проверки прав
+	var path string
+	if err := tx.QueryRow(ctx, `SELECT path FROM folders WHERE id = $1`, folder.ID).Scan(&path); err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.FolderDeletion{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.FolderDeletion{}, err
+	}
+	rows, err := tx.Query(ctx, `SELECT id FROM folders WHERE path LIKE $1 ORDER BY LENGTH(path) DESC, id`, likeEscaper.Replace(path)+"%")
+	if err != nil {
+		return buisnesModel.FolderDeletion{}, err
+	}
+	folderIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	rows, err = tx.Query(ctx, `SELECT id FROM documents WHERE folder_id = ANY($1) AND deleted_at IS NULL ORDER BY id`, folderIDs)
+	if err != nil {
+		return buisnesModel.FolderDeletion{}, err
+	}
+	documentIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	if !recursive && (len(folderIDs) > 1 || len(documentIDs) > 0) {
+		log.Printf("RepLayer: папка %s не пуста: папок %d, документов %d\n", folder.ID, len(folderIDs)-1, len(documentIDs))
+		return buisnesModel.FolderDeletion{}, buisnesModel.ErrConflict
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE documents SET deleted_at = COALESCE(deleted_at, $2), folder_id = NULL
+		WHERE folder_id = ANY($1)`, folderIDs, time.Now().UTC()); err != nil {
+		log.Printf("RepLayer: ошибка перемещения документов папки %s в корзину: %v\n", folder.ID, err)
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	// Вложенные папки удаляются каскадом по parent_id
+	if _, err := tx.Exec(ctx, `DELETE FROM folders WHERE id = $1`, folder.ID); err != nil {
+		log.Printf("RepLayer: ошибка удаления папки %s: %v\n", folder.ID, err)
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return buisnesModel.FolderDeletion{}, err
+	}
+
+	result := buisnesModel.FolderDeletion{
+		DeletedFolders:   folderIDs,
+		DeletedDocuments: documentIDs,
+	}
+	log.Printf("RepLayer: Папка %s удалена: папок %d, документов в корзину %d\n",
+		folder.ID, len(result.DeletedFolders), len(result.DeletedDocuments))
+	return result, nil
+}
+
+// lockFolderOwner - блокировка владельца папок до конца транзакции
+func lockFolderOwner(ctx context.Context, tx pgx.Tx, userID string) error {
+	var locked string
+	if err := tx.QueryRow(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&locked); err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// isUniqueViolation - ошибка нарушения уникального индекса
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// scanFolder - чтение строки folders в модель
+func scanFolder(row scanner) (buisnesModel.Folder, error) {
+	var folder buisnesModel.Folder
+	err := row.Scan(
+		&folder.ID,
+		&folder.UserID,
+		&folder.ParentID,
+		&folder.Name,
+		&folder.Path,
+		&folder.IsPublic,
+		&folder.Grants,
+		&folder.CreatedAt,
+		&folder.UpdatedAt,
+	)
+	return folder, err
+}
@@ -2,33 +2,22 @@ package doc
 
 import (
 	"context"
+	"log"
 
 	"github.com/Masterminds/squirrel"
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 	"github.com/jackc/pgx/v5"
 )
 
-// GetDocument - получение документа по ID
+// GetDocument - получение документа по ID. Документ в корзине не находится (model.ErrNotFound)
 func (r *Repository) GetDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
-	query, args, err := r.sb.Select("*").From("documents").Where(squirrel.Eq{"id": id}).ToSql()
+	query, args, err := r.sb.Select(documentColumns...).From("documents").Where(squirrel.Eq{"id": id, "deleted_at": nil}).ToSql()
 	if err != nil {
 		return buisnesModel.Document{}, err
 	}
 
-	var doc buisnesModel.Document
-	if err := r.pool.QueryRow(ctx, query, args...).Scan(
-		&doc.ID,
-		&doc.UserID,
-		&doc.Name,
-		&doc.MimeType,
-		&doc.FilePath,
-		&doc.IsFile,
-		&doc.IsPublic,
-		&doc.JSONData,
-		&doc.Grants,
-		&doc.CreatedAt,
-		&doc.UpdatedAt,
-	); err != nil {
+	doc, err := scanDocument(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
 		if err == pgx.ErrNoRows {
 			return buisnesModel.Document{}, buisnesModel.ErrNotFound
 		}
@@ -37,3 +26,32 @@ func (r *Repository) GetDocument(ctx context.Context, id string) (buisnesModel.D
 
 	return doc, nil
 }
+
+// DocumentNameExists - в папке владельца (folderID пустой - корень) уже есть документ с таким именем
+// без учета регистра; excludeID - сам изменяемый документ. Документы в корзине имя не занимают
+func (r *Repository) DocumentNameExists(ctx context.Context, userID, folderID, name, excludeID string) (bool, error) {
+	where := squirrel.And{
+		squirrel.Eq{"user_id": userID, "deleted_at": nil},
+		squirrel.Expr("LOWER(name) = LOWER(?)", name),
+	}
+	if folderID == "" {
+		where = append(where, squirrel.Eq{"folder_id": nil})
+	} else {
+		where = append(where, squirrel.Eq{"folder_id": folderID})
+	}
+	if excludeID != "" {
+		where = append(where, squirrel.NotEq{"id": excludeID})
+	}
+
+	query, args, err := r.sb.Select("1").From("documents").Where(where).Limit(1).Prefix("SELECT EXISTS (").Suffix(")").ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var exists bool
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&exists); err != nil {
+		log.Printf("Repository: Ошибка проверки имени документа %s: %v", name, err)
+		return false, err
+	}
+	return exists, nil
+}
@@ -0,0 +1,61 @@
+package doc
+
+import (
+	"context"
+
+	"github.com/Masterminds/squirrel"
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetDocumentVersion - получение конкретной версии документа
+func (r *Repository) GetDocumentVersion(ctx context.Context, documentID string, version int) (buisnesModel.DocumentVersion, error) {
+	query, args, err := r.sb.Select(versionColumns...).
+		From("document_versions v").
+		LeftJoin("users u ON u.id = v.author_id").
+		Where(squirrel.Eq{"v.document_id": documentID, "v.version": version}).
+		ToSql()
+	if err != nil {
+		return buisnesModel.DocumentVersion{}, err
+	}
+
+	v, err := scanVersion(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.DocumentVersion{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.DocumentVersion{}, err
+	}
+
+	return v, nil
+}
+
+// GetDocumentVersions - история версий документа, новые первыми
+func (r *Repository) GetDocumentVersions(ctx context.Context, documentID string) ([]buisnesModel.DocumentVersion, error) {
+	query, args, err := r.sb.Select(versionColumns...).
+		From("document_versions v").
+		LeftJoin("users u ON u.id = v.author_id").
+		Where(squirrel.Eq{"v.document_id": documentID}).
+		OrderBy("v.version DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var versions []buisnesModel.DocumentVersion
+	for rows.Next() {
+		v, err := scanVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+
+	return versions, rows.Err()
+}
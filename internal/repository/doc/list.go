@@ -0,0 +1,118 @@
+package doc
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// sortColumns - выражения SQL для полей сортировки списка документов
+var sortColumns = map[buisnesModel.DocumentSortField]string{
+	buisnesModel.DocumentSortName:    "name",
+	buisnesModel.DocumentSortCreated: "created_at",
+	buisnesModel.DocumentSortSize:    "size_bytes",
+	buisnesModel.DocumentSortMime:    "COALESCE(mime_type, '')",
+}
+
+// ListDocuments - страница видимых запрашивающему документов в порядке query.Sort, начиная после query.After.
+// Общее количество считается только при query.WithTotal (иначе 0)
+func (r *Repository) ListDocuments(ctx context.Context, query buisnesModel.DocumentQuery) ([]buisnesModel.Document, int, error) {
+	where := documentConditions(query)
+
+	var total int
+	if query.WithTotal {
+		countQuery, countArgs, err := r.sb.Select("COUNT(*)").From("documents").Where(where).ToSql()
+		if err != nil {
+			log.Printf("Repository: Ошибка создания SQL запроса количества документов: %v", err)
+			return nil, 0, err
+		}
+		if err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+			log.Printf("Repository: Ошибка подсчета документов: %v", err)
+			return nil, 0, err
+		}
+	}
+
+	keys, args := sortKey(query.Sort, query.After)
+	direction := "ASC"
+	comparison := ">"
+	if query.Sort.Desc {
+		direction = "DESC"
+		comparison = "<"
+	}
+	if query.After != nil {
+		where = append(where, squirrel.Expr("("+strings.Join(keys, ", ")+") "+comparison+" ("+placeholders(len(keys))+")", args...))
+	}
+	orderBy := make([]string, 0, len(keys))
+	for _, key := range keys {
+		orderBy = append(orderBy, key+" "+direction)
+	}
+
+	listQuery, listArgs, err := r.sb.Select(documentColumns...).
+		From("documents").
+		Where(where).
+		OrderBy(orderBy...).
+		Limit(uint64(query.Limit)).
+		ToSql()
+	if err != nil {
+		log.Printf("Repository: Ошибка создания SQL запроса: %v", err)
+		return nil, 0, err
+	}
+
+	rows, err := r.pool.Query(ctx, listQuery, listArgs...)
+	if err != nil {
+		log.Printf("Repository: Ошибка выполнения SQL запроса: %v", err)
+		return nil, 0, err
+	}
+	docs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Document, error) {
+		return scanDocument(row)
+	})
+	if err != nil {
+		log.Printf("Repository: Ошибка сканирования документов: %v", err)
+		return nil, 0, err
+	}
+
+	log.Printf("Repository: Найдено %d документов (сортировка %s)", len(docs), query.Sort)
+	return docs, total, nil
+}
+
+// sortKey - колонки ключа сортировки (поле, created_at, id) и значения курсора для них
+func sortKey(sort buisnesModel.DocumentSort, after *buisnesModel.DocumentCursor) ([]string, []any) {
+	column, ok := sortColumns[sort.Field]
+	if !ok {
+		column = sortColumns[buisnesModel.DocumentSortName]
+	}
+	if column == "created_at" {
+		column = ""
+	}
+
+	var keys []string
+	var args []any
+	if column != "" {
+		keys = append(keys, column)
+		if after != nil {
+			switch sort.Field {
+			case buisnesModel.DocumentSortSize:
+				args = append(args, after.Size)
+			case buisnesModel.DocumentSortMime:
+				args = append(args, after.MimeType)
+			default:
+				args = append(args, after.Name)
+			}
+		}
+	}
+	keys = append(keys, "created_at", "id")
+	if after != nil {
+		args = append(args, after.CreatedAt, after.ID)
+	}
+	return keys, args
+}
+
+// placeholders - список из n плейсхолдеров squirrel
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,122 @@
+package doc
+
+import (
+	"context"
+	"log"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// actualBlobRefs - фактическое количество ссылок версий на каждое содержимое
+const actualBlobRefs = `SELECT digest, MIN(file_path) AS storage_key, MAX(size_bytes) AS size_bytes, COUNT(*) AS cnt
+	FROM document_versions WHERE digest IS NOT NULL GROUP BY digest`
+
+// GetStoredFiles - все ссылки версий документов на объекты хранилища
+func (r *Repository) GetStoredFiles(ctx context.Context) ([]buisnesModel.StoredFile, error) {
+	query, args, err := r.sb.Select("v.document_id", "v.version", "v.file_path", "v.version = d.current_version").
+		From("document_versions v").
+		Join("documents d ON d.id = v.document_id").
+		Where("v.file_path IS NOT NULL AND v.file_path <> ''").
+		OrderBy("v.document_id", "v.version").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка получения ссылок на файлы: %v\n", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var files []buisnesModel.StoredFile
+	for rows.Next() {
+		var f buisnesModel.StoredFile
+		if err := rows.Scan(&f.DocumentID, &f.Version, &f.Key, &f.Current); err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, rows.Err()
+}
+
+// DeleteStorageObject - удаление объекта хранилища через remove, если на него не ссылается ни одна версия
+// или запись blobs. Проверка и удаление выполняются под блокировкой ключа (см. lockStorageKey).
+// Возвращает false, если объект снова используется и удален не был
+func (r *Repository) DeleteStorageObject(ctx context.Context, key string, remove buisnesModel.CommitHook) (bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, lockStorageKey, key); err != nil {
+		return false, err
+	}
+
+	var referenced bool
+	if err := tx.QueryRow(ctx, `SELECT
+		EXISTS (SELECT 1 FROM document_versions WHERE file_path = $1) OR
+		EXISTS (SELECT 1 FROM blobs WHERE storage_key = $1)`, key).Scan(&referenced); err != nil {
+		return false, err
+	}
+	if referenced {
+		log.Printf("RepLayer: Объект %s снова используется и не удаляется\n", key)
+		return false, nil
+	}
+
+	if err := remove(ctx); err != nil {
+		return false, err
+	}
+	return true, tx.Commit(ctx)
+}
+
+// ReconcileBlobReferences - сверка счетчиков ссылок blobs с фактическими ссылками версий.
+// Возвращает количество расхождений; при repair счетчики пересчитываются, а записи без ссылок удаляются
+func (r *Repository) ReconcileBlobReferences(ctx context.Context, repair bool) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка не дает создать или удалить ссылку, пока идет пересчет
+	if repair {
+		if _, err := tx.Exec(ctx, "LOCK TABLE blobs IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+			return 0, err
+		}
+	}
+
+	var mismatches int
+	if err := tx.QueryRow(ctx, `WITH actual AS (`+actualBlobRefs+`)
+		SELECT COUNT(*) FROM blobs b FULL JOIN actual a ON a.digest = b.digest
+		WHERE b.digest IS NULL OR a.digest IS NULL OR b.ref_count <> a.cnt`).Scan(&mismatches); err != nil {
+		log.Printf("RepLayer: ошибка сверки ссылок на содержимое: %v\n", err)
+		return 0, err
+	}
+	if !repair || mismatches == 0 {
+		return mismatches, nil
+	}
+
+	if _, err := tx.Exec(ctx, `INSERT INTO blobs (digest, storage_key, size_bytes, ref_count)
+		SELECT digest, storage_key, size_bytes, cnt FROM (`+actualBlobRefs+`) actual
+		ON CONFLICT (digest) DO UPDATE SET ref_count = EXCLUDED.ref_count
+		WHERE blobs.ref_count <> EXCLUDED.ref_count`); err != nil {
+		log.Printf("RepLayer: ошибка пересчета ссылок на содержимое: %v\n", err)
+		return 0, err
+	}
+	// Объекты удаленных записей станут осиротевшими и будут удалены при сверке хранилища
+	if _, err := tx.Exec(ctx, `DELETE FROM blobs
+		WHERE digest NOT IN (SELECT digest FROM document_versions WHERE digest IS NOT NULL)`); err != nil {
+		log.Printf("RepLayer: ошибка удаления записей содержимого без ссылок: %v\n", err)
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, err
+	}
+
+	log.Printf("RepLayer: Исправлено расхождений счетчиков ссылок на содержимое: %d\n", mismatches)
+	return mismatches, nil
+}
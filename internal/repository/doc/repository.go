@@ -3,8 +3,32 @@ package doc
 import (
 	"github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 )
 
+// tagsColumn - теги документа по алфавиту в виде JSON массива
+const tagsColumn = `COALESCE((SELECT jsonb_agg(t.name ORDER BY t.name) FROM document_tags dt
+	JOIN tags t ON t.id = dt.tag_id WHERE dt.document_id = documents.id), '[]'::jsonb)`
+
+// documentColumns - колонки таблицы documents в порядке сканирования в scanDocument
+var documentColumns = []string{
+	"id", "user_id", "name", "mime_type", "file_path", "is_file", "is_public",
+	"json_data", "grants", "current_version", "size_bytes", "COALESCE(digest, '')", "created_at", "updated_at",
+	"COALESCE(folder_id, '')", tagsColumn, "metadata", "deleted_at",
+}
+
+// versionColumns - колонки таблицы document_versions в порядке сканирования в scanVersion
+var versionColumns = []string{
+	"v.id", "v.document_id", "v.version", "v.file_path", "v.mime_type", "v.size_bytes",
+	"COALESCE(v.digest, '')", "v.json_data", "COALESCE(v.author_id, '')", "COALESCE(u.login, '')", "v.created_at",
+}
+
+// scanner - общий интерфейс pgx.Row и pgx.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
 // Repository - репозиторий для работы с документами
 type Repository struct {
 	pool *pgxpool.Pool
@@ -18,3 +42,62 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 		sb:   squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 	}
 }
+
+// scanDocument - чтение строки documents в модель
+func scanDocument(row scanner) (buisnesModel.Document, error) {
+	var doc buisnesModel.Document
+	var filePath, mimeType *string
+	err := row.Scan(
+		&doc.ID,
+		&doc.UserID,
+		&doc.Name,
+		&mimeType,
+		&filePath,
+		&doc.IsFile,
+		&doc.IsPublic,
+		&doc.JSONData,
+		&doc.Grants,
+		&doc.Version,
+		&doc.Size,
+		&doc.Digest,
+		&doc.CreatedAt,
+		&doc.UpdatedAt,
+		&doc.FolderID,
+		&doc.Tags,
+		&doc.Metadata,
+		&doc.DeletedAt,
+	)
+	if filePath != nil {
+		doc.FilePath = *filePath
+	}
+	if mimeType != nil {
+		doc.MimeType = *mimeType
+	}
+	return doc, err
+}
+
+// scanVersion - чтение строки document_versions (с логином автора) в модель
+func scanVersion(row scanner) (buisnesModel.DocumentVersion, error) {
+	var v buisnesModel.DocumentVersion
+	var filePath, mimeType *string
+	err := row.Scan(
+		&v.ID,
+		&v.DocumentID,
+		&v.Version,
+		&filePath,
+		&mimeType,
+		&v.Size,
+		&v.Digest,
+		&v.JSONData,
+		&v.AuthorID,
+		&v.AuthorLogin,
+		&v.CreatedAt,
+	)
+	if filePath != nil {
+		v.FilePath = *filePath
+	}
+	if mimeType != nil {
+		v.MimeType = *mimeType
+	}
+	return v, err
+}
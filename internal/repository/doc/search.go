@@ -0,0 +1,182 @@
+package doc
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// headlineOptions - параметры ts_headline: до трех фрагментов с границами совпадений model.SnippetStart/SnippetStop
+const headlineOptions = `MaxFragments=3, MaxWords=25, MinWords=8, FragmentDelimiter=" … ", ` +
+	`StartSel="` + buisnesModel.SnippetStart + `", StopSel="` + buisnesModel.SnippetStop + `"`
+
+// SearchDocuments - страница видимых запрашивающему документов, подходящих под поисковый запрос,
+// в порядке убывания релевантности, и общее количество найденных
+func (r *Repository) SearchDocuments(ctx context.Context, search buisnesModel.DocumentSearch) ([]buisnesModel.SearchHit, int, error) {
+	where := documentConditions(buisnesModel.DocumentQuery{
+		ViewerID:    search.ViewerID,
+		ViewerLogin: search.ViewerLogin,
+		ViewAll:     search.ViewAll,
+		Filters:     search.Filters,
+	})
+	where = append(where, squirrel.Expr("search_vector @@ websearch_to_tsquery('simple', ?)", search.Query))
+
+	countQuery, countArgs, err := r.sb.Select("COUNT(*)").From("documents").Where(where).ToSql()
+	if err != nil {
+		log.Printf("Repository: Ошибка создания SQL запроса количества найденных документов: %v", err)
+		return nil, 0, err
+	}
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("Repository: Ошибка подсчета найденных документов: %v", err)
+		return nil, 0, err
+	}
+	if total == 0 {
+		return nil, 0, nil
+	}
+
+	// Сниппеты строятся только для документов страницы: ts_headline заново разбирает текст
+	page := squirrel.Select(searchColumns...).
+		Column(squirrel.Alias(squirrel.Expr("ts_rank_cd(search_vector, websearch_to_tsquery('simple', ?))", search.Query), "rank")).
+		From("documents").
+		Where(where).
+		OrderBy("rank DESC", "id").
+		Limit(uint64(search.Limit)).
+		Offset(uint64(search.Offset))
+	query, args, err := r.sb.Select(pageColumns()...).
+		Column("p.rank").
+		Column(squirrel.Expr(
+			"ts_headline('simple', p.name || E'\\n' || COALESCE(p.content_text, ''), websearch_to_tsquery('simple', ?), ?)",
+			search.Query, headlineOptions,
+		)).
+		FromSelect(page, "p").
+		OrderBy("p.rank DESC", "p.id").
+		ToSql()
+	if err != nil {
+		log.Printf("Repository: Ошибка создания SQL запроса поиска: %v", err)
+		return nil, 0, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка поиска документов: %v", err)
+		return nil, 0, err
+	}
+	hits, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.SearchHit, error) {
+		return scanSearchHit(row)
+	})
+	if err != nil {
+		log.Printf("Repository: Ошибка сканирования найденных документов: %v", err)
+		return nil, 0, err
+	}
+
+	log.Printf("Repository: Найдено %d из %d документов по запросу %q", len(hits), total, search.Query)
+	return hits, total, nil
+}
+
+// GetDocumentsToIndex - документы, текущая версия которых еще не проиндексирована для поиска
+func (r *Repository) GetDocumentsToIndex(ctx context.Context, limit int) ([]buisnesModel.Document, error) {
+	query, args, err := r.sb.Select(documentColumns...).
+		From("documents").
+		Where("indexed_version <> current_version").
+		OrderBy("updated_at").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения документов для индексации: %v", err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Document, error) {
+		return scanDocument(row)
+	})
+}
+
+// SetDocumentText - сохранение извлеченного текста версии документа. Если за время извлечения
+// появилась новая версия, текст не сохраняется: документ останется в очереди индексатора
+func (r *Repository) SetDocumentText(ctx context.Context, id string, version int, text string) error {
+	var content *string
+	if text != "" {
+		content = &text
+	}
+
+	query, args, err := r.sb.Update("documents").
+		Set("content_text", content).
+		Set("indexed_version", version).
+		Where(squirrel.Eq{"id": id, "current_version": version}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("Repository: Ошибка сохранения текста документа %s: %v", id, err)
+		return err
+	}
+	return nil
+}
+
+// searchColumns - колонки документа и текста для подзапроса страницы поиска
+var searchColumns = []string{
+	"id", "user_id", "name", "mime_type", "file_path", "is_file", "is_public",
+	"json_data", "grants", "current_version", "size_bytes", "digest", "created_at", "updated_at", "folder_id",
+	tagsColumn + " AS tags", "metadata", "content_text",
+}
+
+// pageColumns - колонки документа из подзапроса страницы поиска в порядке сканирования в scanSearchHit
+func pageColumns() []string {
+	columns := make([]string, 0, len(searchColumns))
+	for _, column := range searchColumns[:len(searchColumns)-1] {
+		switch column {
+		case "digest", "folder_id":
+			columns = append(columns, "COALESCE(p."+column+", '')")
+		case tagsColumn + " AS tags":
+			columns = append(columns, "p.tags")
+		default:
+			columns = append(columns, "p."+column)
+		}
+	}
+	return columns
+}
+
+// scanSearchHit - чтение строки результата поиска: документ, ранг и сниппет
+func scanSearchHit(row scanner) (buisnesModel.SearchHit, error) {
+	var hit buisnesModel.SearchHit
+	var filePath, mimeType *string
+	err := row.Scan(
+		&hit.Document.ID,
+		&hit.Document.UserID,
+		&hit.Document.Name,
+		&mimeType,
+		&filePath,
+		&hit.Document.IsFile,
+		&hit.Document.IsPublic,
+		&hit.Document.JSONData,
+		&hit.Document.Grants,
+		&hit.Document.Version,
+		&hit.Document.Size,
+		&hit.Document.Digest,
+		&hit.Document.CreatedAt,
+		&hit.Document.UpdatedAt,
+		&hit.Document.FolderID,
+		&hit.Document.Tags,
+		&hit.Document.Metadata,
+		&hit.Rank,
+		&hit.Snippet,
+	)
+	if filePath != nil {
+		hit.Document.FilePath = *filePath
+	}
+	if mimeType != nil {
+		hit.Document.MimeType = *mimeType
+	}
+	return hit, err
+}
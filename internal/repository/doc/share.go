@@ -0,0 +1,166 @@
+package doc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// shareLinkColumns - колонки таблицы share_links в порядке сканирования в scanShareLink
+var shareLinkColumns = []string{
+	"id", "document_id", "user_id", "slug", "COALESCE(password_hash, '')", "expires_at", "max_downloads",
+	"download_count", "last_accessed_at", "revoked_at", "created_at",
+}
+
+// CreateShareLink - сохранение новой ссылки. Занятый slug - model.ErrConflict
+func (r *Repository) CreateShareLink(ctx context.Context, link buisnesModel.ShareLink) (buisnesModel.ShareLink, error) {
+	log.Printf("RepLayer: Создание ссылки на документ %s\n", link.DocumentID)
+
+	query, args, err := r.sb.Insert("share_links").
+		Columns("id", "document_id", "user_id", "slug", "password_hash", "expires_at", "max_downloads", "created_at").
+		Values(link.ID, link.DocumentID, link.UserID, link.Slug, nullString(link.PasswordHash), link.ExpiresAt, link.MaxDownloads, link.CreatedAt).
+		Suffix("RETURNING " + strings.Join(shareLinkColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания ссылки: %v\n", err)
+		return buisnesModel.ShareLink{}, err
+	}
+
+	created, err := scanShareLink(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return buisnesModel.ShareLink{}, buisnesModel.ErrConflict
+		}
+		log.Printf("RepLayer: ошибка создания ссылки на документ %s: %v\n", link.DocumentID, err)
+		return buisnesModel.ShareLink{}, err
+	}
+
+	log.Printf("RepLayer: Ссылка %s на документ %s создана\n", created.ID, created.DocumentID)
+	return created, nil
+}
+
+// GetShareLink - получение ссылки по ID
+func (r *Repository) GetShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error) {
+	return r.getShareLink(ctx, squirrel.Eq{"id": id})
+}
+
+// GetShareLinkBySlug - получение ссылки по slug
+func (r *Repository) GetShareLinkBySlug(ctx context.Context, slug string) (buisnesModel.ShareLink, error) {
+	return r.getShareLink(ctx, squirrel.Eq{"slug": slug})
+}
+
+// getShareLink - получение одной ссылки по условию
+func (r *Repository) getShareLink(ctx context.Context, where squirrel.Eq) (buisnesModel.ShareLink, error) {
+	query, args, err := r.sb.Select(shareLinkColumns...).From("share_links").Where(where).ToSql()
+	if err != nil {
+		return buisnesModel.ShareLink{}, err
+	}
+
+	link, err := scanShareLink(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.ShareLink{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.ShareLink{}, err
+	}
+	return link, nil
+}
+
+// ListShareLinks - ссылки на документ, новые первыми (включая отозванные и истекшие)
+func (r *Repository) ListShareLinks(ctx context.Context, documentID string) ([]buisnesModel.ShareLink, error) {
+	query, args, err := r.sb.Select(shareLinkColumns...).
+		From("share_links").
+		Where(squirrel.Eq{"document_id": documentID}).
+		OrderBy("created_at DESC", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения ссылок на документ %s: %v", documentID, err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.ShareLink, error) {
+		return scanShareLink(row)
+	})
+}
+
+// RevokeShareLink - отзыв ссылки; повторный отзыв сохраняет время первого
+func (r *Repository) RevokeShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error) {
+	log.Printf("RepLayer: Отзыв ссылки %s\n", id)
+
+	query, args, err := r.sb.Update("share_links").
+		Set("revoked_at", squirrel.Expr("COALESCE(revoked_at, ?)", time.Now().UTC())).
+		Where(squirrel.Eq{"id": id}).
+		Suffix("RETURNING " + strings.Join(shareLinkColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return buisnesModel.ShareLink{}, err
+	}
+
+	revoked, err := scanShareLink(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.ShareLink{}, buisnesModel.ErrNotFound
+		}
+		log.Printf("RepLayer: ошибка отзыва ссылки %s: %v\n", id, err)
+		return buisnesModel.ShareLink{}, err
+	}
+	return revoked, nil
+}
+
+// RecordShareDownload - учет скачивания по ссылке. Счетчик увеличивается одним запросом вместе с проверкой
+// срока и лимита, поэтому параллельные скачивания не превысят лимит. Ссылка отозвана, истекла
+// или лимит исчерпан - model.ErrConflict
+func (r *Repository) RecordShareDownload(ctx context.Context, id string, now time.Time) (buisnesModel.ShareLink, error) {
+	query, args, err := r.sb.Update("share_links").
+		Set("download_count", squirrel.Expr("download_count + 1")).
+		Set("last_accessed_at", now).
+		Where(squirrel.And{
+			squirrel.Eq{"id": id, "revoked_at": nil},
+			squirrel.Or{squirrel.Eq{"expires_at": nil}, squirrel.Gt{"expires_at": now}},
+			squirrel.Or{squirrel.Eq{"max_downloads": nil}, squirrel.Expr("download_count < max_downloads")},
+		}).
+		Suffix("RETURNING " + strings.Join(shareLinkColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return buisnesModel.ShareLink{}, err
+	}
+
+	link, err := scanShareLink(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.ShareLink{}, buisnesModel.ErrConflict
+		}
+		log.Printf("RepLayer: ошибка учета скачивания по ссылке %s: %v\n", id, err)
+		return buisnesModel.ShareLink{}, err
+	}
+	return link, nil
+}
+
+// scanShareLink - чтение строки share_links в модель
+func scanShareLink(row scanner) (buisnesModel.ShareLink, error) {
+	var link buisnesModel.ShareLink
+	err := row.Scan(
+		&link.ID,
+		&link.DocumentID,
+		&link.UserID,
+		&link.Slug,
+		&link.PasswordHash,
+		&link.ExpiresAt,
+		&link.MaxDownloads,
+		&link.Downloads,
+		&link.LastAccessedAt,
+		&link.RevokedAt,
+		&link.CreatedAt,
+	)
+	return link, err
+}
@@ -0,0 +1,70 @@
+package doc
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetVersionsToSize - версии файлов из очереди size_backfill, размер которых неизвестен (см. миграцию 026)
+func (r *Repository) GetVersionsToSize(ctx context.Context, limit int) ([]buisnesModel.DocumentVersion, error) {
+	query, args, err := r.sb.Select(versionColumns...).
+		From("size_backfill q").
+		Join("document_versions v ON v.id = q.version_id").
+		LeftJoin("users u ON u.id = v.author_id").
+		OrderBy("q.version_id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: Ошибка получения версий без размера: %v", err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.DocumentVersion, error) {
+		return scanVersion(row)
+	})
+}
+
+// SetVersionSize - сохранение размера версии из хранилища и удаление ее из очереди size_backfill.
+// Размер документа обновляется, только если версия все еще текущая
+func (r *Repository) SetVersionSize(ctx context.Context, versionID string, size int64) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	var documentID string
+	var version int
+	err = tx.QueryRow(ctx, `UPDATE document_versions SET size_bytes = $2 WHERE id = $1
+		RETURNING document_id, version`, versionID, size).Scan(&documentID, &version)
+	if err != nil && err != pgx.ErrNoRows {
+		log.Printf("RepLayer: Ошибка сохранения размера версии %s: %v", versionID, err)
+		return err
+	}
+	if err == nil {
+		if _, err := tx.Exec(ctx, `UPDATE documents SET size_bytes = $3 WHERE id = $1 AND current_version = $2`,
+			documentID, version, size); err != nil {
+			log.Printf("RepLayer: Ошибка сохранения размера документа %s: %v", documentID, err)
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM size_backfill WHERE version_id = $1`, versionID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return err
+	}
+	return nil
+}
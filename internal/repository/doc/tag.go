@@ -0,0 +1,75 @@
+package doc
+
+import (
+	"context"
+	"log"
+
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// AddDocumentTags - добавление тегов документу; недостающие теги создаются в словаре владельца документа.
+// Уже назначенные теги пропускаются
+func (r *Repository) AddDocumentTags(ctx context.Context, doc buisnesModel.Document, tags []string) error {
+	log.Printf("RepLayer: Добавление тегов %v документу %s\n", tags, doc.ID)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `INSERT INTO tags (user_id, name) SELECT $1, unnest($2::text[])
+		ON CONFLICT (user_id, name) DO NOTHING`, doc.UserID, tags); err != nil {
+		log.Printf("RepLayer: ошибка создания тегов: %v\n", err)
+		return err
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO document_tags (document_id, tag_id)
+		SELECT $1, id FROM tags WHERE user_id = $2 AND name = ANY($3::text[])
+		ON CONFLICT DO NOTHING`, doc.ID, doc.UserID, tags); err != nil {
+		log.Printf("RepLayer: ошибка назначения тегов документу %s: %v\n", doc.ID, err)
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// RemoveDocumentTag - снятие тега с документа. Тег без документов остается в словаре владельца
+// (удаление гонялось бы с параллельным назначением) и не выводится в ListTags.
+// Тега нет у документа - model.ErrNotFound
+func (r *Repository) RemoveDocumentTag(ctx context.Context, doc buisnesModel.Document, tag string) error {
+	log.Printf("RepLayer: Снятие тега %s с документа %s\n", tag, doc.ID)
+
+	result, err := r.pool.Exec(ctx, `DELETE FROM document_tags dt USING tags t
+		WHERE dt.tag_id = t.id AND dt.document_id = $1 AND t.user_id = $2 AND t.name = $3`, doc.ID, doc.UserID, tag)
+	if err != nil {
+		log.Printf("RepLayer: ошибка снятия тега с документа %s: %v\n", doc.ID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return buisnesModel.ErrNotFound
+	}
+	return nil
+}
+
+// ListTags - теги пользователя с количеством его документов по алфавиту (теги без документов
+// и документы в корзине не учитываются)
+func (r *Repository) ListTags(ctx context.Context, userID string) ([]buisnesModel.Tag, error) {
+	rows, err := r.pool.Query(ctx, `SELECT t.name, COUNT(*) FROM tags t
+		JOIN document_tags dt ON dt.tag_id = t.id
+		JOIN documents d ON d.id = dt.document_id AND d.deleted_at IS NULL
+		WHERE t.user_id = $1
+		GROUP BY t.name
+		ORDER BY t.name`, userID)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения тегов пользователя %s: %v", userID, err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Tag, error) {
+		var tag buisnesModel.Tag
+		err := row.Scan(&tag.Name, &tag.Count)
+		return tag, err
+	})
+}
@@ -0,0 +1,136 @@
+package doc
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+)
+
+// TrashDocument - перемещение документа в корзину. Документ уже в корзине или не найден - model.ErrNotFound
+func (r *Repository) TrashDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	log.Printf("RepLayer: Перемещение документа %s в корзину\n", id)
+
+	query, args, err := r.sb.Update("documents").
+		Set("deleted_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": id, "deleted_at": nil}).
+		Suffix("RETURNING " + strings.Join(documentColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса перемещения документа %s в корзину: %v\n", id, err)
+		return buisnesModel.Document{}, err
+	}
+
+	trashed, err := scanDocument(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.Document{}, buisnesModel.ErrNotFound
+		}
+		log.Printf("RepLayer: ошибка перемещения документа %s в корзину: %v\n", id, err)
+		return buisnesModel.Document{}, err
+	}
+
+	log.Printf("RepLayer: Документ %s перемещен в корзину\n", id)
+	return trashed, nil
+}
+
+// GetTrashedDocument - получение документа из корзины по ID
+func (r *Repository) GetTrashedDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	query, args, err := r.sb.Select(documentColumns...).
+		From("documents").
+		Where(squirrel.And{squirrel.Eq{"id": id}, squirrel.NotEq{"deleted_at": nil}}).
+		ToSql()
+	if err != nil {
+		return buisnesModel.Document{}, err
+	}
+
+	doc, err := scanDocument(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.Document{}, buisnesModel.ErrNotFound
+		}
+		return buisnesModel.Document{}, err
+	}
+	return doc, nil
+}
+
+// ListTrash - документы в корзине пользователя, последние удаленные первыми
+func (r *Repository) ListTrash(ctx context.Context, userID string) ([]buisnesModel.Document, error) {
+	query, args, err := r.sb.Select(documentColumns...).
+		From("documents").
+		Where(squirrel.And{squirrel.Eq{"user_id": userID}, squirrel.NotEq{"deleted_at": nil}}).
+		OrderBy("deleted_at DESC", "id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения корзины пользователя %s: %v", userID, err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Document, error) {
+		return scanDocument(row)
+	})
+}
+
+// GetExpiredTrash - до limit документов, перемещенных в корзину раньше before, в порядке удаления
+func (r *Repository) GetExpiredTrash(ctx context.Context, before time.Time, limit int) ([]buisnesModel.Document, error) {
+	query, args, err := r.sb.Select(documentColumns...).
+		From("documents").
+		Where(squirrel.Lt{"deleted_at": before}).
+		OrderBy("deleted_at", "id").
+		Limit(uint64(limit)).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("Repository: Ошибка получения документов с истекшим сроком хранения в корзине: %v", err)
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (buisnesModel.Document, error) {
+		return scanDocument(row)
+	})
+}
+
+// RestoreDocument - возврат документа из корзины в его папку. Удаление папки переносит документы
+// корзины в корень владельца, поэтому папка документа всегда существует. Документ не в корзине - model.ErrNotFound
+func (r *Repository) RestoreDocument(ctx context.Context, id string) (buisnesModel.Document, error) {
+	log.Printf("RepLayer: Восстановление документа %s из корзины\n", id)
+
+	query, args, err := r.sb.Update("documents").
+		Set("deleted_at", nil).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.And{squirrel.Eq{"id": id}, squirrel.NotEq{"deleted_at": nil}}).
+		Suffix("RETURNING " + strings.Join(documentColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса восстановления документа %s: %v\n", id, err)
+		return buisnesModel.Document{}, err
+	}
+
+	restored, err := scanDocument(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return buisnesModel.Document{}, buisnesModel.ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			// В папке появился документ с тем же именем после проверки в сервисе
+			return buisnesModel.Document{}, buisnesModel.ErrDocumentNameExists
+		}
+		log.Printf("RepLayer: ошибка восстановления документа %s: %v\n", id, err)
+		return buisnesModel.Document{}, err
+	}
+
+	log.Printf("RepLayer: Документ %s восстановлен из корзины\n", id)
+	return restored, nil
+}
@@ -0,0 +1,73 @@
+package doc
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpdateDocument - обновление свойств документа (имя, публичность, grants, папка, пользовательские метаданные).
+// version (если задана) добавляется новой текущей версией в той же транзакции
+func (r *Repository) UpdateDocument(ctx context.Context, doc buisnesModel.Document, version *buisnesModel.DocumentVersion) (buisnesModel.Document, error) {
+	log.Printf("RepLayer: Начало обновления документа %s\n", doc.ID)
+	if doc.Metadata == nil {
+		doc.Metadata = buisnesModel.JSONData{}
+	}
+
+	query, args, err := r.sb.Update("documents").
+		Set("name", doc.Name).
+		Set("is_public", doc.IsPublic).
+		Set("grants", doc.Grants).
+		Set("folder_id", nullString(doc.FolderID)).
+		Set("metadata", doc.Metadata).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": doc.ID, "deleted_at": nil}).
+		Suffix("RETURNING " + strings.Join(documentColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса обновления документа %s: %v\n", doc.ID, err)
+		return buisnesModel.Document{}, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return buisnesModel.Document{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Версия добавляется первой: она блокирует строку документа, а RETURNING ниже вернет уже новую текущую версию
+	if version != nil {
+		if _, _, err := r.addVersion(ctx, tx, *version); err != nil {
+			log.Printf("RepLayer: ошибка создания версии документа %s: %v\n", doc.ID, err)
+			return buisnesModel.Document{}, err
+		}
+	}
+
+	updated, err := scanDocument(tx.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			log.Printf("RepLayer: документ %s не найден для обновления\n", doc.ID)
+			return buisnesModel.Document{}, buisnesModel.ErrNotFound
+		}
+		if isUniqueViolation(err) {
+			return buisnesModel.Document{}, fmt.Errorf("%w: '%s'", buisnesModel.ErrDocumentNameExists, doc.Name)
+		}
+		log.Printf("RepLayer: ошибка обновления документа %s: %v\n", doc.ID, err)
+		return buisnesModel.Document{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return buisnesModel.Document{}, err
+	}
+
+	log.Printf("RepLayer: Документ %s обновлен\n", doc.ID)
+	return updated, nil
+}
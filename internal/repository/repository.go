@@ -2,25 +2,122 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 )
 
 type FileServerRepository interface {
 	// Документы
-	CreateDocument(ctx context.Context, doc buisnesModel.Document) (buisnesModel.Document, error)
+	CreateDocument(ctx context.Context, doc buisnesModel.Document, commit buisnesModel.CommitHook) (buisnesModel.Document, error)
 	GetDocument(ctx context.Context, id string) (buisnesModel.Document, error)
-	GetListDocuments(ctx context.Context, userID string) ([]buisnesModel.Document, error)
-	DeleteDocument(ctx context.Context, id string) error
+	DocumentNameExists(ctx context.Context, userID, folderID, name, excludeID string) (bool, error)
+	ListDocuments(ctx context.Context, query buisnesModel.DocumentQuery) ([]buisnesModel.Document, int, error)
+	SearchDocuments(ctx context.Context, search buisnesModel.DocumentSearch) ([]buisnesModel.SearchHit, int, error)
+	GetDocumentsToIndex(ctx context.Context, limit int) ([]buisnesModel.Document, error)
+	SetDocumentText(ctx context.Context, id string, version int, text string) error
+	DeleteDocument(ctx context.Context, id string) ([]string, error)
+	UpdateDocument(ctx context.Context, doc buisnesModel.Document, version *buisnesModel.DocumentVersion) (buisnesModel.Document, error)
+
+	// Версии документов
+	CreateDocumentVersion(ctx context.Context, version buisnesModel.DocumentVersion, commit buisnesModel.CommitHook) (buisnesModel.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, documentID string, version int) (buisnesModel.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, documentID string) ([]buisnesModel.DocumentVersion, error)
+	GetVersionsToSize(ctx context.Context, limit int) ([]buisnesModel.DocumentVersion, error)
+	SetVersionSize(ctx context.Context, versionID string, size int64) error
+
+	// Сверка хранилища и БД
+	GetStoredFiles(ctx context.Context) ([]buisnesModel.StoredFile, error)
+	DeleteStorageObject(ctx context.Context, key string, remove buisnesModel.CommitHook) (bool, error)
+	ReconcileBlobReferences(ctx context.Context, repair bool) (int, error)
+
+	// Папки
+	CreateFolder(ctx context.Context, folder buisnesModel.Folder) (buisnesModel.Folder, error)
+	GetFolder(ctx context.Context, id string) (buisnesModel.Folder, error)
+	GetFolderPath(ctx context.Context, folder buisnesModel.Folder) ([]buisnesModel.Folder, error)
+	ListFolders(ctx context.Context, userID, parentID string) ([]buisnesModel.Folder, error)
+	GetFolderTreeDepth(ctx context.Context, folder buisnesModel.Folder) (int, error)
+	UpdateFolder(ctx context.Context, folder buisnesModel.Folder, oldPath string) (buisnesModel.Folder, error)
+	DeleteFolder(ctx context.Context, folder buisnesModel.Folder, recursive bool) (buisnesModel.FolderDeletion, error)
+
+	// Теги
+	AddDocumentTags(ctx context.Context, doc buisnesModel.Document, tags []string) error
+	RemoveDocumentTag(ctx context.Context, doc buisnesModel.Document, tag string) error
+	ListTags(ctx context.Context, userID string) ([]buisnesModel.Tag, error)
+
+	// Корзина
+	TrashDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	GetTrashedDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	ListTrash(ctx context.Context, userID string) ([]buisnesModel.Document, error)
+	GetExpiredTrash(ctx context.Context, before time.Time, limit int) ([]buisnesModel.Document, error)
+	RestoreDocument(ctx context.Context, id string) (buisnesModel.Document, error)
+	DeleteTrashedDocument(ctx context.Context, id string) ([]string, error)
+
+	// Публичные ссылки
+	CreateShareLink(ctx context.Context, link buisnesModel.ShareLink) (buisnesModel.ShareLink, error)
+	GetShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error)
+	GetShareLinkBySlug(ctx context.Context, slug string) (buisnesModel.ShareLink, error)
+	ListShareLinks(ctx context.Context, documentID string) ([]buisnesModel.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id string) (buisnesModel.ShareLink, error)
+	RecordShareDownload(ctx context.Context, id string, now time.Time) (buisnesModel.ShareLink, error)
 
 	// Пользователи
 	CreateUser(ctx context.Context, user buisnesModel.User) (buisnesModel.User, error)
 	GetUserByLogin(ctx context.Context, login string) (buisnesModel.User, error)
 	GetUserByID(ctx context.Context, userID string) (buisnesModel.User, error)
+	UpdateUserRole(ctx context.Context, userID string, role buisnesModel.Role) error
+	SetUserDisabled(ctx context.Context, userID string, disabled bool) error
+	SetUserPassword(ctx context.Context, userID, passwordHash string, resetRequired bool) error
+	ListUsers(ctx context.Context, filter buisnesModel.UserFilter) ([]buisnesModel.User, int, error)
+	SetPasswordResetRequired(ctx context.Context, userID string, required bool) error
+	ClaimLoginAttempt(ctx context.Context, userID string, throttle buisnesModel.LoginThrottle) (buisnesModel.User, error)
+	RestoreLoginFailures(ctx context.Context, previous, claimed buisnesModel.User) error
+	ResetLoginFailures(ctx context.Context, userID string) error
+	SetTOTPSecret(ctx context.Context, userID, secret string) error
+	SetTOTPEnabled(ctx context.Context, userID string, enabled bool, step int64) error
+	UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error)
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (buisnesModel.User, error)
+	CreateUserIdentity(ctx context.Context, identity buisnesModel.UserIdentity) error
+	TouchUserIdentity(ctx context.Context, issuer, subject, email string) error
+	DeleteUser(ctx context.Context, userID, transferTo string) (buisnesModel.UserDeletion, error)
 
 	// Токены
 	CreateToken(ctx context.Context, token buisnesModel.Token) (buisnesModel.Token, error)
-	GetTokenByValue(ctx context.Context, tokenValue string) (buisnesModel.Token, error)
-	DeactivateToken(ctx context.Context, tokenValue string) error
+	GetTokenByHash(ctx context.Context, tokenHash string) (buisnesModel.Token, error)
+	GetTokenByID(ctx context.Context, id string) (buisnesModel.Token, error)
+	GetTokensByUserID(ctx context.Context, userID string) ([]buisnesModel.Token, error)
+	DeactivateTokenByHash(ctx context.Context, tokenHash string) error
+	DeactivateTokenByID(ctx context.Context, id string) error
 	DeactivateUserTokens(ctx context.Context, userID string) error
+	RevokeToken(ctx context.Context, revoked buisnesModel.RevokedToken) error
+	GetRevokedTokens(ctx context.Context) ([]buisnesModel.RevokedToken, error)
+	DeleteExpiredRevokedTokens(ctx context.Context) (int64, error)
+	CreatePasswordResetToken(ctx context.Context, token buisnesModel.PasswordResetToken) error
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (buisnesModel.PasswordResetToken, error)
+
+	// API-ключи
+	CreateAPIKey(ctx context.Context, key buisnesModel.APIKey) error
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (buisnesModel.APIKey, error)
+	GetAPIKeysByUserID(ctx context.Context, userID string) ([]buisnesModel.APIKey, error)
+	DeleteAPIKey(ctx context.Context, id, userID string) error
+	TouchAPIKey(ctx context.Context, id, ipAddress string) error
+
+	// Двухфакторная аутентификация
+	CreateLoginChallenge(ctx context.Context, challenge buisnesModel.LoginChallenge) error
+	GetLoginChallenge(ctx context.Context, tokenHash string) (buisnesModel.LoginChallenge, error)
+	DeleteLoginChallenge(ctx context.Context, id string) error
+	FailLoginChallenge(ctx context.Context, id string, maxAttempts int) error
+	ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) error
+
+	// Вход через OIDC
+	CreateOIDCState(ctx context.Context, state buisnesModel.OIDCState) error
+	ConsumeOIDCState(ctx context.Context, stateHash string) (buisnesModel.OIDCState, error)
+
+	// Сессии загрузки
+	CreateUploadSession(ctx context.Context, session buisnesModel.UploadSession) (buisnesModel.UploadSession, error)
+	GetUploadSession(ctx context.Context, id string) (buisnesModel.UploadSession, error)
+	GetExpiredUploadSessions(ctx context.Context, before time.Time) ([]buisnesModel.UploadSession, error)
+	AdvanceUploadSession(ctx context.Context, id string, from, to int64, chunkKey string, expiresAt time.Time) (buisnesModel.UploadSession, error)
+	DeleteUploadSession(ctx context.Context, id string) error
 }
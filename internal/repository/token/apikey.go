@@ -0,0 +1,172 @@
+package token
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// apiKeyColumns - столбцы api_keys в порядке scanAPIKey
+var apiKeyColumns = []string{
+	"id", "user_id", "name", "key_hash", "key_prefix", "scopes", "allowed_cidrs",
+	"expires_at", "last_used_at", "COALESCE(last_used_ip, '')", "created_at",
+}
+
+// scanAPIKey - чтение строки, выбранной по apiKeyColumns
+func scanAPIKey(row pgx.Row) (model.APIKey, error) {
+	var (
+		key    model.APIKey
+		scopes []string
+	)
+	err := row.Scan(
+		&key.ID,
+		&key.UserID,
+		&key.Name,
+		&key.KeyHash,
+		&key.KeyPrefix,
+		&scopes,
+		&key.AllowedCIDRs,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.LastUsedIP,
+		&key.CreatedAt,
+	)
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	key.Scopes = make([]model.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, model.Scope(scope))
+	}
+	return key, nil
+}
+
+// CreateAPIKey - сохранение API-ключа
+func (r *Repository) CreateAPIKey(ctx context.Context, key model.APIKey) error {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	cidrs := key.AllowedCIDRs
+	if cidrs == nil {
+		cidrs = []string{}
+	}
+
+	query, args, err := r.sb.Insert("api_keys").
+		Columns("id", "user_id", "name", "key_hash", "key_prefix", "scopes", "allowed_cidrs", "expires_at", "created_at").
+		Values(key.ID, key.UserID, key.Name, key.KeyHash, key.KeyPrefix, scopes, cidrs, key.ExpiresAt, key.CreatedAt).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания API-ключа: %v\n", err)
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка создания API-ключа: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: API-ключ %s создан для пользователя %s\n", key.ID, key.UserID)
+	return nil
+}
+
+// GetAPIKeyByHash - получение API-ключа по SHA-256 его значения
+func (r *Repository) GetAPIKeyByHash(ctx context.Context, keyHash string) (model.APIKey, error) {
+	query, args, err := r.sb.Select(apiKeyColumns...).
+		From("api_keys").
+		Where(squirrel.Eq{"key_hash": keyHash}).
+		ToSql()
+	if err != nil {
+		return model.APIKey{}, err
+	}
+
+	key, err := scanAPIKey(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.APIKey{}, model.ErrNotFound
+		}
+		return model.APIKey{}, err
+	}
+
+	return key, nil
+}
+
+// GetAPIKeysByUserID - все API-ключи пользователя, включая истекшие
+func (r *Repository) GetAPIKeysByUserID(ctx context.Context, userID string) ([]model.APIKey, error) {
+	query, args, err := r.sb.Select(apiKeyColumns...).
+		From("api_keys").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("created_at DESC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var keys []model.APIKey
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// DeleteAPIKey - отзыв API-ключа владельцем; чужой или неизвестный ключ дает ErrNotFound
+func (r *Repository) DeleteAPIKey(ctx context.Context, id, userID string) error {
+	query, args, err := r.sb.Delete("api_keys").
+		Where(squirrel.Eq{"id": id, "user_id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса удаления API-ключа: %v\n", err)
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка удаления API-ключа: %v\n", err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: API-ключ %s отозван\n", id)
+	return nil
+}
+
+// TouchAPIKey - запись времени и адреса последнего использования ключа.
+// Обновление выполняется не чаще раза в минуту, чтобы каждый запрос не писал в БД
+func (r *Repository) TouchAPIKey(ctx context.Context, id, ipAddress string) error {
+	query, args, err := r.sb.Update("api_keys").
+		Set("last_used_at", squirrel.Expr("NOW()")).
+		Set("last_used_ip", squirrel.Expr("NULLIF(?, '')", ipAddress)).
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.Or{
+			squirrel.Eq{"last_used_at": nil},
+			squirrel.Expr("last_used_at < NOW() - INTERVAL '1 minute'"),
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка обновления времени использования API-ключа: %v\n", err)
+		return err
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"log"
 
+	"github.com/Masterminds/squirrel"
+
 	"github.com/NarthurN/FileServerService/internal/model"
 )
 
@@ -12,8 +14,17 @@ func (r *Repository) CreateToken(ctx context.Context, token model.Token) (model.
 	log.Printf("RepLayer: Начало создания токена для пользователя %s\n", token.UserID)
 
 	query, args, err := r.sb.Insert("tokens").
-		Columns("id", "user_id", "token", "expires_at", "created_at", "is_active").
-		Values(token.ID, token.UserID, token.Token, token.ExpiresAt, token.CreatedAt, token.IsActive).
+		Columns("id", "user_id", "token_hash", "expires_at", "created_at", "is_active", "user_agent", "ip_address").
+		Values(
+			token.ID,
+			token.UserID,
+			squirrel.Expr("NULLIF(?, '')", token.TokenHash), // Сессия JWT не хранит токен
+			token.ExpiresAt,
+			token.CreatedAt,
+			token.IsActive,
+			token.UserAgent,
+			token.IPAddress,
+		).
 		ToSql()
 	if err != nil {
 		log.Printf("RepLayer: ошибка подготовки запроса создания токена: %v\n", err)
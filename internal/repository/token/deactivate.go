@@ -7,13 +7,13 @@ import (
 	"github.com/Masterminds/squirrel"
 )
 
-// DeactivateToken - деактивация токена
-func (r *Repository) DeactivateToken(ctx context.Context, tokenValue string) error {
+// DeactivateTokenByHash - деактивация токена по SHA-256 его значения
+func (r *Repository) DeactivateTokenByHash(ctx context.Context, tokenHash string) error {
 	log.Printf("RepLayer: Начало деактивации токена\n")
 
 	query, args, err := r.sb.Update("tokens").
 		Set("is_active", false).
-		Where(squirrel.Eq{"token": tokenValue}).
+		Where(squirrel.Eq{"token_hash": tokenHash}).
 		ToSql()
 	if err != nil {
 		log.Printf("RepLayer: ошибка подготовки запроса деактивации токена: %v\n", err)
@@ -29,6 +29,26 @@ func (r *Repository) DeactivateToken(ctx context.Context, tokenValue string) err
 	return nil
 }
 
+// DeactivateTokenByID - деактивация сессии по идентификатору
+func (r *Repository) DeactivateTokenByID(ctx context.Context, id string) error {
+	query, args, err := r.sb.Update("tokens").
+		Set("is_active", false).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса деактивации сессии: %v\n", err)
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка деактивации сессии: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Сессия %s деактивирована\n", id)
+	return nil
+}
+
 // DeactivateUserTokens - деактивация всех токенов пользователя
 func (r *Repository) DeactivateUserTokens(ctx context.Context, userID string) error {
 	log.Printf("RepLayer: Начало деактивации всех токенов пользователя %s\n", userID)
@@ -59,7 +79,7 @@ func (r *Repository) DeactivateExpiredTokens(ctx context.Context) error {
 		Set("is_active", false).
 		Where(squirrel.And{
 			squirrel.Eq{"is_active": true},
-			squirrel.Lt{"expires_at": "NOW()"},
+			squirrel.Expr("expires_at < NOW()"),
 		}).
 		ToSql()
 	if err != nil {
@@ -8,29 +8,47 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-// GetTokenByValue - получение токена по значению
-func (r *Repository) GetTokenByValue(ctx context.Context, tokenValue string) (model.Token, error) {
-	query, args, err := r.sb.Select("id", "user_id", "token", "expires_at", "created_at", "is_active").
+// GetTokenByHash - получение токена по SHA-256 его значения
+func (r *Repository) GetTokenByHash(ctx context.Context, tokenHash string) (model.Token, error) {
+	query, args, err := r.sb.Select(tokenColumns...).
 		From("tokens").
 		Where(squirrel.And{
-			squirrel.Eq{"token": tokenValue},
+			squirrel.Eq{"token_hash": tokenHash},
 			squirrel.Eq{"is_active": true},
-			squirrel.Gt{"expires_at": "NOW()"},
+			squirrel.Expr("expires_at > NOW()"),
 		}).
 		ToSql()
 	if err != nil {
 		return model.Token{}, err
 	}
 
-	var token model.Token
-	if err := r.pool.QueryRow(ctx, query, args...).Scan(
-		&token.ID,
-		&token.UserID,
-		&token.Token,
-		&token.ExpiresAt,
-		&token.CreatedAt,
-		&token.IsActive,
-	); err != nil {
+	token, err := scanToken(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.Token{}, model.ErrNotFound
+		}
+		return model.Token{}, err
+	}
+
+	return token, nil
+}
+
+// GetTokenByID - получение активной сессии по идентификатору (для JWT это jti)
+func (r *Repository) GetTokenByID(ctx context.Context, id string) (model.Token, error) {
+	query, args, err := r.sb.Select(tokenColumns...).
+		From("tokens").
+		Where(squirrel.And{
+			squirrel.Eq{"id": id},
+			squirrel.Eq{"is_active": true},
+			squirrel.Expr("expires_at > NOW()"),
+		}).
+		ToSql()
+	if err != nil {
+		return model.Token{}, err
+	}
+
+	token, err := scanToken(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
 		if err == pgx.ErrNoRows {
 			return model.Token{}, model.ErrNotFound
 		}
@@ -42,12 +60,12 @@ func (r *Repository) GetTokenByValue(ctx context.Context, tokenValue string) (mo
 
 // GetTokensByUserID - получение всех активных токенов пользователя
 func (r *Repository) GetTokensByUserID(ctx context.Context, userID string) ([]model.Token, error) {
-	query, args, err := r.sb.Select("id", "user_id", "token", "expires_at", "created_at", "is_active").
+	query, args, err := r.sb.Select(tokenColumns...).
 		From("tokens").
 		Where(squirrel.And{
 			squirrel.Eq{"user_id": userID},
 			squirrel.Eq{"is_active": true},
-			squirrel.Gt{"expires_at": "NOW()"},
+			squirrel.Expr("expires_at > NOW()"),
 		}).
 		OrderBy("created_at DESC").
 		ToSql()
@@ -63,19 +81,12 @@ func (r *Repository) GetTokensByUserID(ctx context.Context, userID string) ([]mo
 
 	var tokens []model.Token
 	for rows.Next() {
-		var token model.Token
-		if err := rows.Scan(
-			&token.ID,
-			&token.UserID,
-			&token.Token,
-			&token.ExpiresAt,
-			&token.CreatedAt,
-			&token.IsActive,
-		); err != nil {
+		token, err := scanToken(rows)
+		if err != nil {
 			return nil, err
 		}
 		tokens = append(tokens, token)
 	}
 
-	return tokens, nil
+	return tokens, rows.Err()
 }
@@ -0,0 +1,71 @@
+package token
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateOIDCState - сохранение параметров запроса авторизации OIDC; заодно удаляются истекшие
+func (r *Repository) CreateOIDCState(ctx context.Context, state model.OIDCState) error {
+	cleanup, cleanupArgs, err := r.sb.Delete("oidc_states").
+		Where(squirrel.Expr("expires_at <= NOW()")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, cleanup, cleanupArgs...); err != nil {
+		log.Printf("RepLayer: ошибка удаления истекших запросов OIDC: %v\n", err)
+	}
+
+	query, args, err := r.sb.Insert("oidc_states").
+		Columns("id", "state_hash", "nonce", "code_verifier", "expires_at", "created_at").
+		Values(state.ID, state.StateHash, state.Nonce, state.CodeVerifier, state.ExpiresAt, state.CreatedAt).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса сохранения запроса OIDC: %v\n", err)
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка сохранения запроса OIDC: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// ConsumeOIDCState - атомарное погашение действующего запроса авторизации OIDC.
+// Использованный, истекший или неизвестный state дает ErrNotFound
+func (r *Repository) ConsumeOIDCState(ctx context.Context, stateHash string) (model.OIDCState, error) {
+	query, args, err := r.sb.Delete("oidc_states").
+		Where(squirrel.Eq{"state_hash": stateHash}).
+		Where(squirrel.Expr("expires_at > NOW()")).
+		Suffix("RETURNING id, state_hash, nonce, code_verifier, expires_at, created_at").
+		ToSql()
+	if err != nil {
+		return model.OIDCState{}, err
+	}
+
+	var state model.OIDCState
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&state.ID,
+		&state.StateHash,
+		&state.Nonce,
+		&state.CodeVerifier,
+		&state.ExpiresAt,
+		&state.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.OIDCState{}, model.ErrNotFound
+		}
+		log.Printf("RepLayer: ошибка погашения запроса OIDC: %v\n", err)
+		return model.OIDCState{}, err
+	}
+
+	return state, nil
+}
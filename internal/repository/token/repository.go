@@ -2,9 +2,18 @@ package token
 
 import (
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/NarthurN/FileServerService/internal/model"
 )
 
+// tokenColumns - столбцы tokens в порядке scanToken; token_hash пуст у сессий JWT
+var tokenColumns = []string{
+	"id", "user_id", "COALESCE(token_hash, '')", "expires_at", "created_at", "is_active",
+	"COALESCE(user_agent, '')", "COALESCE(ip_address, '')",
+}
+
 // Repository - репозиторий для работы с токенами
 type Repository struct {
 	pool *pgxpool.Pool
@@ -18,3 +27,19 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 		sb:   squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 	}
 }
+
+// scanToken - чтение строки, выбранной по tokenColumns
+func scanToken(row pgx.Row) (model.Token, error) {
+	var token model.Token
+	err := row.Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&token.IsActive,
+		&token.UserAgent,
+		&token.IPAddress,
+	)
+	return token, err
+}
@@ -0,0 +1,124 @@
+package token
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreatePasswordResetToken - сохранение токена сброса пароля.
+// Прежние неиспользованные токены пользователя и все истекшие токены удаляются
+func (r *Repository) CreatePasswordResetToken(ctx context.Context, token model.PasswordResetToken) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	cleanup, cleanupArgs, err := r.sb.Delete("password_reset_tokens").
+		Where(squirrel.Or{
+			squirrel.Eq{"user_id": token.UserID},
+			squirrel.Expr("expires_at <= NOW()"),
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, cleanup, cleanupArgs...); err != nil {
+		log.Printf("RepLayer: ошибка удаления старых токенов сброса пароля: %v\n", err)
+		return err
+	}
+
+	query, args, err := r.sb.Insert("password_reset_tokens").
+		Columns("id", "user_id", "token_hash", "created_by", "expires_at", "created_at").
+		Values(
+			token.ID,
+			token.UserID,
+			token.TokenHash,
+			squirrel.Expr("NULLIF(?, '')", token.CreatedBy),
+			token.ExpiresAt,
+			token.CreatedAt,
+		).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания токена сброса пароля: %v\n", err)
+		return err
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка создания токена сброса пароля: %v\n", err)
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Токен сброса пароля создан для пользователя %s\n", token.UserID)
+	return nil
+}
+
+// ConsumePasswordResetToken - атомарное погашение действующего токена сброса пароля; остальные
+// неиспользованные токены пользователя удаляются в той же транзакции.
+// Использованный, истекший или неизвестный токен дает ErrNotFound
+func (r *Repository) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (model.PasswordResetToken, error) {
+	query, args, err := r.sb.Update("password_reset_tokens").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"token_hash": tokenHash, "used_at": nil}).
+		Where(squirrel.Expr("expires_at > NOW()")).
+		Suffix("RETURNING id, user_id, token_hash, COALESCE(created_by, ''), expires_at, used_at, created_at").
+		ToSql()
+	if err != nil {
+		return model.PasswordResetToken{}, err
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return model.PasswordResetToken{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var token model.PasswordResetToken
+	err = tx.QueryRow(ctx, query, args...).Scan(
+		&token.ID,
+		&token.UserID,
+		&token.TokenHash,
+		&token.CreatedBy,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.PasswordResetToken{}, model.ErrNotFound
+		}
+		log.Printf("RepLayer: ошибка погашения токена сброса пароля: %v\n", err)
+		return model.PasswordResetToken{}, err
+	}
+
+	// Пароль сменится по этому токену, остальные выпущенные токены больше не должны работать
+	cleanup, cleanupArgs, err := r.sb.Delete("password_reset_tokens").
+		Where(squirrel.Eq{"user_id": token.UserID, "used_at": nil}).
+		ToSql()
+	if err != nil {
+		return model.PasswordResetToken{}, err
+	}
+	if _, err := tx.Exec(ctx, cleanup, cleanupArgs...); err != nil {
+		log.Printf("RepLayer: ошибка удаления остальных токенов сброса пароля: %v\n", err)
+		return model.PasswordResetToken{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return model.PasswordResetToken{}, err
+	}
+
+	log.Printf("RepLayer: Токен сброса пароля %s использован\n", token.ID)
+	return token, nil
+}
@@ -0,0 +1,75 @@
+package token
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// RevokeToken - добавление JWT в список отозванных
+func (r *Repository) RevokeToken(ctx context.Context, revoked model.RevokedToken) error {
+	query, args, err := r.sb.Insert("revoked_tokens").
+		Columns("jti", "user_id", "expires_at", "revoked_at").
+		Values(revoked.JTI, revoked.UserID, revoked.ExpiresAt, revoked.RevokedAt).
+		Suffix("ON CONFLICT (jti) DO NOTHING").
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса отзыва токена: %v\n", err)
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка отзыва токена: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Токен %s пользователя %s отозван\n", revoked.JTI, revoked.UserID)
+	return nil
+}
+
+// GetRevokedTokens - отозванные токены, срок действия которых еще не истек
+func (r *Repository) GetRevokedTokens(ctx context.Context) ([]model.RevokedToken, error) {
+	query, args, err := r.sb.Select("jti", "user_id", "expires_at", "revoked_at").
+		From("revoked_tokens").
+		Where(squirrel.Expr("expires_at > NOW()")).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tokens []model.RevokedToken
+	for rows.Next() {
+		var token model.RevokedToken
+		if err := rows.Scan(&token.JTI, &token.UserID, &token.ExpiresAt, &token.RevokedAt); err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, token)
+	}
+
+	return tokens, rows.Err()
+}
+
+// DeleteExpiredRevokedTokens - удаление записей об отозванных токенах, которые истекли сами
+func (r *Repository) DeleteExpiredRevokedTokens(ctx context.Context) (int64, error) {
+	query, args, err := r.sb.Delete("revoked_tokens").
+		Where(squirrel.Expr("expires_at <= NOW()")).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка удаления истекших отозванных токенов: %v\n", err)
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
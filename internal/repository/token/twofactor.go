@@ -0,0 +1,186 @@
+package token
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateLoginChallenge - сохранение токена второго шага входа; заодно удаляются истекшие токены
+func (r *Repository) CreateLoginChallenge(ctx context.Context, challenge model.LoginChallenge) error {
+	cleanup, cleanupArgs, err := r.sb.Delete("login_challenges").
+		Where(squirrel.Expr("expires_at <= NOW()")).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, cleanup, cleanupArgs...); err != nil {
+		log.Printf("RepLayer: ошибка удаления истекших токенов второго шага: %v\n", err)
+	}
+
+	query, args, err := r.sb.Insert("login_challenges").
+		Columns("id", "user_id", "token_hash", "attempts", "expires_at", "created_at").
+		Values(challenge.ID, challenge.UserID, challenge.TokenHash, 0, challenge.ExpiresAt, challenge.CreatedAt).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания токена второго шага: %v\n", err)
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка создания токена второго шага: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Токен второго шага входа создан для пользователя %s\n", challenge.UserID)
+	return nil
+}
+
+// GetLoginChallenge - действующий токен второго шага по SHA-256 его значения
+func (r *Repository) GetLoginChallenge(ctx context.Context, tokenHash string) (model.LoginChallenge, error) {
+	query, args, err := r.sb.Select("id", "user_id", "token_hash", "attempts", "expires_at", "created_at").
+		From("login_challenges").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		Where(squirrel.Expr("expires_at > NOW()")).
+		ToSql()
+	if err != nil {
+		return model.LoginChallenge{}, err
+	}
+
+	var challenge model.LoginChallenge
+	err = r.pool.QueryRow(ctx, query, args...).Scan(
+		&challenge.ID,
+		&challenge.UserID,
+		&challenge.TokenHash,
+		&challenge.Attempts,
+		&challenge.ExpiresAt,
+		&challenge.CreatedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.LoginChallenge{}, model.ErrNotFound
+		}
+		return model.LoginChallenge{}, err
+	}
+
+	return challenge, nil
+}
+
+// DeleteLoginChallenge - погашение токена второго шага; ErrNotFound - токен уже погашен
+func (r *Repository) DeleteLoginChallenge(ctx context.Context, id string) error {
+	query, args, err := r.sb.Delete("login_challenges").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка удаления токена второго шага %s: %v\n", id, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+	return nil
+}
+
+// FailLoginChallenge - учет неверного кода; после maxAttempts попыток токен второго шага удаляется
+func (r *Repository) FailLoginChallenge(ctx context.Context, id string, maxAttempts int) error {
+	query, args, err := r.sb.Update("login_challenges").
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка учета попытки токена второго шага %s: %v\n", id, err)
+		return err
+	}
+
+	cleanup, cleanupArgs, err := r.sb.Delete("login_challenges").
+		Where(squirrel.Eq{"id": id}).
+		Where(squirrel.GtOrEq{"attempts": maxAttempts}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := r.pool.Exec(ctx, cleanup, cleanupArgs...); err != nil {
+		log.Printf("RepLayer: ошибка удаления исчерпанного токена второго шага %s: %v\n", id, err)
+		return err
+	}
+	return nil
+}
+
+// ReplaceRecoveryCodes - замена кодов восстановления пользователя; пустой список удаляет все коды
+func (r *Repository) ReplaceRecoveryCodes(ctx context.Context, userID string, codeHashes []string) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.Printf("RepLayer: ошибка начала транзакции: %v\n", err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := r.sb.Delete("recovery_codes").
+		Where(squirrel.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка удаления кодов восстановления: %v\n", err)
+		return err
+	}
+
+	if len(codeHashes) > 0 {
+		insert := r.sb.Insert("recovery_codes").Columns("id", "user_id", "code_hash")
+		for _, hash := range codeHashes {
+			insert = insert.Values(uuid.New().String(), userID, hash)
+		}
+		query, args, err := insert.ToSql()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, query, args...); err != nil {
+			log.Printf("RepLayer: ошибка сохранения кодов восстановления: %v\n", err)
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.Printf("RepLayer: ошибка фиксации транзакции: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Коды восстановления пользователя %s заменены (%d)\n", userID, len(codeHashes))
+	return nil
+}
+
+// UseRecoveryCode - атомарное погашение кода восстановления; ErrNotFound - код неизвестен или уже использован
+func (r *Repository) UseRecoveryCode(ctx context.Context, userID, codeHash string) error {
+	query, args, err := r.sb.Update("recovery_codes").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"user_id": userID, "code_hash": codeHash, "used_at": nil}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка погашения кода восстановления: %v\n", err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Код восстановления пользователя %s использован\n", userID)
+	return nil
+}
@@ -0,0 +1,46 @@
+package upload
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// AdvanceUploadSession - фиксация принятого фрагмента: смещение меняется только если оно все еще равно from
+func (r *Repository) AdvanceUploadSession(ctx context.Context, id string, from, to int64, chunkKey string, expiresAt time.Time) (model.UploadSession, error) {
+	log.Printf("RepLayer: Сдвиг смещения загрузки %s: %d -> %d\n", id, from, to)
+
+	query, args, err := r.sb.Update("upload_sessions").
+		Set("upload_offset", to).
+		Set("chunks", squirrel.Expr("chunks || jsonb_build_array(?::text)", chunkKey)).
+		Set("expires_at", expiresAt).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": id, "upload_offset": from}).
+		Suffix("RETURNING " + strings.Join(sessionColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return model.UploadSession{}, err
+	}
+
+	session, err := scanSession(r.pool.QueryRow(ctx, query, args...))
+	if err == nil {
+		return session, nil
+	}
+	if err != pgx.ErrNoRows {
+		log.Printf("RepLayer: ошибка сдвига смещения загрузки %s: %v\n", id, err)
+		return model.UploadSession{}, err
+	}
+
+	// Строка не обновлена: либо сессии нет, либо смещение уже изменил параллельный запрос
+	if _, err := r.GetUploadSession(ctx, id); err != nil {
+		return model.UploadSession{}, err
+	}
+	log.Printf("RepLayer: смещение загрузки %s уже не равно %d\n", id, from)
+	return model.UploadSession{}, model.ErrConflict
+}
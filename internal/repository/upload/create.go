@@ -0,0 +1,39 @@
+package upload
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateUploadSession - создание сессии загрузки
+func (r *Repository) CreateUploadSession(ctx context.Context, session model.UploadSession) (model.UploadSession, error) {
+	log.Printf("RepLayer: Начало создания сессии загрузки %s\n", session.Name)
+
+	if session.Chunks == nil {
+		session.Chunks = model.StringArray{}
+	}
+
+	query, args, err := r.sb.Insert("upload_sessions").
+		Columns("user_id", "name", "mime_type", "is_public", "grants", "size_bytes", "upload_offset", "chunks", "expires_at", "created_at", "updated_at", "folder_id").
+		Values(session.UserID, session.Name, session.MimeType, session.IsPublic, session.Grants, session.Size, session.Offset, session.Chunks, session.ExpiresAt, session.CreatedAt, session.CreatedAt, squirrel.Expr("NULLIF(?, '')", session.FolderID)).
+		Suffix("RETURNING " + strings.Join(sessionColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса создания сессии загрузки: %v\n", err)
+		return model.UploadSession{}, err
+	}
+
+	created, err := scanSession(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		log.Printf("RepLayer: ошибка создания сессии загрузки %s: %v\n", session.Name, err)
+		return model.UploadSession{}, err
+	}
+
+	log.Printf("RepLayer: Сессия загрузки %s создана\n", created.ID)
+	return created, nil
+}
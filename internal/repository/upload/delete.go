@@ -0,0 +1,34 @@
+package upload
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// DeleteUploadSession - удаление сессии загрузки по ID
+func (r *Repository) DeleteUploadSession(ctx context.Context, id string) error {
+	log.Printf("RepLayer: Начало удаления сессии загрузки %s\n", id)
+
+	query, args, err := r.sb.Delete("upload_sessions").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка удаления сессии загрузки %s: %v\n", id, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Сессия загрузки %s удалена\n", id)
+	return nil
+}
@@ -0,0 +1,61 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetUploadSession - получение сессии загрузки по ID
+func (r *Repository) GetUploadSession(ctx context.Context, id string) (model.UploadSession, error) {
+	query, args, err := r.sb.Select(sessionColumns...).
+		From("upload_sessions").
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+	if err != nil {
+		return model.UploadSession{}, err
+	}
+
+	session, err := scanSession(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.UploadSession{}, model.ErrNotFound
+		}
+		return model.UploadSession{}, err
+	}
+
+	return session, nil
+}
+
+// GetExpiredUploadSessions - получение сессий, истекших к моменту before
+func (r *Repository) GetExpiredUploadSessions(ctx context.Context, before time.Time) ([]model.UploadSession, error) {
+	query, args, err := r.sb.Select(sessionColumns...).
+		From("upload_sessions").
+		Where(squirrel.LtOrEq{"expires_at": before}).
+		OrderBy("expires_at").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sessions []model.UploadSession
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, rows.Err()
+}
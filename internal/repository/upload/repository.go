@@ -0,0 +1,54 @@
+package upload
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// sessionColumns - колонки таблицы upload_sessions в порядке сканирования в scanSession
+var sessionColumns = []string{
+	"id", "user_id", "name", "mime_type", "is_public", "grants", "size_bytes",
+	"upload_offset", "chunks", "expires_at", "created_at", "updated_at", "COALESCE(folder_id, '')",
+}
+
+// scanner - общий интерфейс pgx.Row и pgx.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// Repository - репозиторий для работы с сессиями загрузки
+type Repository struct {
+	pool *pgxpool.Pool
+	sb   squirrel.StatementBuilderType
+}
+
+// NewRepository - создание нового репозитория
+func NewRepository(pool *pgxpool.Pool) *Repository {
+	return &Repository{
+		pool: pool,
+		sb:   squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+	}
+}
+
+// scanSession - чтение строки upload_sessions в модель
+func scanSession(row scanner) (model.UploadSession, error) {
+	var session model.UploadSession
+	err := row.Scan(
+		&session.ID,
+		&session.UserID,
+		&session.Name,
+		&session.MimeType,
+		&session.IsPublic,
+		&session.Grants,
+		&session.Size,
+		&session.Offset,
+		&session.Chunks,
+		&session.ExpiresAt,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+		&session.FolderID,
+	)
+	return session, err
+}
@@ -12,8 +12,8 @@ func (r *Repository) CreateUser(ctx context.Context, user model.User) (model.Use
 	log.Printf("RepLayer: Начало создания пользователя %s\n", user.Login)
 
 	query, args, err := r.sb.Insert("users").
-		Columns("id", "login", "password_hash", "created_at", "updated_at").
-		Values(user.ID, user.Login, user.Password, user.CreatedAt, user.UpdatedAt).
+		Columns("id", "login", "password_hash", "role", "created_at", "updated_at").
+		Values(user.ID, user.Login, user.Password, user.Role, user.CreatedAt, user.UpdatedAt).
 		ToSql()
 	if err != nil {
 		log.Printf("RepLayer: ошибка подготовки запроса создания пользователя %s: %v\n", user.Login, err)
@@ -12,7 +12,7 @@ import (
 
 // GetUserByLogin - получение пользователя по логину
 func (r *Repository) GetUserByLogin(ctx context.Context, login string) (model.User, error) {
-	query, args, err := r.sb.Select("id", "login", "password_hash", "created_at", "updated_at").
+	query, args, err := r.sb.Select(userColumns...).
 		From("users").
 		Where(squirrel.Eq{"login": login}).
 		ToSql()
@@ -20,14 +20,8 @@ func (r *Repository) GetUserByLogin(ctx context.Context, login string) (model.Us
 		return model.User{}, err
 	}
 
-	var user model.User
-	if err := r.pool.QueryRow(ctx, query, args...).Scan(
-		&user.ID,
-		&user.Login,
-		&user.Password,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	); err != nil {
+	user, err := scanUser(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
 		if err == pgx.ErrNoRows {
 			return model.User{}, buisnesModel.ErrNotFound
 		}
@@ -41,7 +35,7 @@ func (r *Repository) GetUserByLogin(ctx context.Context, login string) (model.Us
 func (r *Repository) GetUserByID(ctx context.Context, userID string) (model.User, error) {
 	log.Printf("Repository: Получение пользователя по ID %s", userID)
 
-	query, args, err := r.sb.Select("id", "login", "password_hash", "created_at", "updated_at").
+	query, args, err := r.sb.Select(userColumns...).
 		From("users").
 		Where(squirrel.Eq{"id": userID}).
 		ToSql()
@@ -50,14 +44,8 @@ func (r *Repository) GetUserByID(ctx context.Context, userID string) (model.User
 		return model.User{}, err
 	}
 
-	var user model.User
-	if err := r.pool.QueryRow(ctx, query, args...).Scan(
-		&user.ID,
-		&user.Login,
-		&user.Password,
-		&user.CreatedAt,
-		&user.UpdatedAt,
-	); err != nil {
+	user, err := scanUser(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
 		if err == pgx.ErrNoRows {
 			log.Printf("Repository: Пользователь %s не найден", userID)
 			return model.User{}, buisnesModel.ErrNotFound
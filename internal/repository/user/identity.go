@@ -0,0 +1,70 @@
+package user
+
+import (
+	"context"
+	"log"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetUserByIdentity - пользователь, к которому привязана учетная запись провайдера OIDC
+func (r *Repository) GetUserByIdentity(ctx context.Context, issuer, subject string) (model.User, error) {
+	query, args, err := r.sb.Select(userColumns...).
+		From("users").
+		Where(squirrel.Expr("id = (SELECT user_id FROM user_identities WHERE issuer = ? AND subject = ?)", issuer, subject)).
+		ToSql()
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user, err := scanUser(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return model.User{}, model.ErrNotFound
+		}
+		return model.User{}, err
+	}
+
+	return user, nil
+}
+
+// CreateUserIdentity - привязка учетной записи провайдера OIDC к пользователю
+func (r *Repository) CreateUserIdentity(ctx context.Context, identity model.UserIdentity) error {
+	query, args, err := r.sb.Insert("user_identities").
+		Columns("id", "user_id", "issuer", "subject", "email", "created_at", "last_login_at").
+		Values(identity.ID, identity.UserID, identity.Issuer, identity.Subject, identity.Email, identity.CreatedAt, identity.CreatedAt).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса привязки учетной записи OIDC: %v\n", err)
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка привязки учетной записи OIDC: %v\n", err)
+		return err
+	}
+
+	log.Printf("RepLayer: Учетная запись %s издателя %s привязана к пользователю %s\n", identity.Subject, identity.Issuer, identity.UserID)
+	return nil
+}
+
+// TouchUserIdentity - отметка входа через привязанную учетную запись OIDC
+func (r *Repository) TouchUserIdentity(ctx context.Context, issuer, subject, email string) error {
+	query, args, err := r.sb.Update("user_identities").
+		Set("email", email).
+		Set("last_login_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"issuer": issuer, "subject": subject}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка отметки входа через OIDC: %v\n", err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// likeEscaper - экранирование спецсимволов LIKE в поисковой строке
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// ListUsers - страница пользователей по фильтру и общее количество подходящих
+func (r *Repository) ListUsers(ctx context.Context, filter model.UserFilter) ([]model.User, int, error) {
+	where := squirrel.And{}
+	if filter.Query != "" {
+		where = append(where, squirrel.ILike{"login": "%" + likeEscaper.Replace(filter.Query) + "%"})
+	}
+	if filter.Role != "" {
+		where = append(where, squirrel.Eq{"role": filter.Role})
+	}
+	if filter.Disabled != nil {
+		where = append(where, squirrel.Eq{"disabled": *filter.Disabled})
+	}
+
+	countQuery, countArgs, err := r.sb.Select("COUNT(*)").From("users").Where(where).ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса количества пользователей: %v\n", err)
+		return nil, 0, err
+	}
+	var total int
+	if err := r.pool.QueryRow(ctx, countQuery, countArgs...).Scan(&total); err != nil {
+		log.Printf("RepLayer: ошибка подсчета пользователей: %v\n", err)
+		return nil, 0, err
+	}
+
+	query, args, err := r.sb.Select(userColumns...).
+		From("users").
+		Where(where).
+		OrderBy("login").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса списка пользователей: %v\n", err)
+		return nil, 0, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка получения списка пользователей: %v\n", err)
+		return nil, 0, err
+	}
+	users, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.User, error) {
+		return scanUser(row)
+	})
+	if err != nil {
+		log.Printf("RepLayer: ошибка сканирования пользователей: %v\n", err)
+		return nil, 0, err
+	}
+
+	log.Printf("RepLayer: Найдено %d из %d пользователей\n", len(users), total)
+	return users, total, nil
+}
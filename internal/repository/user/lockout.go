@@ -0,0 +1,132 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// ClaimLoginAttempt - атомарный учет попытки входа до проверки пароля или кода: попытка считается неудачной,
+// пока успешная проверка не сбросит счетчик (ResetLoginFailures) или не вернет прежнее состояние
+// (RestoreLoginFailures). Проверка блокировки и паузы и увеличение счетчика выполняются одним запросом,
+// поэтому параллельные попытки, в том числе на разных экземплярах, не обходят ни паузу, ни блокировку.
+// После MaxFailures попыток подряд учетная запись блокируется на Lockout; после истечения блокировки
+// счет начинается заново. Учетная запись заблокирована или пауза не истекла - model.ErrConflict
+func (r *Repository) ClaimLoginAttempt(ctx context.Context, userID string, throttle model.LoginThrottle) (model.User, error) {
+	// Счетчик, с которого продолжается учет: истекшая блокировка его обнуляет
+	failures := "CASE WHEN locked_until IS NOT NULL AND locked_until <= NOW() THEN 1 ELSE failed_logins + 1 END"
+
+	maxFailures := throttle.MaxFailures
+	if maxFailures <= 0 {
+		// Без порога блокировки счетчик все равно ведется: от него зависит пауза между попытками
+		maxFailures = math.MaxInt32
+	}
+
+	where := squirrel.And{
+		squirrel.Eq{"id": userID},
+		squirrel.Expr("(locked_until IS NULL OR locked_until <= NOW())"),
+	}
+	if throttle.BackoffBase > 0 {
+		backoffMax := throttle.BackoffMax
+		if backoffMax <= 0 {
+			backoffMax = time.Duration(math.MaxInt32) * time.Second
+		}
+		// Та же пауза, что и loginBackoff сервиса: степень ограничена, чтобы интервал не переполнялся
+		where = append(where, squirrel.Expr(
+			"(failed_logins = 0 OR last_failed_login_at IS NULL OR "+
+				"last_failed_login_at + make_interval(secs => LEAST(? * power(2, LEAST(failed_logins - 1, 30)), ?)) <= NOW())",
+			throttle.BackoffBase.Seconds(), backoffMax.Seconds(),
+		))
+	}
+
+	query, args, err := r.sb.Update("users").
+		Set("failed_logins", squirrel.Expr(failures)).
+		Set("last_failed_login_at", squirrel.Expr("NOW()")).
+		Set("locked_until", squirrel.Expr(
+			fmt.Sprintf("CASE WHEN %s >= ? THEN NOW() + make_interval(secs => ?) WHEN locked_until <= NOW() THEN NULL ELSE locked_until END", failures),
+			maxFailures, throttle.Lockout.Seconds(),
+		)).
+		Where(where).
+		Suffix("RETURNING " + strings.Join(userColumns, ", ")).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса учета попытки входа: %v\n", err)
+		return model.User{}, err
+	}
+
+	user, err := scanUser(r.pool.QueryRow(ctx, query, args...))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			// Пользователь удален, заблокирован или пауза после прошлой попытки не истекла
+			return model.User{}, model.ErrConflict
+		}
+		log.Printf("RepLayer: ошибка учета попытки входа пользователя %s: %v\n", userID, err)
+		return model.User{}, err
+	}
+
+	log.Printf("RepLayer: Попытка входа пользователя %s учтена, попыток подряд: %d\n", userID, user.FailedLogins)
+	return user, nil
+}
+
+// RestoreLoginFailures - возврат счетчика неудачных попыток к состоянию previous до попытки claimed.
+// Если после claimed была учтена еще одна попытка, состояние не меняется
+func (r *Repository) RestoreLoginFailures(ctx context.Context, previous, claimed model.User) error {
+	if claimed.LastFailedLoginAt == nil {
+		return nil
+	}
+
+	query, args, err := r.sb.Update("users").
+		Set("failed_logins", previous.FailedLogins).
+		Set("last_failed_login_at", previous.LastFailedLoginAt).
+		Set("locked_until", previous.LockedUntil).
+		Where(squirrel.Eq{
+			"id":                   claimed.ID,
+			"failed_logins":        claimed.FailedLogins,
+			"last_failed_login_at": *claimed.LastFailedLoginAt,
+		}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса восстановления счетчика входов: %v\n", err)
+		return err
+	}
+
+	if _, err := r.pool.Exec(ctx, query, args...); err != nil {
+		log.Printf("RepLayer: ошибка восстановления счетчика входов пользователя %s: %v\n", claimed.ID, err)
+		return err
+	}
+	return nil
+}
+
+// ResetLoginFailures - сброс счетчика неудачных попыток и снятие временной блокировки
+func (r *Repository) ResetLoginFailures(ctx context.Context, userID string) error {
+	query, args, err := r.sb.Update("users").
+		Set("failed_logins", 0).
+		Set("last_failed_login_at", nil).
+		Set("locked_until", nil).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса сброса неудачных входов: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка сброса неудачных входов пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Счетчик неудачных входов пользователя %s сброшен\n", userID)
+	return nil
+}
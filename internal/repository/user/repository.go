@@ -2,9 +2,19 @@ package user
 
 import (
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/NarthurN/FileServerService/internal/model"
 )
 
+// userColumns - столбцы users в порядке scanUser
+var userColumns = []string{
+	"id", "login", "password_hash", "role", "disabled", "password_reset_required",
+	"failed_logins", "last_failed_login_at", "locked_until",
+	"totp_enabled", "COALESCE(totp_secret, '')", "totp_last_step", "created_at", "updated_at",
+}
+
 // Repository - репозиторий для работы с пользователями и токенами
 type Repository struct {
 	pool *pgxpool.Pool
@@ -18,3 +28,25 @@ func NewRepository(pool *pgxpool.Pool) *Repository {
 		sb:   squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
 	}
 }
+
+// scanUser - чтение строки, выбранной по userColumns
+func scanUser(row pgx.Row) (model.User, error) {
+	var user model.User
+	err := row.Scan(
+		&user.ID,
+		&user.Login,
+		&user.Password,
+		&user.Role,
+		&user.Disabled,
+		&user.PasswordResetRequired,
+		&user.FailedLogins,
+		&user.LastFailedLoginAt,
+		&user.LockedUntil,
+		&user.TOTPEnabled,
+		&user.TOTPSecret,
+		&user.TOTPLastStep,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+	return user, err
+}
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// SetTOTPSecret - сохранение ожидающего подтверждения секрета TOTP; 2FA остается выключенной
+func (r *Repository) SetTOTPSecret(ctx context.Context, userID, secret string) error {
+	query, args, err := r.sb.Update("users").
+		Set("totp_secret", secret).
+		Set("totp_enabled", false).
+		Set("totp_last_step", 0).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса сохранения секрета TOTP: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка сохранения секрета TOTP пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Секрет TOTP пользователя %s ожидает подтверждения\n", userID)
+	return nil
+}
+
+// SetTOTPEnabled - включение 2FA с ожидающим секретом (step - интервал подтверждающего кода)
+// или выключение с удалением секрета
+func (r *Repository) SetTOTPEnabled(ctx context.Context, userID string, enabled bool, step int64) error {
+	update := r.sb.Update("users").
+		Set("totp_enabled", enabled).
+		Set("totp_last_step", step).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID})
+	if !enabled {
+		update = update.Set("totp_secret", nil)
+	}
+
+	query, args, err := update.ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса изменения 2FA: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка изменения 2FA пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Пользователь %s totp_enabled=%t\n", userID, enabled)
+	return nil
+}
+
+// UseTOTPStep - атомарная отметка интервала TOTP использованным. false - код этого или более
+// позднего интервала уже принят (повтор перехваченного кода), в том числе другим экземпляром
+func (r *Repository) UseTOTPStep(ctx context.Context, userID string, step int64) (bool, error) {
+	query, args, err := r.sb.Update("users").
+		Set("totp_last_step", step).
+		Where(squirrel.Eq{"id": userID}).
+		Where(squirrel.Lt{"totp_last_step": step}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка отметки интервала TOTP пользователя %s: %v\n", userID, err)
+		return false, err
+	}
+	return result.RowsAffected() > 0, nil
+}
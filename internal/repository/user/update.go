@@ -0,0 +1,111 @@
+package user
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// UpdateUserRole - смена роли пользователя
+func (r *Repository) UpdateUserRole(ctx context.Context, userID string, role model.Role) error {
+	query, args, err := r.sb.Update("users").
+		Set("role", role).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса смены роли пользователя: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка смены роли пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Роль пользователя %s изменена на %s\n", userID, role)
+	return nil
+}
+
+// SetUserDisabled - блокировка или разблокировка учетной записи
+func (r *Repository) SetUserDisabled(ctx context.Context, userID string, disabled bool) error {
+	query, args, err := r.sb.Update("users").
+		Set("disabled", disabled).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса блокировки пользователя: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка блокировки пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Пользователь %s disabled=%t\n", userID, disabled)
+	return nil
+}
+
+// SetUserPassword - замена хеша пароля; resetRequired требует сменить пароль при следующем входе
+func (r *Repository) SetUserPassword(ctx context.Context, userID, passwordHash string, resetRequired bool) error {
+	query, args, err := r.sb.Update("users").
+		Set("password_hash", passwordHash).
+		Set("password_reset_required", resetRequired).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса смены пароля: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка смены пароля пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Пароль пользователя %s изменен\n", userID)
+	return nil
+}
+
+// SetPasswordResetRequired - требование сменить пароль до следующего входа
+func (r *Repository) SetPasswordResetRequired(ctx context.Context, userID string, required bool) error {
+	query, args, err := r.sb.Update("users").
+		Set("password_reset_required", required).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID}).
+		ToSql()
+	if err != nil {
+		log.Printf("RepLayer: ошибка подготовки запроса сброса пароля: %v\n", err)
+		return err
+	}
+
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		log.Printf("RepLayer: ошибка сброса пароля пользователя %s: %v\n", userID, err)
+		return err
+	}
+	if result.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	log.Printf("RepLayer: Пользователь %s password_reset_required=%t\n", userID, required)
+	return nil
+}
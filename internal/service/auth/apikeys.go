@@ -0,0 +1,218 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
+)
+
+const (
+	// apiKeyPrefix - начало значения API-ключа, по которому ValidateToken отличает его от токена сессии
+	apiKeyPrefix = "fsk_"
+	// apiKeyShownPrefix - длина начала ключа, сохраняемого для узнавания в списке
+	apiKeyShownPrefix = len(apiKeyPrefix) + 8
+	// apiKeyNameMaxLength - максимальная длина названия ключа
+	apiKeyNameMaxLength = 100
+)
+
+// isAPIKey - значение похоже на API-ключ
+func isAPIKey(tokenValue string) bool {
+	return strings.HasPrefix(tokenValue, apiKeyPrefix)
+}
+
+// CreateAPIKey - выпуск API-ключа пользователя. Заполняются Name, Scopes, AllowedCIDRs и ExpiresAt из spec;
+// значение ключа возвращается один раз, в БД хранится только его SHA-256
+func (s *Service) CreateAPIKey(ctx context.Context, userID string, spec model.APIKey) (model.NewAPIKey, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.NewAPIKey{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	name := strings.TrimSpace(spec.Name)
+	if name == "" || len(name) > apiKeyNameMaxLength {
+		return model.NewAPIKey{}, model.NewValidationError(
+			fmt.Sprintf("Название ключа должно содержать от 1 до %d символов", apiKeyNameMaxLength), model.ErrInvalidInput)
+	}
+
+	scopes, err := normalizeScopes(spec.Scopes)
+	if err != nil {
+		return model.NewAPIKey{}, err
+	}
+	for _, scope := range scopes {
+		if scope == model.ScopeAdmin && !s.accessManager.Can(user, validate.PermissionManageUsers) {
+			log.Printf("AuthService: Пользователь %s запросил ключ с разрешением admin без прав администратора", user.Login)
+			return model.NewAPIKey{}, model.NewAccessError("Разрешение admin доступно только администраторам", model.ErrAccessDenied)
+		}
+	}
+
+	cidrs, err := normalizeCIDRs(spec.AllowedCIDRs)
+	if err != nil {
+		return model.NewAPIKey{}, err
+	}
+
+	now := time.Now().UTC()
+	var expiresAt *time.Time
+	if spec.ExpiresAt != nil {
+		if !spec.ExpiresAt.After(now) {
+			return model.NewAPIKey{}, model.NewValidationError("Срок действия ключа уже истек", model.ErrInvalidInput)
+		}
+		expires := spec.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+
+	secret, err := s.generateSecureToken()
+	if err != nil {
+		return model.NewAPIKey{}, fmt.Errorf("failed to generate api key: %w", err)
+	}
+	keyValue := apiKeyPrefix + secret
+
+	key := model.APIKey{
+		ID:           uuid.New().String(),
+		UserID:       user.ID,
+		Name:         name,
+		KeyHash:      hashToken(keyValue),
+		KeyPrefix:    keyValue[:apiKeyShownPrefix],
+		Scopes:       scopes,
+		AllowedCIDRs: cidrs,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+	}
+	if err := s.repo.CreateAPIKey(ctx, key); err != nil {
+		return model.NewAPIKey{}, fmt.Errorf("failed to save api key: %w", err)
+	}
+
+	log.Printf("AuthService: Пользователь %s выпустил API-ключ %s (%s)", user.Login, key.ID, key.Name)
+	return model.NewAPIKey{APIKey: key, Key: keyValue}, nil
+}
+
+// ListAPIKeys - API-ключи пользователя (новые первыми)
+func (s *Service) ListAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	keys, err := s.repo.GetAPIKeysByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("AuthService: Ошибка получения API-ключей пользователя %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey - отзыв API-ключа пользователя. Чужой ключ не отличается от несуществующего
+func (s *Service) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	if err := s.repo.DeleteAPIKey(ctx, keyID, userID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+
+	log.Printf("AuthService: API-ключ %s пользователя %s отозван", keyID, userID)
+	return nil
+}
+
+// validateAPIKey - проверка API-ключа: срок действия, адрес клиента и состояние владельца.
+// Возвращает владельца ключа с заполненным APIKey, по которому обработчики проверяют разрешения
+func (s *Service) validateAPIKey(ctx context.Context, keyValue string) (model.User, error) {
+	key, err := s.repo.GetAPIKeyByHash(ctx, hashToken(keyValue))
+	if err != nil {
+		log.Printf("AuthService: API-ключ не найден: %v", err)
+		return model.User{}, fmt.Errorf("invalid token")
+	}
+
+	if key.ExpiresAt != nil && time.Now().UTC().After(*key.ExpiresAt) {
+		log.Printf("AuthService: API-ключ %s истек: %v", key.ID, *key.ExpiresAt)
+		return model.User{}, fmt.Errorf("token expired")
+	}
+
+	client := model.ClientInfoFromContext(ctx)
+	if !ipAllowed(client.IPAddress, key.AllowedCIDRs) {
+		log.Printf("AuthService: API-ключ %s использован с недопустимого адреса %q", key.ID, client.IPAddress)
+		return model.User{}, fmt.Errorf("invalid token")
+	}
+
+	user, err := s.repo.GetUserByID(ctx, key.UserID)
+	if err != nil {
+		log.Printf("AuthService: Владелец API-ключа %s не найден: %v", key.ID, err)
+		return model.User{}, fmt.Errorf("user not found")
+	}
+	if user.Disabled {
+		log.Printf("AuthService: API-ключ заблокированного пользователя %s", user.Login)
+		return model.User{}, fmt.Errorf("invalid token")
+	}
+
+	if err := s.repo.TouchAPIKey(ctx, key.ID, client.IPAddress); err != nil {
+		log.Printf("AuthService: Предупреждение - не удалось записать использование API-ключа %s: %v", key.ID, err)
+	}
+
+	user.APIKey = &key
+	return user, nil
+}
+
+// normalizeScopes - проверка списка разрешений с удалением повторов
+func normalizeScopes(scopes []model.Scope) ([]model.Scope, error) {
+	if len(scopes) == 0 {
+		return nil, model.NewValidationError("Ключ должен иметь хотя бы одно разрешение", model.ErrRequired)
+	}
+
+	seen := make(map[model.Scope]bool, len(scopes))
+	normalized := make([]model.Scope, 0, len(scopes))
+	for _, scope := range scopes {
+		if !scope.Valid() {
+			return nil, model.NewValidationError(fmt.Sprintf("Неизвестное разрешение %q", scope), model.ErrInvalidInput)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			normalized = append(normalized, scope)
+		}
+	}
+	return normalized, nil
+}
+
+// normalizeCIDRs - приведение разрешенных адресов к виду сети; одиночный IP становится /32 или /128
+func normalizeCIDRs(cidrs []string) ([]string, error) {
+	normalized := make([]string, 0, len(cidrs))
+	for _, raw := range cidrs {
+		value := strings.TrimSpace(raw)
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, model.NewValidationError(fmt.Sprintf("Неверный адрес %q", raw), model.ErrInvalidInput)
+			}
+			if ip.To4() != nil {
+				value += "/32"
+			} else {
+				value += "/128"
+			}
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, model.NewValidationError(fmt.Sprintf("Неверная подсеть %q", raw), model.ErrInvalidInput)
+		}
+		normalized = append(normalized, network.String())
+	}
+	return normalized, nil
+}
+
+// ipAllowed - адрес клиента входит в одну из подсетей; пустой список разрешает любой адрес
+func ipAllowed(address string, cidrs []string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
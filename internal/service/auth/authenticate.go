@@ -8,63 +8,104 @@ import (
 	"time"
 
 	"github.com/NarthurN/FileServerService/internal/model"
-	"github.com/google/uuid"
 )
 
-// AuthenticateUser - аутентификация с полной проверкой
-func (s *Service) AuthenticateUser(ctx context.Context, login, password string) (string, error) {
+// AuthenticateUser - аутентификация с полной проверкой. При включенной 2FA вместо токена сессии
+// возвращается токен второго шага, который обменивается на сессию в VerifySecondFactor
+func (s *Service) AuthenticateUser(ctx context.Context, login, password string, client model.ClientInfo) (model.LoginResult, error) {
 	log.Printf("AuthService: Начало аутентификации пользователя %s", login)
 
 	// Валидация входных данных
 	if err := s.validateAuthInput(login, password); err != nil {
 		log.Printf("AuthService: Неверные входные данные для аутентификации: %v", err)
-		return "", fmt.Errorf("invalid input: %w", err)
+		return model.LoginResult{}, fmt.Errorf("invalid input: %w", err)
 	}
 
 	// Нормализация логина
 	normalizedLogin := strings.ToLower(strings.TrimSpace(login))
 
+	// Ограничение частоты попыток считает и попытки входа в несуществующие логины
+	if err := s.checkLoginRate(normalizedLogin, client); err != nil {
+		return model.LoginResult{}, err
+	}
+
 	// Получение пользователя
 	user, err := s.repo.GetUserByLogin(ctx, normalizedLogin)
 	if err != nil {
 		log.Printf("AuthService: Пользователь %s не найден: %v", normalizedLogin, err)
-		return "", fmt.Errorf("invalid credentials")
+		return model.LoginResult{}, fmt.Errorf("invalid credentials")
+	}
+
+	// Временная блокировка и пауза после неудачных попыток
+	if err := s.checkLoginLockout(user, time.Now().UTC()); err != nil {
+		return model.LoginResult{}, err
+	}
+
+	// Попытка учитывается до проверки пароля, поэтому параллельные запросы не получают лишних попыток
+	claimed, err := s.claimLoginAttempt(ctx, user)
+	if err != nil {
+		return model.LoginResult{}, err
 	}
 
 	// Проверка пароля
 	if err := s.verifyPassword(password, user.Password); err != nil {
 		log.Printf("AuthService: Неверный пароль для пользователя %s", normalizedLogin)
-		return "", fmt.Errorf("invalid credentials")
+		return model.LoginResult{}, fmt.Errorf("invalid credentials")
 	}
 
-	// Бизнес-логика: деактивация старых токенов (опционально)
-	if err := s.cleanupOldTokens(ctx, user.ID); err != nil {
-		log.Printf("AuthService: Предупреждение - не удалось очистить старые токены: %v", err)
-		// Не прерываем процесс, это не критичная ошибка
+	// Успешная проверка пароля обнуляет счетчик неудачных попыток; при 2FA - только после второго шага
+	if user.TOTPEnabled {
+		s.restoreLoginFailures(ctx, user, claimed)
+	} else {
+		s.resetLoginFailures(ctx, claimed)
 	}
 
-	// Генерация нового токена
-	tokenValue, err := s.generateSecureToken()
-	if err != nil {
-		log.Printf("AuthService: Ошибка генерации токена: %v", err)
-		return "", fmt.Errorf("failed to generate token: %w", err)
+	// Заблокированный пользователь не может войти (проверяется после пароля, чтобы не раскрывать статус)
+	if user.Disabled {
+		log.Printf("AuthService: Вход заблокированного пользователя %s", normalizedLogin)
+		return model.LoginResult{}, model.NewAuthError("Учетная запись заблокирована", model.ErrAccountDisabled)
 	}
 
-	// Создание токена с настраиваемым временем жизни
-	token := model.Token{
-		ID:        uuid.New().String(),
-		UserID:    user.ID,
-		Token:     tokenValue,
-		ExpiresAt: time.Now().UTC().Add(s.getTokenLifetime()),
-		CreatedAt: time.Now().UTC(),
-		IsActive:  true,
+	// После сброса пароля администратором вход возможен только с новым паролем
+	if user.PasswordResetRequired {
+		log.Printf("AuthService: Вход пользователя %s до смены сброшенного пароля", normalizedLogin)
+		return model.LoginResult{}, model.NewAuthError("Пароль сброшен, задайте новый по токену сброса", model.ErrPasswordResetRequired)
 	}
 
-	if _, err := s.repo.CreateToken(ctx, token); err != nil {
-		log.Printf("AuthService: Ошибка сохранения токена: %v", err)
-		return "", fmt.Errorf("failed to save token: %w", err)
+	if user.TOTPEnabled {
+		result, err := s.createLoginChallenge(ctx, user)
+		if err != nil {
+			log.Printf("AuthService: Ошибка выпуска токена второго шага: %v", err)
+			return model.LoginResult{}, err
+		}
+		log.Printf("AuthService: Пароль пользователя %s принят, ожидается код второго фактора", normalizedLogin)
+		return result, nil
+	}
+
+	tokenValue, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return model.LoginResult{}, err
 	}
 
 	log.Printf("AuthService: Пользователь %s успешно аутентифицирован", normalizedLogin)
+	return model.LoginResult{Token: tokenValue}, nil
+}
+
+// startSession - выпуск токена сессии после успешного входа
+func (s *Service) startSession(ctx context.Context, user model.User, client model.ClientInfo) (string, error) {
+	// Политика одной сессии: вход завершает остальные сессии пользователя
+	if s.config.Auth.SingleSession {
+		if _, err := s.revokeUserSessions(ctx, user.ID); err != nil {
+			log.Printf("AuthService: Предупреждение - не удалось завершить старые сессии: %v", err)
+			// Не прерываем процесс, это не критичная ошибка
+		}
+	}
+
+	// Генерация нового токена с настраиваемым временем жизни
+	tokenValue, err := s.issueToken(ctx, user, client)
+	if err != nil {
+		log.Printf("AuthService: Ошибка выпуска токена: %v", err)
+		return "", err
+	}
 	return tokenValue, nil
 }
@@ -0,0 +1,119 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/google/uuid"
+)
+
+// Ограничения длины сведений о клиенте (размеры столбцов tokens)
+const (
+	maxUserAgentLength = 512
+	maxIPAddressLength = 64
+)
+
+// issueToken - выпуск токена пользователю: JWT или случайная строка. В обоих режимах в таблицу tokens
+// пишется сессия: для случайной строки хранится только ее SHA-256, для JWT - только jti
+func (s *Service) issueToken(ctx context.Context, user model.User, client model.ClientInfo) (string, error) {
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.getTokenLifetime())
+
+	session := model.Token{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		IsActive:  true,
+		UserAgent: truncate(client.UserAgent, maxUserAgentLength),
+		IPAddress: truncate(client.IPAddress, maxIPAddressLength),
+	}
+
+	var tokenValue string
+	var err error
+	if s.jwt != nil {
+		tokenValue, err = s.jwt.sign(jwtClaims{
+			Subject:   user.ID,
+			Login:     user.Login,
+			ID:        session.ID,
+			IssuedAt:  now.Unix(),
+			ExpiresAt: expiresAt.Unix(),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to sign token: %w", err)
+		}
+	} else {
+		tokenValue, err = s.generateSecureToken()
+		if err != nil {
+			return "", fmt.Errorf("failed to generate token: %w", err)
+		}
+		session.TokenHash = hashToken(tokenValue)
+	}
+
+	if _, err := s.repo.CreateToken(ctx, session); err != nil {
+		return "", fmt.Errorf("failed to save token: %w", err)
+	}
+
+	return tokenValue, nil
+}
+
+// endSession - завершение сессии: деактивация строки и, для JWT, отзыв по jti
+func (s *Service) endSession(ctx context.Context, session model.Token) error {
+	if session.TokenHash == "" {
+		if err := s.revokeJWT(ctx, session.ID, session.UserID, session.ExpiresAt); err != nil {
+			return err
+		}
+	}
+	return s.repo.DeactivateTokenByID(ctx, session.ID)
+}
+
+// revokeJWT - отзыв JWT до истечения срока: запись в БД для других экземпляров и в список в памяти
+func (s *Service) revokeJWT(ctx context.Context, jti, userID string, expiresAt time.Time) error {
+	if err := s.repo.RevokeToken(ctx, model.RevokedToken{
+		JTI:       jti,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+		RevokedAt: time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+	s.revoked.add(jti, expiresAt)
+	return nil
+}
+
+// SyncRevokedTokens - загрузка отозванных JWT из БД и удаление истекших записей
+func (s *Service) SyncRevokedTokens(ctx context.Context) error {
+	if s.jwt == nil {
+		return nil
+	}
+
+	if _, err := s.repo.DeleteExpiredRevokedTokens(ctx); err != nil {
+		return fmt.Errorf("failed to delete expired revoked tokens: %w", err)
+	}
+	tokens, err := s.repo.GetRevokedTokens(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load revoked tokens: %w", err)
+	}
+	s.revoked.merge(tokens, time.Now().UTC())
+	return nil
+}
+
+// hashToken - SHA-256 значения токена для хранения и поиска в БД. Токен содержит 256 случайных бит,
+// поэтому медленный хеш или соль не нужны: перебор по утекшему хешу невозможен
+func hashToken(tokenValue string) string {
+	hash := sha256.Sum256([]byte(tokenValue))
+	return hex.EncodeToString(hash[:])
+}
+
+// truncate - обрезка до limit байт без разрыва символов UTF-8 (заголовки клиента могут быть любыми)
+func truncate(value string, limit int) string {
+	if len(value) > limit {
+		value = value[:limit]
+	}
+	return strings.ToValidUTF8(value, "")
+}
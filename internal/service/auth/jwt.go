@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+)
+
+// Поддерживаемые алгоритмы подписи JWT
+const (
+	jwtHS256 = "HS256"
+	jwtRS256 = "RS256"
+	jwtEdDSA = "EdDSA"
+)
+
+var (
+	errJWTMalformed = errors.New("malformed jwt")
+	errJWTSignature = errors.New("invalid jwt signature")
+	errJWTExpired   = errors.New("jwt expired")
+)
+
+// jwtHeader - заголовок JWT
+type jwtHeader struct {
+	Alg string `json:"alg"`
+	Typ string `json:"typ"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// jwtClaims - полезная нагрузка пользовательского токена
+type jwtClaims struct {
+	Subject   string `json:"sub"`           // ID пользователя
+	Login     string `json:"login"`         // Логин пользователя
+	Issuer    string `json:"iss,omitempty"` // Издатель
+	ID        string `json:"jti"`           // Уникальный ID токена (для отзыва)
+	IssuedAt  int64  `json:"iat"`           // Время выпуска (unix)
+	ExpiresAt int64  `json:"exp"`           // Время истечения (unix)
+}
+
+// jwtKeys - ключ подписи и набор ключей проверки по kid
+type jwtKeys struct {
+	alg     string
+	kid     string
+	secret  []byte         // HS256
+	signer  crypto.Signer  // RS256/EdDSA
+	verify  map[string]any // kid -> []byte | *rsa.PublicKey | ed25519.PublicKey
+	issuer  string
+	encoder *base64.Encoding
+}
+
+// newJWTKeys - подготовка ключей по конфигурации. Для RS256/EdDSA все <kid>.pem из директории
+// принимаются при проверке, что позволяет менять ключ подписи без отзыва уже выданных токенов
+func newJWTKeys(cfg config.AuthConfig) (*jwtKeys, error) {
+	keys := &jwtKeys{
+		alg:     cfg.JWT.Algorithm,
+		kid:     cfg.JWT.KeyID,
+		verify:  make(map[string]any),
+		issuer:  cfg.JWT.Issuer,
+		encoder: base64.RawURLEncoding,
+	}
+
+	switch keys.alg {
+	case jwtHS256:
+		if len(cfg.JWTSecret) < 32 {
+			return nil, fmt.Errorf("JWT_SECRET must be at least 32 bytes for HS256")
+		}
+		keys.secret = []byte(cfg.JWTSecret)
+		keys.verify[keys.kid] = keys.secret
+		return keys, nil
+	case jwtRS256, jwtEdDSA:
+	default:
+		return nil, fmt.Errorf("unsupported JWT algorithm %q", keys.alg)
+	}
+
+	if cfg.JWT.KeysDir == "" || keys.kid == "" {
+		return nil, fmt.Errorf("JWT_KEYS_DIR and JWT_KEY_ID are required for %s", keys.alg)
+	}
+	files, err := filepath.Glob(filepath.Join(cfg.JWT.KeysDir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		kid := strings.TrimSuffix(filepath.Base(file), ".pem")
+		public, private, err := loadPEMKey(file)
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", kid, err)
+		}
+		if !keyMatchesAlg(keys.alg, public) {
+			return nil, fmt.Errorf("jwt key %s does not match algorithm %s", kid, keys.alg)
+		}
+		keys.verify[kid] = public
+		if kid == keys.kid {
+			if private == nil {
+				return nil, fmt.Errorf("jwt key %s must be a private key to sign tokens", kid)
+			}
+			keys.signer = private
+		}
+	}
+	if keys.signer == nil {
+		return nil, fmt.Errorf("signing key %s.pem not found in %s", keys.kid, cfg.JWT.KeysDir)
+	}
+
+	return keys, nil
+}
+
+// loadPEMKey - чтение открытого или закрытого ключа RSA/Ed25519 из PEM
+func loadPEMKey(file string) (any, crypto.Signer, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, nil, fmt.Errorf("no PEM block found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		public, err := x509.ParsePKIXPublicKey(block.Bytes)
+		return public, nil, err
+	case "RSA PRIVATE KEY":
+		private, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return private.Public(), private, nil
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		private, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported private key type %T", parsed)
+		}
+		return private.Public(), private, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+func keyMatchesAlg(alg string, public any) bool {
+	switch public.(type) {
+	case *rsa.PublicKey:
+		return alg == jwtRS256
+	case ed25519.PublicKey:
+		return alg == jwtEdDSA
+	default:
+		return false
+	}
+}
+
+// sign - выпуск подписанного токена
+func (k *jwtKeys) sign(claims jwtClaims) (string, error) {
+	claims.Issuer = k.issuer
+
+	header, err := json.Marshal(jwtHeader{Alg: k.alg, Typ: "JWT", Kid: k.kid})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	signingInput := k.encoder.EncodeToString(header) + "." + k.encoder.EncodeToString(payload)
+
+	var signature []byte
+	switch k.alg {
+	case jwtHS256:
+		mac := hmac.New(sha256.New, k.secret)
+		mac.Write([]byte(signingInput))
+		signature = mac.Sum(nil)
+	case jwtRS256:
+		digest := sha256.Sum256([]byte(signingInput))
+		signature, err = k.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	case jwtEdDSA:
+		signature, err = k.signer.Sign(rand.Reader, []byte(signingInput), crypto.Hash(0))
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return signingInput + "." + k.encoder.EncodeToString(signature), nil
+}
+
+// parse - проверка подписи, алгоритма, издателя и срока действия токена
+func (k *jwtKeys) parse(token string, now time.Time) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, errJWTMalformed
+	}
+
+	var header jwtHeader
+	if err := k.decodePart(parts[0], &header); err != nil {
+		return jwtClaims{}, err
+	}
+	// Алгоритм фиксирован конфигурацией: значение из заголовка не может его подменить
+	if header.Alg != k.alg {
+		return jwtClaims{}, errJWTSignature
+	}
+	key, ok := k.verify[header.Kid]
+	if !ok {
+		return jwtClaims{}, errJWTSignature
+	}
+
+	signature, err := k.encoder.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, errJWTMalformed
+	}
+	signingInput := parts[0] + "." + parts[1]
+
+	valid := false
+	switch public := key.(type) {
+	case []byte:
+		mac := hmac.New(sha256.New, public)
+		mac.Write([]byte(signingInput))
+		valid = hmac.Equal(signature, mac.Sum(nil))
+	case *rsa.PublicKey:
+		digest := sha256.Sum256([]byte(signingInput))
+		valid = rsa.VerifyPKCS1v15(public, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(public, []byte(signingInput), signature)
+	}
+	if !valid {
+		return jwtClaims{}, errJWTSignature
+	}
+
+	var claims jwtClaims
+	if err := k.decodePart(parts[1], &claims); err != nil {
+		return jwtClaims{}, err
+	}
+	if claims.Subject == "" || claims.ID == "" || (k.issuer != "" && claims.Issuer != k.issuer) {
+		return jwtClaims{}, errJWTMalformed
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return jwtClaims{}, errJWTExpired
+	}
+
+	return claims, nil
+}
+
+func (k *jwtKeys) decodePart(part string, v any) error {
+	data, err := k.encoder.DecodeString(part)
+	if err != nil {
+		return errJWTMalformed
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errJWTMalformed
+	}
+	return nil
+}
+
+// isJWT - токен имеет форму JWT (три части через точку), а не случайной hex-строки
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
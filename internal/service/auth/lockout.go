@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// checkLoginRate - ограничение частоты попыток входа с одного IP и в один логин (в памяти экземпляра)
+func (s *Service) checkLoginRate(login string, client model.ClientInfo) error {
+	if s.ipLimiter != nil && client.IPAddress != "" && !s.ipLimiter.Allow(client.IPAddress) {
+		log.Printf("AuthService: Превышена частота попыток входа с адреса %s", client.IPAddress)
+		return model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: s.ipLimiter.RetryAfter(client.IPAddress)}
+	}
+	if s.loginLimiter != nil && !s.loginLimiter.Allow(login) {
+		log.Printf("AuthService: Превышена частота попыток входа в логин %s", login)
+		return model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: s.loginLimiter.RetryAfter(login)}
+	}
+	return nil
+}
+
+// checkLoginLockout - временная блокировка и пауза после неудачных попыток, сохраненные в БД.
+// Быстрая проверка до записи попытки; окончательно попытку допускает claimLoginAttempt
+func (s *Service) checkLoginLockout(user model.User, now time.Time) error {
+	if user.Locked(now) {
+		log.Printf("AuthService: Вход в заблокированную до %v учетную запись %s", *user.LockedUntil, user.Login)
+		return model.ThrottleError{Cause: model.ErrAccountLocked, RetryAfter: user.LockedUntil.Sub(now)}
+	}
+	if user.FailedLogins > 0 && user.LastFailedLoginAt != nil {
+		if wait := user.LastFailedLoginAt.Add(s.loginBackoff(user.FailedLogins)).Sub(now); wait > 0 {
+			log.Printf("AuthService: Повторная попытка входа в %s раньше паузы %v", user.Login, wait)
+			return model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: wait}
+		}
+	}
+	return nil
+}
+
+// claimLoginAttempt - учет попытки входа до проверки пароля или кода: попытка считается неудачной, пока успешная
+// проверка не сбросит счетчик. Параллельная попытка, успевшая раньше, отклоняет эту по паузе или блокировке
+func (s *Service) claimLoginAttempt(ctx context.Context, user model.User) (model.User, error) {
+	claimed, err := s.repo.ClaimLoginAttempt(ctx, user.ID, model.LoginThrottle{
+		MaxFailures: s.config.Auth.Login.MaxFailures,
+		Lockout:     s.config.Auth.Login.Lockout,
+		BackoffBase: s.config.Auth.Login.BackoffBase,
+		BackoffMax:  s.config.Auth.Login.BackoffMax,
+	})
+	if err != nil {
+		if !errors.Is(err, model.ErrConflict) {
+			return model.User{}, fmt.Errorf("failed to record login attempt: %w", err)
+		}
+		// Время до следующей попытки берется из актуального состояния учетной записи
+		now := time.Now().UTC()
+		if current, getErr := s.repo.GetUserByID(ctx, user.ID); getErr == nil {
+			if err := s.checkLoginLockout(current, now); err != nil {
+				return model.User{}, err
+			}
+		}
+		return model.User{}, model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: time.Second}
+	}
+
+	if now := time.Now().UTC(); claimed.Locked(now) && !user.Locked(now) {
+		log.Printf("AuthService: Учетная запись %s заблокирована до %v после %d попыток входа подряд",
+			user.Login, *claimed.LockedUntil, claimed.FailedLogins)
+	}
+	return claimed, nil
+}
+
+// restoreLoginFailures - успешная проверка пароля при включенной 2FA возвращает счетчик к состоянию до попытки:
+// сбрасывается он только после второго шага, иначе подбор кода не приводил бы к блокировке
+func (s *Service) restoreLoginFailures(ctx context.Context, previous, claimed model.User) {
+	if err := s.repo.RestoreLoginFailures(ctx, previous, claimed); err != nil {
+		log.Printf("AuthService: Предупреждение - не удалось восстановить счетчик неудачных входов: %v", err)
+	}
+}
+
+// resetLoginFailures - обнуление счетчика неудачных попыток после успешного входа
+func (s *Service) resetLoginFailures(ctx context.Context, user model.User) {
+	if user.FailedLogins > 0 || user.LockedUntil != nil {
+		if err := s.repo.ResetLoginFailures(ctx, user.ID); err != nil {
+			log.Printf("AuthService: Предупреждение - не удалось сбросить счетчик неудачных входов: %v", err)
+		}
+	}
+	if s.loginLimiter != nil {
+		s.loginLimiter.Reset(user.Login)
+	}
+}
+
+// loginBackoff - пауза после failures неудачных попыток подряд: BackoffBase * 2^(failures-1), не больше BackoffMax
+func (s *Service) loginBackoff(failures int) time.Duration {
+	base, limit := s.config.Auth.Login.BackoffBase, s.config.Auth.Login.BackoffMax
+	if base <= 0 || failures <= 0 {
+		return 0
+	}
+
+	wait := base
+	for i := 1; i < failures; i++ {
+		wait *= 2
+		if limit > 0 && wait >= limit {
+			return limit
+		}
+	}
+	if limit > 0 && wait > limit {
+		return limit
+	}
+	return wait
+}
+
+// UnlockUser - снятие временной блокировки входа и сброс счетчика неудачных попыток администратором
+func (s *Service) UnlockUser(ctx context.Context, adminID, userID string) (model.User, error) {
+	admin, err := s.requireAdmin(ctx, adminID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.repo.ResetLoginFailures(ctx, user.ID); err != nil {
+		return model.User{}, fmt.Errorf("failed to unlock user: %w", err)
+	}
+	if s.loginLimiter != nil {
+		s.loginLimiter.Reset(user.Login)
+	}
+	if err := s.cacheManager.InvalidateUser(ctx, user.ID); err != nil {
+		log.Printf("AuthService: Ошибка инвалидации кэша пользователя: %v", err)
+	}
+
+	user.FailedLogins = 0
+	user.LastFailedLoginAt = nil
+	user.LockedUntil = nil
+
+	log.Printf("AuthService: Администратор %s снял блокировку входа пользователя %s", admin.Login, user.Login)
+	return user, nil
+}
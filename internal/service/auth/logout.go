@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
 )
 
 // LogoutUser - завершение сессии
@@ -11,18 +14,37 @@ func (s *Service) LogoutUser(ctx context.Context, tokenValue string) error {
 	log.Printf("AuthService: Завершение сессии для токена")
 
 	if tokenValue == "" {
-		return fmt.Errorf("token is required")
+		return fmt.Errorf("token is required: %w", model.ErrInvalidToken)
+	}
+
+	// JWT не хранится в БД: выход - это отзыв по jti до истечения срока
+	if s.jwt != nil && isJWT(tokenValue) {
+		claims, err := s.jwt.parse(tokenValue, time.Now().UTC())
+		if err != nil {
+			log.Printf("AuthService: JWT для выхода недействителен: %v", err)
+			return fmt.Errorf("token not found: %w", model.ErrInvalidToken)
+		}
+		if err := s.endSession(ctx, model.Token{
+			ID:        claims.ID,
+			UserID:    claims.Subject,
+			ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC(),
+		}); err != nil {
+			log.Printf("AuthService: Ошибка отзыва токена: %v", err)
+			return fmt.Errorf("failed to revoke token: %w", err)
+		}
+		log.Printf("AuthService: Сессия успешно завершена (JWT отозван)")
+		return nil
 	}
 
 	// Проверяем, что токен существует
-	_, err := s.repo.GetTokenByValue(ctx, tokenValue)
+	_, err := s.repo.GetTokenByHash(ctx, hashToken(tokenValue))
 	if err != nil {
 		log.Printf("AuthService: Токен для выхода не найден: %v", err)
-		return fmt.Errorf("token not found")
+		return fmt.Errorf("token not found: %w", model.ErrInvalidToken)
 	}
 
 	// Деактивируем токен
-	if err := s.repo.DeactivateToken(ctx, tokenValue); err != nil {
+	if err := s.repo.DeactivateTokenByHash(ctx, hashToken(tokenValue)); err != nil {
 		log.Printf("AuthService: Ошибка деактивации токена: %v", err)
 		return fmt.Errorf("failed to deactivate token: %w", err)
 	}
@@ -0,0 +1,450 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// Ограничения обмена с провайдером OIDC
+const (
+	oidcHTTPTimeout     = 10 * time.Second
+	oidcMaxResponseSize = 1 << 20
+	oidcClockSkew       = time.Minute // Допустимое расхождение часов с провайдером
+	oidcKeysRefresh     = time.Minute // Не чаще этого перечитываем JWKS при неизвестном kid
+)
+
+var (
+	errOIDCProvider = errors.New("oidc provider error")
+	errOIDCIDToken  = errors.New("invalid oidc id token")
+)
+
+// oidcDiscovery - нужная часть документа /.well-known/openid-configuration
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// oidcAudience - claim aud: строка или массив строк
+type oidcAudience []string
+
+func (a *oidcAudience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = oidcAudience{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+	return nil
+}
+
+// oidcClaims - проверенные claims ID-токена
+type oidcClaims struct {
+	Issuer          string       `json:"iss"`
+	Subject         string       `json:"sub"`
+	Audience        oidcAudience `json:"aud"`
+	AuthorizedParty string       `json:"azp"`
+	ExpiresAt       int64        `json:"exp"`
+	IssuedAt        int64        `json:"iat"`
+	Nonce           string       `json:"nonce"`
+	Email           string       `json:"email"`
+	EmailVerified   bool         `json:"email_verified"`
+
+	raw map[string]any // Все claims, для AUTH_OIDC_LOGIN_CLAIM
+}
+
+// claim - строковое значение произвольного claim
+func (c oidcClaims) claim(name string) string {
+	value, _ := c.raw[name].(string)
+	return value
+}
+
+// oidcProvider - клиент провайдера OpenID Connect: discovery, обмен кода и проверка ID-токена
+type oidcProvider struct {
+	cfg    config.OIDCConfig
+	client *http.Client
+
+	mu          sync.Mutex
+	discovery   *oidcDiscovery // nil - документ еще не загружен
+	keys        map[string]any // kid -> *rsa.PublicKey | *ecdsa.PublicKey | ed25519.PublicKey
+	keysFetched time.Time
+}
+
+// newOIDCProvider - клиент провайдера по конфигурации; nil, если вход через OIDC выключен.
+// Провайдер не опрашивается при старте, чтобы его недоступность не мешала запуску сервиса
+func newOIDCProvider(cfg config.OIDCConfig) (*oidcProvider, error) {
+	if cfg.IssuerURL == "" {
+		return nil, nil
+	}
+	if cfg.ClientID == "" || cfg.RedirectURL == "" {
+		return nil, fmt.Errorf("AUTH_OIDC_CLIENT_ID and AUTH_OIDC_REDIRECT_URL are required")
+	}
+	if _, err := url.ParseRequestURI(cfg.IssuerURL); err != nil {
+		return nil, fmt.Errorf("invalid AUTH_OIDC_ISSUER: %w", err)
+	}
+	if !model.Role(cfg.DefaultRole).Valid() {
+		return nil, fmt.Errorf("unknown AUTH_OIDC_DEFAULT_ROLE %q", cfg.DefaultRole)
+	}
+	if cfg.LoginClaim == "" {
+		return nil, fmt.Errorf("AUTH_OIDC_LOGIN_CLAIM is required")
+	}
+
+	return &oidcProvider{
+		cfg:    cfg,
+		client: &http.Client{Timeout: oidcHTTPTimeout},
+	}, nil
+}
+
+// getDiscovery - документ discovery провайдера (загружается один раз)
+func (p *oidcProvider) getDiscovery(ctx context.Context) (oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.discovery != nil {
+		return *p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	if err := p.getJSON(ctx, p.cfg.IssuerURL+"/.well-known/openid-configuration", &discovery); err != nil {
+		return oidcDiscovery{}, err
+	}
+	// Издатель в документе обязан совпадать с настроенным (OpenID Connect Discovery, 4.3)
+	if strings.TrimSuffix(discovery.Issuer, "/") != p.cfg.IssuerURL {
+		return oidcDiscovery{}, fmt.Errorf("%w: discovery issuer %q does not match %q", errOIDCProvider, discovery.Issuer, p.cfg.IssuerURL)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return oidcDiscovery{}, fmt.Errorf("%w: incomplete discovery document", errOIDCProvider)
+	}
+
+	p.discovery = &discovery
+	return discovery, nil
+}
+
+// authorizationURL - адрес страницы входа провайдера для authorization code + PKCE (S256)
+func (p *oidcProvider) authorizationURL(ctx context.Context, state, nonce, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := []string{"openid"}
+	for _, scope := range p.cfg.Scopes {
+		if scope != "openid" {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.cfg.ClientID)
+	query.Set("redirect_uri", p.cfg.RedirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", pkceChallenge(codeVerifier))
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// exchangeCode - обмен кода авторизации на ID-токен
+func (p *oidcProvider) exchangeCode(ctx context.Context, code, codeVerifier string) (string, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.cfg.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.cfg.ClientID)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if p.cfg.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.cfg.ClientID), url.QueryEscape(p.cfg.ClientSecret))
+	}
+
+	var response struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := p.doJSON(req, &response); err != nil {
+		return "", err
+	}
+	if response.Error != "" {
+		return "", fmt.Errorf("%w: %s: %s", errOIDCProvider, response.Error, response.ErrorDescription)
+	}
+	if response.IDToken == "" {
+		return "", fmt.Errorf("%w: token response without id_token", errOIDCProvider)
+	}
+	return response.IDToken, nil
+}
+
+// verifyIDToken - проверка подписи, издателя, получателя, срока действия и nonce ID-токена
+func (p *oidcProvider) verifyIDToken(ctx context.Context, token, nonce string, now time.Time) (oidcClaims, error) {
+	discovery, err := p.getDiscovery(ctx)
+	if err != nil {
+		return oidcClaims{}, err
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return oidcClaims{}, errOIDCIDToken
+	}
+	encoder := base64.RawURLEncoding
+
+	var header jwtHeader
+	if err := decodeJSONPart(encoder, parts[0], &header); err != nil {
+		return oidcClaims{}, err
+	}
+	key, err := p.key(ctx, discovery, header.Kid)
+	if err != nil {
+		return oidcClaims{}, err
+	}
+	signature, err := encoder.DecodeString(parts[2])
+	if err != nil {
+		return oidcClaims{}, errOIDCIDToken
+	}
+	if !verifyOIDCSignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature) {
+		return oidcClaims{}, fmt.Errorf("%w: bad signature", errOIDCIDToken)
+	}
+
+	var claims oidcClaims
+	if err := decodeJSONPart(encoder, parts[1], &claims); err != nil {
+		return oidcClaims{}, err
+	}
+	if err := decodeJSONPart(encoder, parts[1], &claims.raw); err != nil {
+		return oidcClaims{}, err
+	}
+
+	switch {
+	case claims.Issuer != discovery.Issuer:
+		return oidcClaims{}, fmt.Errorf("%w: unexpected issuer %q", errOIDCIDToken, claims.Issuer)
+	case claims.Subject == "":
+		return oidcClaims{}, fmt.Errorf("%w: empty subject", errOIDCIDToken)
+	case !claims.hasAudience(p.cfg.ClientID):
+		return oidcClaims{}, fmt.Errorf("%w: token issued for another client", errOIDCIDToken)
+	case len(claims.Audience) > 1 && claims.AuthorizedParty != p.cfg.ClientID:
+		return oidcClaims{}, fmt.Errorf("%w: unexpected azp %q", errOIDCIDToken, claims.AuthorizedParty)
+	case now.Add(-oidcClockSkew).Unix() >= claims.ExpiresAt:
+		return oidcClaims{}, fmt.Errorf("%w: token expired", errOIDCIDToken)
+	case claims.IssuedAt > now.Add(oidcClockSkew).Unix():
+		return oidcClaims{}, fmt.Errorf("%w: token issued in the future", errOIDCIDToken)
+	case claims.Nonce != nonce:
+		return oidcClaims{}, fmt.Errorf("%w: nonce mismatch", errOIDCIDToken)
+	}
+
+	return claims, nil
+}
+
+func (c oidcClaims) hasAudience(clientID string) bool {
+	for _, audience := range c.Audience {
+		if audience == clientID {
+			return true
+		}
+	}
+	return false
+}
+
+// key - открытый ключ провайдера по kid. JWKS перечитывается, если kid неизвестен
+// (провайдер сменил ключ), но не чаще oidcKeysRefresh
+func (p *oidcProvider) key(ctx context.Context, discovery oidcDiscovery, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysFetched) < oidcKeysRefresh && p.keys != nil {
+		return nil, fmt.Errorf("%w: unknown key %q", errOIDCIDToken, kid)
+	}
+
+	var jwks struct {
+		Keys []oidcJWK `json:"keys"`
+	}
+	if err := p.getJSON(ctx, discovery.JWKSURI, &jwks); err != nil {
+		return nil, err
+	}
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		public, err := jwk.publicKey()
+		if err != nil {
+			continue // Ключи неподдерживаемых типов пропускаются
+		}
+		keys[jwk.Kid] = public
+	}
+	p.keys, p.keysFetched = keys, time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: unknown key %q", errOIDCIDToken, kid)
+}
+
+// lookupKey - ключ по kid; без kid подходит единственный ключ набора
+func (p *oidcProvider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, target string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	return p.doJSON(req, v)
+}
+
+// doJSON - запрос к провайдеру с разбором JSON-ответа. Ответ с ошибкой OAuth (400/401)
+// тоже разбирается, чтобы показать код ошибки провайдера
+func (p *oidcProvider) doJSON(req *http.Request, v any) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errOIDCProvider, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, oidcMaxResponseSize))
+	if err != nil {
+		return fmt.Errorf("%w: %v", errOIDCProvider, err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest && resp.StatusCode != http.StatusUnauthorized {
+		return fmt.Errorf("%w: %s returned %d", errOIDCProvider, req.URL.Path, resp.StatusCode)
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("%w: %s: %v", errOIDCProvider, req.URL.Path, err)
+	}
+	return nil
+}
+
+// oidcJWK - ключ из набора JWKS (RFC 7517)
+type oidcJWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k oidcJWK) publicKey() (any, error) {
+	decode := base64.RawURLEncoding.DecodeString
+	switch {
+	case k.Kty == "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case k.Kty == "EC" && k.Crv == "P-256":
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		public := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !public.Curve.IsOnCurve(public.X, public.Y) {
+			return nil, errors.New("point is not on curve")
+		}
+		return public, nil
+	case k.Kty == "OKP" && k.Crv == "Ed25519":
+		x, err := decode(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
+
+// verifyOIDCSignature - проверка подписи ID-токена; алгоритм должен соответствовать типу ключа,
+// поэтому "none" и подмена RS256 на HS256 невозможны
+func verifyOIDCSignature(alg string, key any, signingInput, signature []byte) bool {
+	digest := sha256.Sum256(signingInput)
+	switch public := key.(type) {
+	case *rsa.PublicKey:
+		return alg == "RS256" && rsa.VerifyPKCS1v15(public, crypto.SHA256, digest[:], signature) == nil
+	case *ecdsa.PublicKey:
+		if alg != "ES256" || len(signature) != 64 {
+			return false
+		}
+		r, s := new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:])
+		return ecdsa.Verify(public, digest[:], r, s)
+	case ed25519.PublicKey:
+		return alg == jwtEdDSA && ed25519.Verify(public, signingInput, signature)
+	default:
+		return false
+	}
+}
+
+// pkceChallenge - code_challenge для метода S256 (RFC 7636)
+func pkceChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func decodeJSONPart(encoder *base64.Encoding, part string, v any) error {
+	data, err := encoder.DecodeString(part)
+	if err != nil {
+		return errOIDCIDToken
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return errOIDCIDToken
+	}
+	return nil
+}
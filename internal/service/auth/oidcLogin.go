@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// maxOIDCLoginLength - ограничение длины логина, взятого из claim (размер столбца users.login)
+const maxOIDCLoginLength = 255
+
+// StartOIDCLogin - начало входа через провайдера OIDC: адрес страницы входа провайдера.
+// state, nonce и PKCE code_verifier сохраняются до возврата пользователя
+func (s *Service) StartOIDCLogin(ctx context.Context) (string, error) {
+	if s.oidc == nil {
+		return "", model.ErrOIDCNotConfigured
+	}
+
+	state, err := s.generateSecureToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate state: %w", err)
+	}
+	nonce, err := s.generateSecureToken()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	verifierBytes := make([]byte, 32)
+	if _, err := rand.Read(verifierBytes); err != nil {
+		return "", fmt.Errorf("failed to generate code verifier: %w", err)
+	}
+	codeVerifier := base64.RawURLEncoding.EncodeToString(verifierBytes)
+
+	authURL, err := s.oidc.authorizationURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		log.Printf("AuthService: Ошибка обращения к провайдеру OIDC: %v", err)
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	if err := s.repo.CreateOIDCState(ctx, model.OIDCState{
+		ID:           uuid.New().String(),
+		StateHash:    hashToken(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    now.Add(s.config.Auth.OIDC.StateTTL),
+		CreatedAt:    now,
+	}); err != nil {
+		return "", fmt.Errorf("failed to save oidc state: %w", err)
+	}
+
+	return authURL, nil
+}
+
+// CompleteOIDCLogin - завершение входа через OIDC: обмен кода на ID-токен, сопоставление
+// учетной записи провайдера с пользователем и выпуск токена сессии (или токена второго шага при 2FA)
+func (s *Service) CompleteOIDCLogin(ctx context.Context, code, state string, client model.ClientInfo) (model.LoginResult, error) {
+	if s.oidc == nil {
+		return model.LoginResult{}, model.ErrOIDCNotConfigured
+	}
+	if code == "" || state == "" {
+		return model.LoginResult{}, model.NewValidationError("Не переданы code и state", model.ErrInvalidInput)
+	}
+
+	pending, err := s.repo.ConsumeOIDCState(ctx, hashToken(state))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			log.Printf("AuthService: Неизвестный или истекший state входа через OIDC")
+			return model.LoginResult{}, model.NewAuthError("Вход через OIDC не начат или устарел", model.ErrInvalidToken)
+		}
+		return model.LoginResult{}, fmt.Errorf("failed to consume oidc state: %w", err)
+	}
+
+	idToken, err := s.oidc.exchangeCode(ctx, code, pending.CodeVerifier)
+	if err != nil {
+		log.Printf("AuthService: Ошибка обмена кода OIDC: %v", err)
+		return model.LoginResult{}, model.NewAuthError("Провайдер отклонил код авторизации", errors.Join(model.ErrInvalidCredentials, err))
+	}
+	claims, err := s.oidc.verifyIDToken(ctx, idToken, pending.Nonce, time.Now().UTC())
+	if err != nil {
+		log.Printf("AuthService: Неверный ID-токен OIDC: %v", err)
+		return model.LoginResult{}, model.NewAuthError("Неверный ID-токен провайдера", errors.Join(model.ErrInvalidCredentials, err))
+	}
+
+	user, err := s.resolveOIDCUser(ctx, claims)
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+	if user.Disabled {
+		log.Printf("AuthService: Вход через OIDC заблокированного пользователя %s", user.Login)
+		return model.LoginResult{}, model.NewAuthError("Учетная запись заблокирована", model.ErrAccountDisabled)
+	}
+
+	if user.TOTPEnabled {
+		result, err := s.createLoginChallenge(ctx, user)
+		if err != nil {
+			return model.LoginResult{}, err
+		}
+		log.Printf("AuthService: Пользователь %s вошел через OIDC, ожидается код второго фактора", user.Login)
+		return result, nil
+	}
+
+	tokenValue, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return model.LoginResult{}, err
+	}
+
+	log.Printf("AuthService: Пользователь %s аутентифицирован через OIDC", user.Login)
+	return model.LoginResult{Token: tokenValue}, nil
+}
+
+// resolveOIDCUser - пользователь для учетной записи провайдера: по привязке (издатель + subject),
+// иначе по логину из AUTH_OIDC_LOGIN_CLAIM с привязкой, иначе новый пользователь при AUTH_OIDC_AUTO_PROVISION
+func (s *Service) resolveOIDCUser(ctx context.Context, claims oidcClaims) (model.User, error) {
+	user, err := s.repo.GetUserByIdentity(ctx, claims.Issuer, claims.Subject)
+	if err == nil {
+		if err := s.repo.TouchUserIdentity(ctx, claims.Issuer, claims.Subject, claims.Email); err != nil {
+			log.Printf("AuthService: Предупреждение - не удалось отметить вход через OIDC: %v", err)
+		}
+		return user, nil
+	}
+	if !errors.Is(err, model.ErrNotFound) {
+		return model.User{}, fmt.Errorf("failed to get user by identity: %w", err)
+	}
+
+	cfg := s.config.Auth.OIDC
+	// Неподтвержденный email позволил бы выдать себя за чужую учетную запись
+	if cfg.LoginClaim == "email" && !claims.EmailVerified {
+		log.Printf("AuthService: Вход через OIDC с неподтвержденным email %s", claims.Email)
+		return model.User{}, model.NewAccessError("Email не подтвержден провайдером", model.ErrAccessDenied)
+	}
+	login := strings.ToLower(strings.TrimSpace(claims.claim(cfg.LoginClaim)))
+	if login == "" || len(login) > maxOIDCLoginLength {
+		log.Printf("AuthService: ID-токен OIDC без подходящего claim %s", cfg.LoginClaim)
+		return model.User{}, model.NewValidationError(fmt.Sprintf("Провайдер не передал claim %s", cfg.LoginClaim), model.ErrInvalidInput)
+	}
+
+	user, err = s.repo.GetUserByLogin(ctx, login)
+	switch {
+	case errors.Is(err, model.ErrNotFound):
+		if !cfg.AutoProvision {
+			log.Printf("AuthService: Вход через OIDC незарегистрированного пользователя %s", login)
+			return model.User{}, model.NewAccessError("Пользователь не зарегистрирован", model.ErrAccessDenied)
+		}
+		user, err = s.provisionOIDCUser(ctx, login)
+		if err != nil {
+			return model.User{}, err
+		}
+	case err != nil:
+		return model.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.repo.CreateUserIdentity(ctx, model.UserIdentity{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Email:     claims.Email,
+		CreatedAt: time.Now().UTC(),
+	}); err != nil {
+		return model.User{}, fmt.Errorf("failed to link oidc identity: %w", err)
+	}
+
+	log.Printf("AuthService: Учетная запись OIDC %s привязана к пользователю %s", claims.Subject, user.Login)
+	return user, nil
+}
+
+// provisionOIDCUser - создание пользователя без пароля: он входит только через провайдера
+// (или задает пароль через сброс)
+func (s *Service) provisionOIDCUser(ctx context.Context, login string) (model.User, error) {
+	now := time.Now().UTC()
+	user, err := s.repo.CreateUser(ctx, model.User{
+		ID:        uuid.New().String(),
+		Login:     login,
+		Role:      model.Role(s.config.Auth.OIDC.DefaultRole),
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		log.Printf("AuthService: Ошибка создания пользователя OIDC: %v", err)
+		return model.User{}, model.NewBusinessError("Ошибка создания пользователя в репозитории", err)
+	}
+
+	log.Printf("AuthService: Пользователь %s создан при первом входе через OIDC", user.Login)
+	return user, nil
+}
@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/notify"
+)
+
+// ChangePassword - смена пароля пользователем по текущему паролю.
+// Остальные сессии пользователя завершаются, текущая остается. Возвращает количество завершенных сессий.
+// Неверный текущий пароль учитывается так же, как неудачный вход, чтобы сессия не позволяла подбирать пароль
+func (s *Service) ChangePassword(ctx context.Context, userID, currentToken, currentPassword, newPassword string, client model.ClientInfo) (int, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.checkLoginRate(user.Login, client); err != nil {
+		return 0, err
+	}
+	if err := s.checkLoginLockout(user, time.Now().UTC()); err != nil {
+		return 0, err
+	}
+	claimed, err := s.claimLoginAttempt(ctx, user)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.verifyPassword(currentPassword, user.Password); err != nil {
+		log.Printf("AuthService: Неверный текущий пароль пользователя %s", user.Login)
+		return 0, model.NewAuthError("Неверный текущий пароль", model.ErrInvalidCredentials)
+	}
+	s.resetLoginFailures(ctx, claimed)
+
+	if currentPassword == newPassword {
+		return 0, model.NewValidationError("Новый пароль совпадает с текущим", model.ErrInvalidInput)
+	}
+
+	if err := s.setPassword(ctx, user, newPassword); err != nil {
+		return 0, err
+	}
+
+	// Завершаем все сессии, кроме той, из которой сменили пароль
+	currentID := s.sessionID(ctx, currentToken)
+	tokens, err := s.repo.GetTokensByUserID(ctx, user.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get sessions: %w", err)
+	}
+	revoked := 0
+	for _, token := range tokens {
+		if token.ID == currentID {
+			continue
+		}
+		if err := s.endSession(ctx, token); err != nil {
+			return revoked, fmt.Errorf("failed to revoke session: %w", err)
+		}
+		revoked++
+	}
+
+	log.Printf("AuthService: Пользователь %s сменил пароль, завершено сессий: %d", user.Login, revoked)
+	return revoked, nil
+}
+
+// RequestPasswordReset - выпуск токена сброса пароля по логину и отправка его пользователю.
+// Неизвестный или заблокированный логин не считается ошибкой, чтобы не раскрывать существование учетных записей
+func (s *Service) RequestPasswordReset(ctx context.Context, login string) error {
+	normalizedLogin := strings.ToLower(strings.TrimSpace(login))
+
+	user, err := s.repo.GetUserByLogin(ctx, normalizedLogin)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			log.Printf("AuthService: Запрос сброса пароля для неизвестного логина %s", normalizedLogin)
+			return nil
+		}
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Disabled {
+		log.Printf("AuthService: Запрос сброса пароля для заблокированного пользователя %s", normalizedLogin)
+		return nil
+	}
+
+	if _, err := s.issueResetToken(ctx, user, ""); err != nil {
+		return err
+	}
+
+	log.Printf("AuthService: Токен сброса пароля выпущен для пользователя %s", user.Login)
+	return nil
+}
+
+// ConfirmPasswordReset - установка нового пароля по токену сброса. Токен одноразовый,
+// после смены пароля завершаются все сессии пользователя; возвращает их количество
+func (s *Service) ConfirmPasswordReset(ctx context.Context, resetToken, newPassword string) (int, error) {
+	// Пароль проверяется до погашения, чтобы опечатка не сжигала токен
+	if err := s.validatePassword(newPassword); err != nil {
+		return 0, model.NewValidationError("Неверный пароль", fmt.Errorf("%w: %v", model.ErrInvalidInput, err))
+	}
+
+	token, err := s.repo.ConsumePasswordResetToken(ctx, hashToken(resetToken))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			log.Printf("AuthService: Токен сброса пароля недействителен")
+			return 0, model.NewAuthError("Токен сброса недействителен или истек", model.ErrInvalidToken)
+		}
+		return 0, fmt.Errorf("failed to consume reset token: %w", err)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, token.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.Disabled {
+		return 0, model.NewAuthError("Учетная запись заблокирована", model.ErrAccountDisabled)
+	}
+
+	if err := s.setPassword(ctx, user, newPassword); err != nil {
+		return 0, err
+	}
+	count, err := s.revokeUserSessions(ctx, user.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	log.Printf("AuthService: Пароль пользователя %s изменен по токену сброса, завершено сессий: %d", user.Login, count)
+	return count, nil
+}
+
+// issueResetToken - выпуск токена сброса пароля и отправка его через notifier.
+// createdBy - ID администратора, пусто для запроса самого пользователя
+func (s *Service) issueResetToken(ctx context.Context, user model.User, createdBy string) (model.PasswordReset, error) {
+	tokenValue, err := s.generateSecureToken()
+	if err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to generate reset token: %w", err)
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(s.getResetTokenLifetime())
+	if err := s.repo.CreatePasswordResetToken(ctx, model.PasswordResetToken{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: hashToken(tokenValue),
+		CreatedBy: createdBy,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}); err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to save reset token: %w", err)
+	}
+
+	if err := s.notifier.Send(ctx, notify.Notification{
+		Type:      notify.TypePasswordReset,
+		UserID:    user.ID,
+		Login:     user.Login,
+		Token:     tokenValue,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}); err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to send reset token: %w", err)
+	}
+
+	return model.PasswordReset{User: user, Token: tokenValue, ExpiresAt: expiresAt}, nil
+}
+
+// setPassword - проверка и сохранение нового пароля, снимает требование сброса
+func (s *Service) setPassword(ctx context.Context, user model.User, password string) error {
+	if err := s.validatePassword(password); err != nil {
+		log.Printf("AuthService: Неверный новый пароль для пользователя %s: %v", user.Login, err)
+		return model.NewValidationError("Неверный пароль", fmt.Errorf("%w: %v", model.ErrInvalidInput, err))
+	}
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return model.NewBusinessError("Ошибка хеширования пароля", err)
+	}
+	if err := s.repo.SetUserPassword(ctx, user.ID, hashedPassword, false); err != nil {
+		return fmt.Errorf("failed to update password: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) getResetTokenLifetime() time.Duration {
+	if s.config.Auth.ResetTokenTTL != 0 {
+		return s.config.Auth.ResetTokenTTL
+	}
+	return time.Hour
+}
@@ -7,11 +7,10 @@ import (
 	"time"
 
 	"github.com/NarthurN/FileServerService/internal/model"
-	"github.com/google/uuid"
 )
 
 // RefreshToken - обновление токена
-func (s *Service) RefreshToken(ctx context.Context, oldToken string) (string, error) {
+func (s *Service) RefreshToken(ctx context.Context, oldToken string, client model.ClientInfo) (string, error) {
 	log.Printf("AuthService: Обновление токена")
 
 	// Валидируем старый токен
@@ -19,29 +18,29 @@ func (s *Service) RefreshToken(ctx context.Context, oldToken string) (string, er
 	if err != nil {
 		return "", fmt.Errorf("invalid old token: %w", err)
 	}
+	if user.APIKey != nil {
+		return "", fmt.Errorf("api key cannot be refreshed: %w", model.ErrInvalidToken)
+	}
 
-	// Деактивируем старый токен
-	if err := s.repo.DeactivateToken(ctx, oldToken); err != nil {
+	// Отзываем старый токен
+	if s.jwt != nil && isJWT(oldToken) {
+		if claims, err := s.jwt.parse(oldToken, time.Now().UTC()); err == nil {
+			if err := s.endSession(ctx, model.Token{
+				ID:        claims.ID,
+				UserID:    claims.Subject,
+				ExpiresAt: time.Unix(claims.ExpiresAt, 0).UTC(),
+			}); err != nil {
+				log.Printf("AuthService: Предупреждение - не удалось отозвать старый токен: %v", err)
+			}
+		}
+	} else if err := s.repo.DeactivateTokenByHash(ctx, hashToken(oldToken)); err != nil {
 		log.Printf("AuthService: Предупреждение - не удалось деактивировать старый токен: %v", err)
 	}
 
 	// Создаем новый токен
-	newTokenValue, err := s.generateSecureToken()
+	newTokenValue, err := s.issueToken(ctx, user, client)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate new token: %w", err)
-	}
-
-	newToken := model.Token{
-		ID:        uuid.New().String(),
-		UserID:    user.ID,
-		Token:     newTokenValue,
-		ExpiresAt: time.Now().UTC().Add(s.getTokenLifetime()),
-		CreatedAt: time.Now().UTC(),
-		IsActive:  true,
-	}
-
-	if _, err := s.repo.CreateToken(ctx, newToken); err != nil {
-		return "", fmt.Errorf("failed to save new token: %w", err)
+		return "", fmt.Errorf("failed to issue new token: %w", err)
 	}
 
 	log.Printf("AuthService: Токен успешно обновлен")
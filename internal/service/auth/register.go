@@ -2,6 +2,8 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"strings"
 	"time"
@@ -10,16 +12,77 @@ import (
 	"github.com/google/uuid"
 )
 
-// RegisterUser - регистрация нового пользователя с полной валидацией
-func (s *Service) RegisterUser(ctx context.Context, adminToken, login, password string) (model.User, error) {
+// RegisterUser - регистрация нового пользователя администратором с полной валидацией
+func (s *Service) RegisterUser(ctx context.Context, adminID, login, password string, role model.Role) (model.User, error) {
 	log.Printf("AuthService: Начало регистрации пользователя %s", login)
 
-	// Бизнес-валидация: проверка админского токена
-	if err := s.validateAdminToken(adminToken); err != nil {
-		log.Printf("AuthService: Неверный админский токен")
-		return model.User{}, model.NewAuthError("Неверный админский токен", model.ErrInvalidAdminToken)
+	// Регистрировать пользователей может только администратор (роль берется из БД)
+	admin, err := s.requireAdmin(ctx, adminID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	if role == "" {
+		role = model.RoleUser
+	}
+	if !role.Valid() {
+		log.Printf("AuthService: Неизвестная роль %s", role)
+		return model.User{}, model.NewValidationError("Неизвестная роль", model.ErrInvalidInput)
+	}
+
+	createdUser, err := s.createUser(ctx, login, password, role)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	log.Printf("AuthService: Пользователь %s (%s) успешно зарегистрирован администратором %s с ID %s", login, role, admin.Login, createdUser.ID)
+	return createdUser, nil
+}
+
+// BootstrapAdmin - создание администратора или назначение роли admin существующему пользователю.
+// Используется командой cmd/admin, пока в системе нет ни одного администратора
+func (s *Service) BootstrapAdmin(ctx context.Context, login, password string) (model.User, error) {
+	normalizedLogin := strings.ToLower(strings.TrimSpace(login))
+
+	user, err := s.repo.GetUserByLogin(ctx, normalizedLogin)
+	switch {
+	case err == nil:
+		if user.Role != model.RoleAdmin {
+			if err := s.repo.UpdateUserRole(ctx, user.ID, model.RoleAdmin); err != nil {
+				return model.User{}, fmt.Errorf("failed to grant admin role: %w", err)
+			}
+			user.Role = model.RoleAdmin
+		}
+		// Команда восстанавливает доступ, даже если учетную запись заблокировали или вход временно закрыт
+		if user.Disabled {
+			if err := s.repo.SetUserDisabled(ctx, user.ID, false); err != nil {
+				return model.User{}, fmt.Errorf("failed to enable user: %w", err)
+			}
+			user.Disabled = false
+		}
+		if user.Locked(time.Now().UTC()) {
+			if err := s.repo.ResetLoginFailures(ctx, user.ID); err != nil {
+				return model.User{}, fmt.Errorf("failed to unlock user: %w", err)
+			}
+			user.FailedLogins, user.LockedUntil = 0, nil
+		}
+		log.Printf("AuthService: Пользователь %s назначен администратором", normalizedLogin)
+		return user, nil
+	case !errors.Is(err, model.ErrNotFound):
+		return model.User{}, fmt.Errorf("failed to get user: %w", err)
 	}
 
+	user, err = s.createUser(ctx, login, password, model.RoleAdmin)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	log.Printf("AuthService: Администратор %s создан с ID %s", normalizedLogin, user.ID)
+	return user, nil
+}
+
+// createUser - валидация логина и пароля и создание пользователя с ролью
+func (s *Service) createUser(ctx context.Context, login, password string, role model.Role) (model.User, error) {
 	// Валидация логина согласно заданию
 	if err := s.validateLogin(login); err != nil {
 		log.Printf("AuthService: Неверный логин %s: %v", login, err)
@@ -50,6 +113,7 @@ func (s *Service) RegisterUser(ctx context.Context, adminToken, login, password
 		ID:        uuid.New().String(),
 		Login:     strings.ToLower(strings.TrimSpace(login)), // нормализация
 		Password:  hashedPassword,
+		Role:      role,
 		CreatedAt: time.Now().UTC(),
 		UpdatedAt: time.Now().UTC(),
 	}
@@ -60,6 +124,5 @@ func (s *Service) RegisterUser(ctx context.Context, adminToken, login, password
 		return model.User{}, model.NewBusinessError("Ошибка создания пользователя в репозитории", err)
 	}
 
-	log.Printf("AuthService: Пользователь %s успешно зарегистрирован с ID %s", login, createdUser.ID)
 	return createdUser, nil
 }
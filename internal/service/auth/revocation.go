@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// revocationList - отозванные JWT в памяти: проверка при каждом запросе не обращается к БД,
+// а записи других экземпляров сервиса подтягиваются периодической синхронизацией
+type revocationList struct {
+	mu      sync.RWMutex
+	revoked map[string]time.Time // jti -> срок действия токена
+}
+
+func newRevocationList() *revocationList {
+	return &revocationList{revoked: make(map[string]time.Time)}
+}
+
+// add - отзыв токена до момента его естественного истечения
+func (l *revocationList) add(jti string, expiresAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revoked[jti] = expiresAt
+}
+
+// isRevoked - токен отозван
+func (l *revocationList) isRevoked(jti string) bool {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, ok := l.revoked[jti]
+	return ok
+}
+
+// merge - добавление записей из БД и удаление истекших
+func (l *revocationList) merge(tokens []model.RevokedToken, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, token := range tokens {
+		l.revoked[token.JTI] = token.ExpiresAt
+	}
+	for jti, expiresAt := range l.revoked {
+		if !now.Before(expiresAt) {
+			delete(l.revoked, jti)
+		}
+	}
+}
@@ -12,32 +12,70 @@ import (
 
 	"golang.org/x/crypto/bcrypt"
 
+	"github.com/NarthurN/FileServerService/internal/cache"
 	"github.com/NarthurN/FileServerService/internal/config"
 	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/notify"
 	"github.com/NarthurN/FileServerService/internal/repository"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
 )
 
 type Service struct {
-	repo   repository.FileServerRepository
-	config *config.Config
+	repo          repository.FileServerRepository
+	config        *config.Config
+	accessManager *validate.AccessManager
+	cacheManager  *cache.CacheManager
+	notifier      notify.Notifier       // Доставка токенов сброса пароля
+	jwt           *jwtKeys              // nil в режиме opaque
+	revoked       *revocationList       // Отозванные JWT
+	ipLimiter     *validate.RateLimiter // Частота попыток входа с одного IP; nil - без ограничения
+	loginLimiter  *validate.RateLimiter // Частота попыток входа в один логин; nil - без ограничения
+	oidc          *oidcProvider         // Вход через OpenID Connect; nil - выключен
 }
 
-func NewService(repo repository.FileServerRepository, cfg *config.Config) *Service {
-	return &Service{
-		repo:   repo,
-		config: cfg,
+func NewService(repo repository.FileServerRepository, cfg *config.Config, cacheManager *cache.CacheManager, accessManager *validate.AccessManager) (*Service, error) {
+	s := &Service{
+		repo:          repo,
+		config:        cfg,
+		accessManager: accessManager,
+		cacheManager:  cacheManager,
+		revoked:       newRevocationList(),
 	}
-}
 
-// Вспомогательные методы с бизнес-логикой
+	if login := cfg.Auth.Login; login.RateWindow > 0 {
+		if login.RatePerIP > 0 {
+			s.ipLimiter = validate.NewRateLimiter(login.RatePerIP, login.RateWindow)
+		}
+		if login.RatePerUser > 0 {
+			s.loginLimiter = validate.NewRateLimiter(login.RatePerUser, login.RateWindow)
+		}
+	}
 
-func (s *Service) validateAdminToken(token string) error {
-	if token != s.config.Auth.AdminToken {
-		return fmt.Errorf("admin token mismatch")
+	notifier, err := notify.New(cfg.Notify)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure notifier: %w", err)
 	}
-	return nil
+	s.notifier = notifier
+
+	oidc, err := newOIDCProvider(cfg.Auth.OIDC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure oidc: %w", err)
+	}
+	s.oidc = oidc
+
+	if cfg.Auth.TokenMode == config.TokenModeJWT {
+		keys, err := newJWTKeys(cfg.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure jwt: %w", err)
+		}
+		s.jwt = keys
+	}
+
+	return s, nil
 }
 
+// Вспомогательные методы с бизнес-логикой
+
 func (s *Service) validateLogin(login string) error {
 	login = strings.TrimSpace(login)
 
@@ -168,8 +206,3 @@ func (s *Service) GetUserByLogin(ctx context.Context, login string) (model.User,
 	normalizedLogin := strings.ToLower(strings.TrimSpace(login))
 	return s.repo.GetUserByLogin(ctx, normalizedLogin)
 }
-
-func (s *Service) cleanupOldTokens(ctx context.Context, userID string) error {
-	// Можно настроить: деактивировать все старые токены или оставить несколько активных
-	return s.repo.DeactivateUserTokens(ctx, userID)
-}
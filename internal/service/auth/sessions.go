@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// ListSessions - активные сессии пользователя; currentToken отмечает сессию текущего запроса
+func (s *Service) ListSessions(ctx context.Context, userID, currentToken string) ([]model.Session, error) {
+	tokens, err := s.repo.GetTokensByUserID(ctx, userID)
+	if err != nil {
+		log.Printf("AuthService: Ошибка получения сессий пользователя %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to get sessions: %w", err)
+	}
+
+	currentID := s.sessionID(ctx, currentToken)
+	sessions := make([]model.Session, 0, len(tokens))
+	for _, token := range tokens {
+		// Строка могла остаться активной после отзыва JWT на другом экземпляре до синхронизации
+		if token.TokenHash == "" && s.revoked.isRevoked(token.ID) {
+			continue
+		}
+		sessions = append(sessions, model.Session{
+			ID:        token.ID,
+			CreatedAt: token.CreatedAt,
+			ExpiresAt: token.ExpiresAt,
+			UserAgent: token.UserAgent,
+			IPAddress: token.IPAddress,
+			Current:   token.ID == currentID,
+		})
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession - завершение одной сессии пользователя. Чужая сессия не отличается от несуществующей
+func (s *Service) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	session, err := s.repo.GetTokenByID(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if session.UserID != userID {
+		log.Printf("AuthService: Пользователь %s пытался завершить чужую сессию %s", userID, sessionID)
+		return model.ErrNotFound
+	}
+
+	if err := s.endSession(ctx, session); err != nil {
+		log.Printf("AuthService: Ошибка завершения сессии %s: %v", sessionID, err)
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+
+	log.Printf("AuthService: Сессия %s пользователя %s завершена", sessionID, userID)
+	return nil
+}
+
+// LogoutEverywhere - завершение всех сессий пользователя, возвращает их количество
+func (s *Service) LogoutEverywhere(ctx context.Context, userID string) (int, error) {
+	count, err := s.revokeUserSessions(ctx, userID)
+	if err != nil {
+		log.Printf("AuthService: Ошибка завершения сессий пользователя %s: %v", userID, err)
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	log.Printf("AuthService: Завершено %d сессий пользователя %s", count, userID)
+	return count, nil
+}
+
+// revokeUserSessions - отзыв JWT всех активных сессий и деактивация всех строк пользователя
+func (s *Service) revokeUserSessions(ctx context.Context, userID string) (int, error) {
+	tokens, err := s.repo.GetTokensByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	for _, token := range tokens {
+		if token.TokenHash != "" {
+			continue
+		}
+		if err := s.revokeJWT(ctx, token.ID, token.UserID, token.ExpiresAt); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.repo.DeactivateUserTokens(ctx, userID); err != nil {
+		return 0, err
+	}
+	return len(tokens), nil
+}
+
+// sessionID - идентификатор сессии токена: jti для JWT или id строки tokens
+func (s *Service) sessionID(ctx context.Context, tokenValue string) string {
+	if s.jwt != nil && isJWT(tokenValue) {
+		claims, err := s.jwt.parse(tokenValue, time.Now().UTC())
+		if err != nil {
+			return ""
+		}
+		return claims.ID
+	}
+
+	token, err := s.repo.GetTokenByHash(ctx, hashToken(tokenValue))
+	if err != nil {
+		return ""
+	}
+	return token.ID
+}
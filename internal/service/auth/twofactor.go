@@ -0,0 +1,270 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// Коды восстановления: 10 символов base32 в двух группах (xxxxx-xxxxx), 50 бит случайности
+const (
+	recoveryCodeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+	recoveryCodeLength   = 10
+)
+
+// EnrollTOTP - начало подключения 2FA: новый секрет сохраняется как ожидающий и
+// начинает действовать только после подтверждения кодом из приложения (ConfirmTOTP)
+func (s *Service) EnrollTOTP(ctx context.Context, userID string) (model.TOTPEnrollment, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.TOTPEnrollment{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.TOTPEnabled {
+		return model.TOTPEnrollment{}, model.NewBusinessError("Двухфакторная аутентификация уже включена", model.ErrConflict)
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return model.TOTPEnrollment{}, fmt.Errorf("failed to generate totp secret: %w", err)
+	}
+	if err := s.repo.SetTOTPSecret(ctx, user.ID, secret); err != nil {
+		return model.TOTPEnrollment{}, fmt.Errorf("failed to save totp secret: %w", err)
+	}
+
+	log.Printf("AuthService: Пользователь %s начал подключение 2FA", user.Login)
+	return model.TOTPEnrollment{
+		Secret: secret,
+		URI:    totpURI(s.config.Auth.TwoFactor.Issuer, user.Login, secret),
+	}, nil
+}
+
+// ConfirmTOTP - включение 2FA по коду из приложения. Возвращает одноразовые коды восстановления;
+// они показываются один раз, в БД хранится только их SHA-256
+func (s *Service) ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if user.TOTPEnabled {
+		return nil, model.NewBusinessError("Двухфакторная аутентификация уже включена", model.ErrConflict)
+	}
+	if user.TOTPSecret == "" {
+		return nil, model.NewValidationError("Сначала начните подключение 2FA", model.ErrInvalidInput)
+	}
+
+	step, ok := verifyTOTP(user.TOTPSecret, normalizeCode(code), user.TOTPLastStep, time.Now().UTC())
+	if !ok {
+		log.Printf("AuthService: Неверный код подтверждения 2FA пользователя %s", user.Login)
+		return nil, model.NewValidationError("Неверный код подтверждения", model.ErrSecondFactorInvalid)
+	}
+
+	codes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetTOTPEnabled(ctx, user.ID, true, step); err != nil {
+		return nil, fmt.Errorf("failed to enable totp: %w", err)
+	}
+	s.invalidateUser(ctx, user.ID)
+
+	log.Printf("AuthService: Пользователь %s включил 2FA", user.Login)
+	return codes, nil
+}
+
+// DisableTOTP - отключение 2FA по коду из приложения или коду восстановления.
+// Недоступно, если политика требует 2FA для роли пользователя
+func (s *Service) DisableTOTP(ctx context.Context, userID, code string) error {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if !user.TOTPEnabled {
+		return model.NewValidationError("Двухфакторная аутентификация не включена", model.ErrInvalidInput)
+	}
+	if s.accessManager.SecondFactorRequired(user) {
+		return model.NewAccessError("Двухфакторная аутентификация обязательна для роли "+string(user.Role), model.ErrSecondFactorRequired)
+	}
+
+	if err := s.checkSecondFactor(ctx, user, code); err != nil {
+		return model.NewValidationError("Неверный код", err)
+	}
+
+	if err := s.repo.SetTOTPEnabled(ctx, user.ID, false, 0); err != nil {
+		return fmt.Errorf("failed to disable totp: %w", err)
+	}
+	if err := s.repo.ReplaceRecoveryCodes(ctx, user.ID, nil); err != nil {
+		return fmt.Errorf("failed to delete recovery codes: %w", err)
+	}
+	s.invalidateUser(ctx, user.ID)
+
+	log.Printf("AuthService: Пользователь %s отключил 2FA", user.Login)
+	return nil
+}
+
+// VerifySecondFactor - второй шаг входа: код из приложения или код восстановления в обмен на токен второго шага.
+// Неверный код учитывается и в попытках токена, и в счетчике неудачных входов учетной записи
+func (s *Service) VerifySecondFactor(ctx context.Context, challengeToken, code string, client model.ClientInfo) (string, error) {
+	if strings.TrimSpace(challengeToken) == "" {
+		return "", model.NewAuthError("Неверный или истекший токен второго шага", model.ErrInvalidToken)
+	}
+
+	challenge, err := s.repo.GetLoginChallenge(ctx, hashToken(challengeToken))
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return "", model.NewAuthError("Неверный или истекший токен второго шага", model.ErrInvalidToken)
+		}
+		return "", fmt.Errorf("failed to get login challenge: %w", err)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if err := s.checkLoginLockout(user, time.Now().UTC()); err != nil {
+		return "", err
+	}
+	if user.Disabled || !user.TOTPEnabled {
+		// Учетную запись заблокировали или 2FA отключили после проверки пароля
+		_ = s.repo.DeleteLoginChallenge(ctx, challenge.ID)
+		return "", model.NewAuthError("Неверный или истекший токен второго шага", model.ErrInvalidToken)
+	}
+
+	// Попытка учитывается до проверки кода, поэтому параллельные запросы не получают лишних попыток
+	claimed, err := s.claimLoginAttempt(ctx, user)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.checkSecondFactor(ctx, user, code); err != nil {
+		log.Printf("AuthService: Неверный код второго фактора пользователя %s", user.Login)
+		if err := s.repo.FailLoginChallenge(ctx, challenge.ID, s.config.Auth.TwoFactor.MaxAttempts); err != nil {
+			log.Printf("AuthService: Предупреждение - не удалось учесть попытку второго шага: %v", err)
+		}
+		return "", model.NewAuthError("Неверный код", err)
+	}
+
+	// Токен второго шага одноразовый: при параллельных запросах сессию получит только один
+	if err := s.repo.DeleteLoginChallenge(ctx, challenge.ID); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return "", model.NewAuthError("Неверный или истекший токен второго шага", model.ErrInvalidToken)
+		}
+		return "", fmt.Errorf("failed to consume login challenge: %w", err)
+	}
+	s.resetLoginFailures(ctx, claimed)
+
+	tokenValue, err := s.startSession(ctx, user, client)
+	if err != nil {
+		return "", err
+	}
+
+	log.Printf("AuthService: Пользователь %s прошел второй шаг входа", user.Login)
+	return tokenValue, nil
+}
+
+// createLoginChallenge - выпуск токена второго шага после проверки пароля; в БД хранится только его SHA-256
+func (s *Service) createLoginChallenge(ctx context.Context, user model.User) (model.LoginResult, error) {
+	challengeToken, err := s.generateSecureToken()
+	if err != nil {
+		return model.LoginResult{}, fmt.Errorf("failed to generate challenge: %w", err)
+	}
+
+	now := time.Now().UTC()
+	challenge := model.LoginChallenge{
+		ID:        uuid.New().String(),
+		UserID:    user.ID,
+		TokenHash: hashToken(challengeToken),
+		ExpiresAt: now.Add(s.config.Auth.TwoFactor.ChallengeTTL),
+		CreatedAt: now,
+	}
+	if err := s.repo.CreateLoginChallenge(ctx, challenge); err != nil {
+		return model.LoginResult{}, fmt.Errorf("failed to save challenge: %w", err)
+	}
+
+	return model.LoginResult{Challenge: challengeToken, ChallengeExpiresAt: challenge.ExpiresAt}, nil
+}
+
+// checkSecondFactor - проверка кода из приложения (6 цифр) или кода восстановления; принятый код погашается
+func (s *Service) checkSecondFactor(ctx context.Context, user model.User, code string) error {
+	code = normalizeCode(code)
+	if code == "" {
+		return model.ErrSecondFactorInvalid
+	}
+
+	if len(code) == totpDigits {
+		step, ok := verifyTOTP(user.TOTPSecret, code, user.TOTPLastStep, time.Now().UTC())
+		if !ok {
+			return model.ErrSecondFactorInvalid
+		}
+		// Интервал отмечается атомарно: параллельный запрос с тем же кодом получит отказ
+		used, err := s.repo.UseTOTPStep(ctx, user.ID, step)
+		if err != nil {
+			return fmt.Errorf("failed to save totp step: %w", err)
+		}
+		if !used {
+			return model.ErrSecondFactorInvalid
+		}
+		return nil
+	}
+
+	if err := s.repo.UseRecoveryCode(ctx, user.ID, hashToken(code)); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrSecondFactorInvalid
+		}
+		return fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	log.Printf("AuthService: Пользователь %s использовал код восстановления", user.Login)
+	return nil
+}
+
+// replaceRecoveryCodes - новый набор кодов восстановления взамен прежнего
+func (s *Service) replaceRecoveryCodes(ctx context.Context, userID string) ([]string, error) {
+	count := s.config.Auth.TwoFactor.RecoveryCodes
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+	for len(codes) < count {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate recovery code: %w", err)
+		}
+		codes = append(codes, code[:recoveryCodeLength/2]+"-"+code[recoveryCodeLength/2:])
+		hashes = append(hashes, hashToken(code))
+	}
+
+	if err := s.repo.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, fmt.Errorf("failed to save recovery codes: %w", err)
+	}
+	return codes, nil
+}
+
+// generateRecoveryCode - случайный код восстановления без разделителя
+func generateRecoveryCode() (string, error) {
+	raw := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	code := make([]byte, recoveryCodeLength)
+	for i, b := range raw {
+		code[i] = recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)]
+	}
+	return string(code), nil
+}
+
+// normalizeCode - код без пробелов и дефисов в нижнем регистре: коды вводят вручную
+func normalizeCode(code string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(code)))
+}
+
+// invalidateUser - сброс кэша пользователя после изменения учетной записи
+func (s *Service) invalidateUser(ctx context.Context, userID string) {
+	if err := s.cacheManager.InvalidateUser(ctx, userID); err != nil {
+		log.Printf("AuthService: Ошибка инвалидации кэша пользователя: %v", err)
+	}
+}
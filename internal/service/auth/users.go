@@ -0,0 +1,207 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+const (
+	defaultUsersLimit = 50
+	maxUsersLimit     = 1000
+)
+
+// ListUsers - поиск пользователей администратором с пагинацией
+func (s *Service) ListUsers(ctx context.Context, adminID string, filter model.UserFilter) (model.UserPage, error) {
+	if _, err := s.requireAdmin(ctx, adminID); err != nil {
+		return model.UserPage{}, err
+	}
+
+	if filter.Role != "" && !filter.Role.Valid() {
+		return model.UserPage{}, model.NewValidationError("Неизвестная роль", model.ErrInvalidInput)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultUsersLimit
+	}
+	if filter.Limit > maxUsersLimit {
+		filter.Limit = maxUsersLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	users, total, err := s.repo.ListUsers(ctx, filter)
+	if err != nil {
+		log.Printf("AuthService: Ошибка получения списка пользователей: %v", err)
+		return model.UserPage{}, fmt.Errorf("failed to list users: %w", err)
+	}
+	return model.UserPage{
+		Users:  users,
+		Total:  total,
+		Limit:  filter.Limit,
+		Offset: filter.Offset,
+	}, nil
+}
+
+// UpdateUser - смена роли и блокировка учетной записи администратором.
+// Блокировка завершает все сессии пользователя
+func (s *Service) UpdateUser(ctx context.Context, adminID, userID string, update model.UserUpdate) (model.User, error) {
+	admin, err := s.requireAdmin(ctx, adminID)
+	if err != nil {
+		return model.User{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.User{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	// Администратор не может заблокировать себя или снять с себя роль admin
+	if user.ID == admin.ID && ((update.Disabled != nil && *update.Disabled) || (update.Role != nil && *update.Role != model.RoleAdmin)) {
+		return model.User{}, model.NewValidationError("Нельзя заблокировать себя или снять с себя роль admin", model.ErrSelfModification)
+	}
+
+	if update.Role != nil && *update.Role != user.Role {
+		if !update.Role.Valid() {
+			return model.User{}, model.NewValidationError("Неизвестная роль", model.ErrInvalidInput)
+		}
+		if err := s.repo.UpdateUserRole(ctx, user.ID, *update.Role); err != nil {
+			return model.User{}, fmt.Errorf("failed to update role: %w", err)
+		}
+		log.Printf("AuthService: Администратор %s сменил роль пользователя %s: %s -> %s", admin.Login, user.Login, user.Role, *update.Role)
+		user.Role = *update.Role
+	}
+
+	if update.Disabled != nil && *update.Disabled != user.Disabled {
+		if err := s.repo.SetUserDisabled(ctx, user.ID, *update.Disabled); err != nil {
+			return model.User{}, fmt.Errorf("failed to update account status: %w", err)
+		}
+		user.Disabled = *update.Disabled
+		if user.Disabled {
+			count, err := s.revokeUserSessions(ctx, user.ID)
+			if err != nil {
+				return model.User{}, fmt.Errorf("failed to revoke sessions: %w", err)
+			}
+			log.Printf("AuthService: Администратор %s заблокировал пользователя %s, завершено сессий: %d", admin.Login, user.Login, count)
+		} else {
+			log.Printf("AuthService: Администратор %s разблокировал пользователя %s", admin.Login, user.Login)
+		}
+	}
+
+	// Права доступа к документам в кэше зависят от роли
+	if err := s.cacheManager.InvalidateUser(ctx, user.ID); err != nil {
+		log.Printf("AuthService: Ошибка инвалидации кэша пользователя: %v", err)
+	}
+
+	return user, nil
+}
+
+// ResetUserPassword - принудительный сброс пароля администратором. Вход по старому паролю
+// блокируется до смены пароля по выпущенному токену сброса; все сессии пользователя завершаются
+func (s *Service) ResetUserPassword(ctx context.Context, adminID, userID string) (model.PasswordReset, error) {
+	admin, err := s.requireAdmin(ctx, adminID)
+	if err != nil {
+		return model.PasswordReset{}, err
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if err := s.repo.SetPasswordResetRequired(ctx, user.ID, true); err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to require password reset: %w", err)
+	}
+	user.PasswordResetRequired = true
+
+	count, err := s.revokeUserSessions(ctx, user.ID)
+	if err != nil {
+		return model.PasswordReset{}, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	reset, err := s.issueResetToken(ctx, user, admin.ID)
+	if err != nil {
+		return model.PasswordReset{}, err
+	}
+
+	log.Printf("AuthService: Администратор %s сбросил пароль пользователя %s, завершено сессий: %d", admin.Login, user.Login, count)
+	return reset, nil
+}
+
+// DeleteUser - удаление пользователя администратором. Документы удаляются
+// или, если указан transferTo, передаются другому пользователю
+func (s *Service) DeleteUser(ctx context.Context, adminID, userID, transferTo string) (model.UserDeletion, error) {
+	admin, err := s.requireAdmin(ctx, adminID)
+	if err != nil {
+		return model.UserDeletion{}, err
+	}
+	if userID == admin.ID {
+		return model.UserDeletion{}, model.NewValidationError("Нельзя удалить себя", model.ErrSelfModification)
+	}
+
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		return model.UserDeletion{}, fmt.Errorf("failed to get user: %w", err)
+	}
+	if transferTo != "" {
+		if transferTo == user.ID {
+			return model.UserDeletion{}, model.NewValidationError("Нельзя передать документы удаляемому пользователю", model.ErrInvalidInput)
+		}
+		if _, err := s.repo.GetUserByID(ctx, transferTo); err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return model.UserDeletion{}, model.NewValidationError("Получатель документов не найден", model.ErrInvalidInput)
+			}
+			return model.UserDeletion{}, fmt.Errorf("failed to get transfer target: %w", err)
+		}
+	}
+
+	// JWT отзываются до удаления, пока известны их идентификаторы
+	if _, err := s.revokeUserSessions(ctx, user.ID); err != nil {
+		return model.UserDeletion{}, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	deletion, err := s.repo.DeleteUser(ctx, user.ID, transferTo)
+	if err != nil {
+		log.Printf("AuthService: Ошибка удаления пользователя %s: %v", user.Login, err)
+		return model.UserDeletion{}, fmt.Errorf("failed to delete user: %w", err)
+	}
+
+	// Инвалидируем кэш удаленных и переданных документов
+	for _, id := range append(deletion.DeletedDocuments, deletion.TransferredDocuments...) {
+		if err := s.cacheManager.InvalidateDocument(ctx, id); err != nil {
+			log.Printf("AuthService: Ошибка инвалидации кэша документа: %v", err)
+		}
+		if err := s.cacheManager.InvalidateDocumentVersions(ctx, id, true); err != nil {
+			log.Printf("AuthService: Ошибка инвалидации кэша версий документа: %v", err)
+		}
+	}
+	if err := s.cacheManager.InvalidateUser(ctx, user.ID); err != nil {
+		log.Printf("AuthService: Ошибка инвалидации кэша пользователя: %v", err)
+	}
+	if transferTo != "" {
+		if err := s.cacheManager.InvalidateUserDocuments(ctx, transferTo); err != nil {
+			log.Printf("AuthService: Ошибка инвалидации кэша документов получателя: %v", err)
+		}
+	}
+
+	log.Printf("AuthService: Администратор %s удалил пользователя %s: документов удалено %d, передано %d",
+		admin.Login, user.Login, len(deletion.DeletedDocuments), len(deletion.TransferredDocuments))
+	return deletion, nil
+}
+
+// requireAdmin - загрузка пользователя и проверка права управлять пользователями
+func (s *Service) requireAdmin(ctx context.Context, adminID string) (model.User, error) {
+	admin, err := s.repo.GetUserByID(ctx, adminID)
+	if err != nil {
+		log.Printf("AuthService: Пользователь %s не найден: %v", adminID, err)
+		return model.User{}, model.NewAuthError("Пользователь не найден", model.ErrInvalidToken)
+	}
+	if err := s.accessManager.CheckManageUsers(admin); err != nil {
+		log.Printf("AuthService: Пользователь %s с ролью %s не может управлять пользователями", admin.Login, admin.Role)
+		return model.User{}, model.NewAccessError("Управление пользователями доступно только администраторам", err)
+	}
+	return admin, nil
+}
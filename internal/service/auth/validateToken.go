@@ -2,6 +2,7 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -15,8 +16,31 @@ func (s *Service) ValidateToken(ctx context.Context, tokenValue string) (model.U
 		return model.User{}, fmt.Errorf("token is required")
 	}
 
+	// API-ключ дает доступ только к операциям из его разрешений, их проверяют обработчики
+	if isAPIKey(tokenValue) {
+		return s.validateAPIKey(ctx, tokenValue)
+	}
+
+	// JWT проверяется по подписи и списку отзыва без обращения к БД;
+	// токены, выданные до включения режима jwt, по-прежнему ищутся в таблице tokens
+	if s.jwt != nil && isJWT(tokenValue) {
+		claims, err := s.jwt.parse(tokenValue, time.Now().UTC())
+		if err != nil {
+			log.Printf("AuthService: JWT недействителен: %v", err)
+			if errors.Is(err, errJWTExpired) {
+				return model.User{}, fmt.Errorf("token expired")
+			}
+			return model.User{}, fmt.Errorf("invalid token")
+		}
+		if s.revoked.isRevoked(claims.ID) {
+			log.Printf("AuthService: JWT %s отозван", claims.ID)
+			return model.User{}, fmt.Errorf("invalid token")
+		}
+		return model.User{ID: claims.Subject, Login: claims.Login}, nil
+	}
+
 	// Получение токена с проверкой активности и срока действия
-	token, err := s.repo.GetTokenByValue(ctx, tokenValue)
+	token, err := s.repo.GetTokenByHash(ctx, hashToken(tokenValue))
 	if err != nil {
 		log.Printf("AuthService: Токен не найден или недействителен: %v", err)
 		return model.User{}, fmt.Errorf("invalid token")
@@ -26,7 +50,7 @@ func (s *Service) ValidateToken(ctx context.Context, tokenValue string) (model.U
 	if time.Now().UTC().After(token.ExpiresAt) {
 		log.Printf("AuthService: Токен истек: %v", token.ExpiresAt)
 		// Деактивируем истекший токен
-		_ = s.repo.DeactivateToken(ctx, tokenValue)
+		_ = s.repo.DeactivateTokenByHash(ctx, hashToken(tokenValue))
 		return model.User{}, fmt.Errorf("token expired")
 	}
 
@@ -36,6 +60,10 @@ func (s *Service) ValidateToken(ctx context.Context, tokenValue string) (model.U
 		log.Printf("AuthService: Пользователь для токена не найден: %v", err)
 		return model.User{}, fmt.Errorf("user not found")
 	}
+	if user.Disabled {
+		log.Printf("AuthService: Токен заблокированного пользователя %s", user.Login)
+		return model.User{}, fmt.Errorf("invalid token")
+	}
 
 	return user, nil
 }
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/NarthurN/FileServerService/internal/cache"
 	"github.com/NarthurN/FileServerService/internal/config"
@@ -9,82 +11,327 @@ import (
 	"github.com/NarthurN/FileServerService/internal/repository"
 	"github.com/NarthurN/FileServerService/internal/service/auth"
 	"github.com/NarthurN/FileServerService/internal/service/docs"
+	"github.com/NarthurN/FileServerService/internal/service/uploads"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
 )
 
 // FileServerService - интерфейс сервиса для работы с документами
 type DocsService interface {
 	// Документы
-	CreateDocument(ctx context.Context, doc model.Document) (model.Document, error)
+	CreateDocument(ctx context.Context, doc model.Document, commit model.CommitHook) (model.Document, error)
 	GetDocument(ctx context.Context, id string) (model.Document, error)
-	GetListDocuments(ctx context.Context, userID string) ([]model.Document, error)
-	DeleteDocument(ctx context.Context, id string) error
+	GetListDocuments(ctx context.Context, userID string, query model.DocumentQuery) (model.DocumentPage, error)
+	DeleteDocument(ctx context.Context, id, userID string) error
+	PurgeDocument(ctx context.Context, id string) ([]string, error)
+	UpdateDocument(ctx context.Context, id, userID string, update model.DocumentUpdate) (model.Document, error)
 
 	// Получение документов для пользователя
-	GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string) ([]model.Document, error)
+	GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string, query model.DocumentQuery) (model.DocumentPage, error)
 	// Проверка прав доступа к документу
 	HasAccessToDocument(ctx context.Context, userID, documentID string) (bool, error)
+	AuthorizeDocumentChange(ctx context.Context, userID, documentID string) error
+
+	// Версии документов
+	CreateDocumentVersion(ctx context.Context, version model.DocumentVersion, commit model.CommitHook) (model.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, documentID string, version int) (model.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, documentID string) ([]model.DocumentVersion, error)
+	GetVersionsToSize(ctx context.Context, limit int) ([]model.DocumentVersion, error)
+	SetVersionSize(ctx context.Context, version model.DocumentVersion, size int64) error
+	RestoreDocumentVersion(ctx context.Context, documentID string, version int, authorID string) (model.DocumentVersion, error)
+
+	// Сверка хранилища и БД
+	GetStoredFiles(ctx context.Context) ([]model.StoredFile, error)
+	DeleteStorageObject(ctx context.Context, key string, remove model.CommitHook) (bool, error)
+	DeleteReleasedObjects(ctx context.Context, keys []string, remove func(ctx context.Context, key string) error) int
+	ReconcileBlobReferences(ctx context.Context, repair bool) (int, error)
+
+	// Полнотекстовый поиск
+	SearchDocuments(ctx context.Context, userID string, search model.DocumentSearch) (model.SearchPage, error)
+	GetDocumentsToIndex(ctx context.Context, limit int) ([]model.Document, error)
+	SetDocumentText(ctx context.Context, id string, version int, text string) error
+
+	// Папки
+	CreateFolder(ctx context.Context, userID string, folder model.Folder) (model.Folder, error)
+	GetFolder(ctx context.Context, userID, id string) (model.Folder, error)
+	ListFolder(ctx context.Context, userID, parentID string, query model.DocumentQuery) (model.FolderListing, error)
+	UpdateFolder(ctx context.Context, userID, id string, update model.FolderUpdate) (model.Folder, error)
+	DeleteFolder(ctx context.Context, userID, id string, recursive bool) (model.FolderDeletion, error)
+
+	// Теги
+	AddDocumentTags(ctx context.Context, id, userID string, tags []string) (model.Document, error)
+	RemoveDocumentTag(ctx context.Context, id, userID, tag string) (model.Document, error)
+	ListTags(ctx context.Context, userID string) ([]model.Tag, error)
+
+	// Корзина
+	ListTrash(ctx context.Context, userID string) ([]model.Document, error)
+	RestoreDocument(ctx context.Context, id, userID string) (model.Document, error)
+	EmptyTrash(ctx context.Context, userID string) (model.TrashPurge, error)
+	PurgeExpiredTrash(ctx context.Context, before time.Time, limit int) (model.TrashPurge, error)
+
+	// Публичные ссылки
+	CreateShareLink(ctx context.Context, documentID, userID string, spec model.ShareLinkSpec) (model.ShareLink, error)
+	ListShareLinks(ctx context.Context, documentID, userID string) ([]model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, documentID, linkID, userID string) (model.ShareLink, error)
+	OpenSharedDocument(ctx context.Context, slug, password string, client model.ClientInfo) (model.SharedDocument, error)
+	RecordShareDownload(ctx context.Context, link model.ShareLink) (model.ShareLink, error)
+}
+
+// UploadsService - интерфейс сервиса возобновляемых загрузок
+type UploadsService interface {
+	CreateUploadSession(ctx context.Context, session model.UploadSession) (model.UploadSession, error)
+	GetUploadSession(ctx context.Context, id, userID string) (model.UploadSession, error)
+	GetExpiredUploadSessions(ctx context.Context) ([]model.UploadSession, error)
+	UploadChunkLimit(session model.UploadSession) int64
+	AdvanceUploadSession(ctx context.Context, session model.UploadSession, from, written int64, chunkKey string) (model.UploadSession, error)
+	DeleteUploadSession(ctx context.Context, id string) error
 }
 
 // AuthService - интерфейс сервиса авторизации
 type AuthService interface {
 	// Регистрация и аутентификация
-	RegisterUser(ctx context.Context, adminToken, login, password string) (model.User, error)
-	AuthenticateUser(ctx context.Context, login, password string) (string, error)
+	RegisterUser(ctx context.Context, adminID, login, password string, role model.Role) (model.User, error)
+	BootstrapAdmin(ctx context.Context, login, password string) (model.User, error)
+	AuthenticateUser(ctx context.Context, login, password string, client model.ClientInfo) (model.LoginResult, error)
 	ValidateToken(ctx context.Context, tokenValue string) (model.User, error)
 	LogoutUser(ctx context.Context, tokenValue string) error
 
 	// Управление токенами
-	RefreshToken(ctx context.Context, oldToken string) (string, error)
+	RefreshToken(ctx context.Context, oldToken string, client model.ClientInfo) (string, error)
 	GetUserByToken(ctx context.Context, tokenValue string) (model.User, error)
+	SyncRevokedTokens(ctx context.Context) error
+
+	// Сессии пользователя
+	ListSessions(ctx context.Context, userID, currentToken string) ([]model.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	LogoutEverywhere(ctx context.Context, userID string) (int, error)
+
+	// Смена и сброс пароля
+	ChangePassword(ctx context.Context, userID, currentToken, currentPassword, newPassword string, client model.ClientInfo) (int, error)
+	RequestPasswordReset(ctx context.Context, login string) error
+	ConfirmPasswordReset(ctx context.Context, resetToken, newPassword string) (int, error)
+
+	// API-ключи
+	CreateAPIKey(ctx context.Context, userID string, spec model.APIKey) (model.NewAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID string) error
+
+	// Двухфакторная аутентификация
+	EnrollTOTP(ctx context.Context, userID string) (model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID, code string) error
+	VerifySecondFactor(ctx context.Context, challengeToken, code string, client model.ClientInfo) (string, error)
+
+	// Вход через OpenID Connect
+	StartOIDCLogin(ctx context.Context) (string, error)
+	CompleteOIDCLogin(ctx context.Context, code, state string, client model.ClientInfo) (model.LoginResult, error)
+
+	// Управление пользователями (администратор)
+	ListUsers(ctx context.Context, adminID string, filter model.UserFilter) (model.UserPage, error)
+	UpdateUser(ctx context.Context, adminID, userID string, update model.UserUpdate) (model.User, error)
+	ResetUserPassword(ctx context.Context, adminID, userID string) (model.PasswordReset, error)
+	UnlockUser(ctx context.Context, adminID, userID string) (model.User, error)
+	DeleteUser(ctx context.Context, adminID, userID, transferTo string) (model.UserDeletion, error)
 
 	// Получение пользователя по логину
 	GetUserByLogin(ctx context.Context, login string) (model.User, error)
 }
 
 type compositeService struct {
-	authService AuthService
-	docsService DocsService
+	authService    AuthService
+	docsService    DocsService
+	uploadsService UploadsService
 }
 
-func NewCompositeService(repo repository.FileServerRepository, cfg *config.Config, cacheManager *cache.CacheManager) FileServerService {
-	return &compositeService{
-		authService: auth.NewService(repo, cfg),
-		docsService: docs.NewService(repo, cacheManager),
+func NewCompositeService(repo repository.FileServerRepository, cfg *config.Config, cacheManager *cache.CacheManager) (FileServerService, error) {
+	// Одна политика доступа на все сервисы: требование 2FA для ролей действует везде одинаково
+	accessManager, err := validate.NewAccessManager(cfg.Auth.TwoFactor.RequiredRoles)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure access policy: %w", err)
+	}
+
+	authService, err := auth.NewService(repo, cfg, cacheManager, accessManager)
+	if err != nil {
+		return nil, err
 	}
+
+	// Сессии загрузки проверяют будущий документ теми же правилами, что и сервис документов
+	docsService := docs.NewService(repo, cacheManager, accessManager, cfg.Share)
+
+	return &compositeService{
+		authService:    authService,
+		docsService:    docsService,
+		uploadsService: uploads.NewService(repo, cfg.Upload, docsService),
+	}, nil
 }
 
 // Методы для работы с документами (делегируем в docsService)
-func (s *compositeService) CreateDocument(ctx context.Context, doc model.Document) (model.Document, error) {
-	return s.docsService.CreateDocument(ctx, doc)
+func (s *compositeService) CreateDocument(ctx context.Context, doc model.Document, commit model.CommitHook) (model.Document, error) {
+	return s.docsService.CreateDocument(ctx, doc, commit)
 }
 
 func (s *compositeService) GetDocument(ctx context.Context, id string) (model.Document, error) {
 	return s.docsService.GetDocument(ctx, id)
 }
 
-func (s *compositeService) GetListDocuments(ctx context.Context, userID string) ([]model.Document, error) {
-	return s.docsService.GetListDocuments(ctx, userID)
+func (s *compositeService) GetListDocuments(ctx context.Context, userID string, query model.DocumentQuery) (model.DocumentPage, error) {
+	return s.docsService.GetListDocuments(ctx, userID, query)
+}
+
+func (s *compositeService) DeleteDocument(ctx context.Context, id, userID string) error {
+	return s.docsService.DeleteDocument(ctx, id, userID)
 }
 
-func (s *compositeService) DeleteDocument(ctx context.Context, id string) error {
-	return s.docsService.DeleteDocument(ctx, id)
+func (s *compositeService) PurgeDocument(ctx context.Context, id string) ([]string, error) {
+	return s.docsService.PurgeDocument(ctx, id)
 }
 
-func (s *compositeService) GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string) ([]model.Document, error) {
-	return s.docsService.GetDocumentsForUser(ctx, requestUserID, targetUserID)
+func (s *compositeService) UpdateDocument(ctx context.Context, id, userID string, update model.DocumentUpdate) (model.Document, error) {
+	return s.docsService.UpdateDocument(ctx, id, userID, update)
+}
+
+func (s *compositeService) GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string, query model.DocumentQuery) (model.DocumentPage, error) {
+	return s.docsService.GetDocumentsForUser(ctx, requestUserID, targetUserID, query)
 }
 
 func (s *compositeService) HasAccessToDocument(ctx context.Context, userID, documentID string) (bool, error) {
 	return s.docsService.HasAccessToDocument(ctx, userID, documentID)
 }
 
+func (s *compositeService) AuthorizeDocumentChange(ctx context.Context, userID, documentID string) error {
+	return s.docsService.AuthorizeDocumentChange(ctx, userID, documentID)
+}
+
+func (s *compositeService) CreateDocumentVersion(ctx context.Context, version model.DocumentVersion, commit model.CommitHook) (model.DocumentVersion, error) {
+	return s.docsService.CreateDocumentVersion(ctx, version, commit)
+}
+
+func (s *compositeService) GetDocumentVersion(ctx context.Context, documentID string, version int) (model.DocumentVersion, error) {
+	return s.docsService.GetDocumentVersion(ctx, documentID, version)
+}
+
+func (s *compositeService) GetDocumentVersions(ctx context.Context, documentID string) ([]model.DocumentVersion, error) {
+	return s.docsService.GetDocumentVersions(ctx, documentID)
+}
+
+func (s *compositeService) GetVersionsToSize(ctx context.Context, limit int) ([]model.DocumentVersion, error) {
+	return s.docsService.GetVersionsToSize(ctx, limit)
+}
+
+func (s *compositeService) SetVersionSize(ctx context.Context, version model.DocumentVersion, size int64) error {
+	return s.docsService.SetVersionSize(ctx, version, size)
+}
+
+func (s *compositeService) RestoreDocumentVersion(ctx context.Context, documentID string, version int, authorID string) (model.DocumentVersion, error) {
+	return s.docsService.RestoreDocumentVersion(ctx, documentID, version, authorID)
+}
+
+func (s *compositeService) GetStoredFiles(ctx context.Context) ([]model.StoredFile, error) {
+	return s.docsService.GetStoredFiles(ctx)
+}
+
+func (s *compositeService) DeleteStorageObject(ctx context.Context, key string, remove model.CommitHook) (bool, error) {
+	return s.docsService.DeleteStorageObject(ctx, key, remove)
+}
+
+func (s *compositeService) DeleteReleasedObjects(ctx context.Context, keys []string, remove func(ctx context.Context, key string) error) int {
+	return s.docsService.DeleteReleasedObjects(ctx, keys, remove)
+}
+
+func (s *compositeService) ReconcileBlobReferences(ctx context.Context, repair bool) (int, error) {
+	return s.docsService.ReconcileBlobReferences(ctx, repair)
+}
+
+func (s *compositeService) SearchDocuments(ctx context.Context, userID string, search model.DocumentSearch) (model.SearchPage, error) {
+	return s.docsService.SearchDocuments(ctx, userID, search)
+}
+
+func (s *compositeService) GetDocumentsToIndex(ctx context.Context, limit int) ([]model.Document, error) {
+	return s.docsService.GetDocumentsToIndex(ctx, limit)
+}
+
+func (s *compositeService) SetDocumentText(ctx context.Context, id string, version int, text string) error {
+	return s.docsService.SetDocumentText(ctx, id, version, text)
+}
+
+func (s *compositeService) CreateFolder(ctx context.Context, userID string, folder model.Folder) (model.Folder, error) {
+	return s.docsService.CreateFolder(ctx, userID, folder)
+}
+
+func (s *compositeService) GetFolder(ctx context.Context, userID, id string) (model.Folder, error) {
+	return s.docsService.GetFolder(ctx, userID, id)
+}
+
+func (s *compositeService) ListFolder(ctx context.Context, userID, parentID string, query model.DocumentQuery) (model.FolderListing, error) {
+	return s.docsService.ListFolder(ctx, userID, parentID, query)
+}
+
+func (s *compositeService) UpdateFolder(ctx context.Context, userID, id string, update model.FolderUpdate) (model.Folder, error) {
+	return s.docsService.UpdateFolder(ctx, userID, id, update)
+}
+
+func (s *compositeService) DeleteFolder(ctx context.Context, userID, id string, recursive bool) (model.FolderDeletion, error) {
+	return s.docsService.DeleteFolder(ctx, userID, id, recursive)
+}
+
+func (s *compositeService) AddDocumentTags(ctx context.Context, id, userID string, tags []string) (model.Document, error) {
+	return s.docsService.AddDocumentTags(ctx, id, userID, tags)
+}
+
+func (s *compositeService) RemoveDocumentTag(ctx context.Context, id, userID, tag string) (model.Document, error) {
+	return s.docsService.RemoveDocumentTag(ctx, id, userID, tag)
+}
+
+func (s *compositeService) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	return s.docsService.ListTags(ctx, userID)
+}
+
+func (s *compositeService) ListTrash(ctx context.Context, userID string) ([]model.Document, error) {
+	return s.docsService.ListTrash(ctx, userID)
+}
+
+func (s *compositeService) RestoreDocument(ctx context.Context, id, userID string) (model.Document, error) {
+	return s.docsService.RestoreDocument(ctx, id, userID)
+}
+
+func (s *compositeService) EmptyTrash(ctx context.Context, userID string) (model.TrashPurge, error) {
+	return s.docsService.EmptyTrash(ctx, userID)
+}
+
+func (s *compositeService) PurgeExpiredTrash(ctx context.Context, before time.Time, limit int) (model.TrashPurge, error) {
+	return s.docsService.PurgeExpiredTrash(ctx, before, limit)
+}
+
+func (s *compositeService) CreateShareLink(ctx context.Context, documentID, userID string, spec model.ShareLinkSpec) (model.ShareLink, error) {
+	return s.docsService.CreateShareLink(ctx, documentID, userID, spec)
+}
+
+func (s *compositeService) ListShareLinks(ctx context.Context, documentID, userID string) ([]model.ShareLink, error) {
+	return s.docsService.ListShareLinks(ctx, documentID, userID)
+}
+
+func (s *compositeService) RevokeShareLink(ctx context.Context, documentID, linkID, userID string) (model.ShareLink, error) {
+	return s.docsService.RevokeShareLink(ctx, documentID, linkID, userID)
+}
+
+func (s *compositeService) OpenSharedDocument(ctx context.Context, slug, password string, client model.ClientInfo) (model.SharedDocument, error) {
+	return s.docsService.OpenSharedDocument(ctx, slug, password, client)
+}
+
+func (s *compositeService) RecordShareDownload(ctx context.Context, link model.ShareLink) (model.ShareLink, error) {
+	return s.docsService.RecordShareDownload(ctx, link)
+}
+
 // Методы для работы с аутентификацией (делегируем в authService)
-func (s *compositeService) RegisterUser(ctx context.Context, adminToken, login, password string) (model.User, error) {
-	return s.authService.RegisterUser(ctx, adminToken, login, password)
+func (s *compositeService) RegisterUser(ctx context.Context, adminID, login, password string, role model.Role) (model.User, error) {
+	return s.authService.RegisterUser(ctx, adminID, login, password, role)
+}
+
+func (s *compositeService) BootstrapAdmin(ctx context.Context, login, password string) (model.User, error) {
+	return s.authService.BootstrapAdmin(ctx, login, password)
 }
 
-func (s *compositeService) AuthenticateUser(ctx context.Context, login, password string) (string, error) {
-	return s.authService.AuthenticateUser(ctx, login, password)
+func (s *compositeService) AuthenticateUser(ctx context.Context, login, password string, client model.ClientInfo) (model.LoginResult, error) {
+	return s.authService.AuthenticateUser(ctx, login, password, client)
 }
 
 func (s *compositeService) ValidateToken(ctx context.Context, tokenValue string) (model.User, error) {
@@ -95,14 +342,123 @@ func (s *compositeService) LogoutUser(ctx context.Context, tokenValue string) er
 	return s.authService.LogoutUser(ctx, tokenValue)
 }
 
-func (s *compositeService) RefreshToken(ctx context.Context, oldToken string) (string, error) {
-	return s.authService.RefreshToken(ctx, oldToken)
+func (s *compositeService) RefreshToken(ctx context.Context, oldToken string, client model.ClientInfo) (string, error) {
+	return s.authService.RefreshToken(ctx, oldToken, client)
 }
 
 func (s *compositeService) GetUserByToken(ctx context.Context, tokenValue string) (model.User, error) {
 	return s.authService.GetUserByToken(ctx, tokenValue)
 }
 
+func (s *compositeService) SyncRevokedTokens(ctx context.Context) error {
+	return s.authService.SyncRevokedTokens(ctx)
+}
+
+func (s *compositeService) ListSessions(ctx context.Context, userID, currentToken string) ([]model.Session, error) {
+	return s.authService.ListSessions(ctx, userID, currentToken)
+}
+
+func (s *compositeService) RevokeSession(ctx context.Context, userID, sessionID string) error {
+	return s.authService.RevokeSession(ctx, userID, sessionID)
+}
+
+func (s *compositeService) LogoutEverywhere(ctx context.Context, userID string) (int, error) {
+	return s.authService.LogoutEverywhere(ctx, userID)
+}
+
+func (s *compositeService) ChangePassword(ctx context.Context, userID, currentToken, currentPassword, newPassword string, client model.ClientInfo) (int, error) {
+	return s.authService.ChangePassword(ctx, userID, currentToken, currentPassword, newPassword, client)
+}
+
+func (s *compositeService) RequestPasswordReset(ctx context.Context, login string) error {
+	return s.authService.RequestPasswordReset(ctx, login)
+}
+
+func (s *compositeService) ConfirmPasswordReset(ctx context.Context, resetToken, newPassword string) (int, error) {
+	return s.authService.ConfirmPasswordReset(ctx, resetToken, newPassword)
+}
+
+func (s *compositeService) CreateAPIKey(ctx context.Context, userID string, spec model.APIKey) (model.NewAPIKey, error) {
+	return s.authService.CreateAPIKey(ctx, userID, spec)
+}
+
+func (s *compositeService) ListAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error) {
+	return s.authService.ListAPIKeys(ctx, userID)
+}
+
+func (s *compositeService) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	return s.authService.RevokeAPIKey(ctx, userID, keyID)
+}
+
+func (s *compositeService) EnrollTOTP(ctx context.Context, userID string) (model.TOTPEnrollment, error) {
+	return s.authService.EnrollTOTP(ctx, userID)
+}
+
+func (s *compositeService) ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error) {
+	return s.authService.ConfirmTOTP(ctx, userID, code)
+}
+
+func (s *compositeService) DisableTOTP(ctx context.Context, userID, code string) error {
+	return s.authService.DisableTOTP(ctx, userID, code)
+}
+
+func (s *compositeService) VerifySecondFactor(ctx context.Context, challengeToken, code string, client model.ClientInfo) (string, error) {
+	return s.authService.VerifySecondFactor(ctx, challengeToken, code, client)
+}
+
+func (s *compositeService) StartOIDCLogin(ctx context.Context) (string, error) {
+	return s.authService.StartOIDCLogin(ctx)
+}
+
+func (s *compositeService) CompleteOIDCLogin(ctx context.Context, code, state string, client model.ClientInfo) (model.LoginResult, error) {
+	return s.authService.CompleteOIDCLogin(ctx, code, state, client)
+}
+
+func (s *compositeService) ListUsers(ctx context.Context, adminID string, filter model.UserFilter) (model.UserPage, error) {
+	return s.authService.ListUsers(ctx, adminID, filter)
+}
+
+func (s *compositeService) UpdateUser(ctx context.Context, adminID, userID string, update model.UserUpdate) (model.User, error) {
+	return s.authService.UpdateUser(ctx, adminID, userID, update)
+}
+
+func (s *compositeService) ResetUserPassword(ctx context.Context, adminID, userID string) (model.PasswordReset, error) {
+	return s.authService.ResetUserPassword(ctx, adminID, userID)
+}
+
+func (s *compositeService) UnlockUser(ctx context.Context, adminID, userID string) (model.User, error) {
+	return s.authService.UnlockUser(ctx, adminID, userID)
+}
+
+func (s *compositeService) DeleteUser(ctx context.Context, adminID, userID, transferTo string) (model.UserDeletion, error) {
+	return s.authService.DeleteUser(ctx, adminID, userID, transferTo)
+}
+
 func (s *compositeService) GetUserByLogin(ctx context.Context, login string) (model.User, error) {
 	return s.authService.GetUserByLogin(ctx, login)
 }
+
+// Методы для работы с загрузками (делегируем в uploadsService)
+func (s *compositeService) CreateUploadSession(ctx context.Context, session model.UploadSession) (model.UploadSession, error) {
+	return s.uploadsService.CreateUploadSession(ctx, session)
+}
+
+func (s *compositeService) GetUploadSession(ctx context.Context, id, userID string) (model.UploadSession, error) {
+	return s.uploadsService.GetUploadSession(ctx, id, userID)
+}
+
+func (s *compositeService) GetExpiredUploadSessions(ctx context.Context) ([]model.UploadSession, error) {
+	return s.uploadsService.GetExpiredUploadSessions(ctx)
+}
+
+func (s *compositeService) UploadChunkLimit(session model.UploadSession) int64 {
+	return s.uploadsService.UploadChunkLimit(session)
+}
+
+func (s *compositeService) AdvanceUploadSession(ctx context.Context, session model.UploadSession, from, written int64, chunkKey string) (model.UploadSession, error) {
+	return s.uploadsService.AdvanceUploadSession(ctx, session, from, written, chunkKey)
+}
+
+func (s *compositeService) DeleteUploadSession(ctx context.Context, id string) error {
+	return s.uploadsService.DeleteUploadSession(ctx, id)
+}
@@ -4,52 +4,34 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"strings"
 
 	buisnesModel "github.com/NarthurN/FileServerService/internal/model"
 )
 
 // CreateDocument - создание документа с бизнес-логикой
-func (s *service) CreateDocument(ctx context.Context, doc buisnesModel.Document) (buisnesModel.Document, error) {
+func (s *service) CreateDocument(ctx context.Context, doc buisnesModel.Document, commit buisnesModel.CommitHook) (buisnesModel.Document, error) {
 	log.Printf("ServiceLayer: Начало создания документа %s для пользователя %s", doc.Name, doc.UserID)
 
-	// Бизнес-валидация
+	// Бизнес-валидация: роль, имя, папка, grants и наличие содержимого
+	doc, err := s.CheckNewDocument(ctx, doc)
+	if err != nil {
+		return buisnesModel.Document{}, err
+	}
 	if err := s.validateDocumentForCreation(doc); err != nil {
 		log.Printf("ServiceLayer: Ошибка валидации документа %s: %v", doc.Name, err)
 		return buisnesModel.Document{}, fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Проверяем, что пользователь существует
-	if _, err := s.repo.GetUserByID(ctx, doc.UserID); err != nil {
-		log.Printf("ServiceLayer: Пользователь %s не найден: %v", doc.UserID, err)
-		return buisnesModel.Document{}, fmt.Errorf("user not found: %w", err)
+	if !doc.IsFile {
+		doc.Size = jsonSize(doc.JSONData)
 	}
-
-	// Нормализация данных
-	doc = s.normalizeDocument(doc)
-
-	// Проверяем уникальность имени документа для пользователя
-	existingDocs, err := s.repo.GetListDocuments(ctx, doc.UserID)
-	if err != nil {
-		log.Printf("ServiceLayer: Ошибка получения списка документов: %v", err)
-		return buisnesModel.Document{}, fmt.Errorf("failed to check existing documents: %w", err)
-	}
-
-	for _, existing := range existingDocs {
-		if strings.EqualFold(existing.Name, doc.Name) {
-			log.Printf("ServiceLayer: Документ с именем %s уже существует", doc.Name)
-			return buisnesModel.Document{}, fmt.Errorf("document with name '%s' already exists", doc.Name)
-		}
-	}
-
-	// Валидируем grants (проверяем, что пользователи существуют)
-	if err := s.validateGrants(ctx, doc.Grants); err != nil {
-		log.Printf("ServiceLayer: Ошибка валидации grants: %v", err)
-		return buisnesModel.Document{}, fmt.Errorf("invalid grants: %w", err)
+	if doc.Metadata, err = buisnesModel.NormalizeMetadata(doc.Metadata); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации метаданных документа %s: %v", doc.Name, err)
+		return buisnesModel.Document{}, err
 	}
 
 	// Создаем документ
-	createdDoc, err := s.repo.CreateDocument(ctx, doc)
+	createdDoc, err := s.repo.CreateDocument(ctx, doc, commit)
 	if err != nil {
 		log.Printf("ServiceLayer: Ошибка создания документа в репозитории: %v", err)
 		return buisnesModel.Document{}, fmt.Errorf("failed to create document: %w", err)
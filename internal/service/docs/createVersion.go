@@ -0,0 +1,119 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateDocumentVersion - загрузка нового содержимого существующего документа как новой текущей версии
+func (s *service) CreateDocumentVersion(ctx context.Context, version model.DocumentVersion, commit model.CommitHook) (model.DocumentVersion, error) {
+	log.Printf("ServiceLayer: Создание новой версии документа %s", version.DocumentID)
+
+	if version.DocumentID == "" {
+		return model.DocumentVersion{}, fmt.Errorf("document ID: %w", model.ErrRequired)
+	}
+	if version.AuthorID == "" {
+		return model.DocumentVersion{}, fmt.Errorf("author ID: %w", model.ErrRequired)
+	}
+
+	doc, err := s.repo.GetDocument(ctx, version.DocumentID)
+	if err != nil {
+		log.Printf("ServiceLayer: Документ %s не найден: %v", version.DocumentID, err)
+		return model.DocumentVersion{}, fmt.Errorf("document not found: %w", err)
+	}
+
+	author, err := s.getUser(ctx, version.AuthorID)
+	if err != nil {
+		return model.DocumentVersion{}, err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, author); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может изменять документ %s: %v", version.AuthorID, doc.ID, err)
+		return model.DocumentVersion{}, err
+	}
+
+	if version, err = prepareVersion(doc, version); err != nil {
+		return model.DocumentVersion{}, err
+	}
+
+	created, err := s.repo.CreateDocumentVersion(ctx, version, commit)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка создания версии документа %s: %v", version.DocumentID, err)
+		return model.DocumentVersion{}, fmt.Errorf("failed to create document version: %w", err)
+	}
+	created.AuthorLogin = version.AuthorLogin
+
+	s.invalidateDocumentVersions(ctx, doc)
+
+	log.Printf("ServiceLayer: Версия %d документа %s создана, кэш инвалидирован", created.Version, doc.ID)
+	return created, nil
+}
+
+// RestoreDocumentVersion - восстановление старой версии: ее содержимое становится новой текущей версией
+func (s *service) RestoreDocumentVersion(ctx context.Context, documentID string, version int, authorID string) (model.DocumentVersion, error) {
+	log.Printf("ServiceLayer: Восстановление версии %d документа %s", version, documentID)
+
+	old, err := s.GetDocumentVersion(ctx, documentID, version)
+	if err != nil {
+		return model.DocumentVersion{}, err
+	}
+
+	// Содержимое версий неизменяемо, поэтому восстановленная версия ссылается на тот же объект хранилища
+	restored := model.DocumentVersion{
+		DocumentID: documentID,
+		FilePath:   old.FilePath,
+		MimeType:   old.MimeType,
+		Size:       old.Size,
+		Digest:     old.Digest,
+		JSONData:   old.JSONData,
+		AuthorID:   authorID,
+		CreatedAt:  time.Now().UTC(),
+	}
+
+	author, err := s.repo.GetUserByID(ctx, authorID)
+	if err == nil {
+		restored.AuthorLogin = author.Login
+	}
+
+	return s.CreateDocumentVersion(ctx, restored, nil)
+}
+
+// prepareVersion - проверка и нормализация новой версии документа doc
+func prepareVersion(doc model.Document, version model.DocumentVersion) (model.DocumentVersion, error) {
+	// Тип содержимого документа не меняется между версиями
+	if doc.IsFile && version.FilePath == "" {
+		return model.DocumentVersion{}, fmt.Errorf("file is required for file documents: %w", model.ErrDocumentNoContent)
+	}
+	if !doc.IsFile && version.JSONData == nil {
+		return model.DocumentVersion{}, fmt.Errorf("JSON data is required for non-file documents: %w", model.ErrDocumentNoContent)
+	}
+
+	version.MimeType = strings.ToLower(strings.TrimSpace(version.MimeType))
+	if version.MimeType == "" {
+		version.MimeType = doc.MimeType
+	}
+	if !doc.IsFile {
+		version.Size = jsonSize(version.JSONData)
+	}
+	if version.CreatedAt.IsZero() {
+		version.CreatedAt = time.Now().UTC()
+	}
+	return version, nil
+}
+
+// invalidateDocumentVersions - инвалидация кэша документа после смены текущей версии
+func (s *service) invalidateDocumentVersions(ctx context.Context, doc model.Document) {
+	if err := s.cacheManager.InvalidateDocument(ctx, doc.ID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документа: %v", err)
+	}
+	if err := s.cacheManager.InvalidateDocumentVersions(ctx, doc.ID, false); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша версий документа: %v", err)
+	}
+	if err := s.cacheManager.InvalidateUserDocuments(ctx, doc.UserID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документов пользователя: %v", err)
+	}
+}
@@ -0,0 +1,41 @@
+package docs
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// encodeCursor - непрозрачный курсор, указывающий на документ doc при сортировке sort
+func encodeCursor(sort model.DocumentSort, doc model.Document) string {
+	data, err := json.Marshal(model.DocumentCursor{
+		Sort:      sort.String(),
+		Name:      doc.Name,
+		MimeType:  doc.MimeType,
+		Size:      doc.Size,
+		CreatedAt: doc.CreatedAt,
+		ID:        doc.ID,
+	})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeCursor - разбор курсора. Курсор, выданный для другой сортировки, отклоняется
+func decodeCursor(raw string, sort model.DocumentSort) (*model.DocumentCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, model.NewValidationError("Некорректный курсор", model.ErrInvalidInput)
+	}
+
+	var cursor model.DocumentCursor
+	if err := json.Unmarshal(data, &cursor); err != nil || cursor.ID == "" {
+		return nil, model.NewValidationError("Некорректный курсор", model.ErrInvalidInput)
+	}
+	if cursor.Sort != sort.String() {
+		return nil, model.NewValidationError("Курсор выдан для другой сортировки", model.ErrInvalidInput)
+	}
+	return &cursor, nil
+}
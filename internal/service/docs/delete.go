@@ -6,35 +6,78 @@ import (
 	"log"
 )
 
-// DeleteDocument - удаление документа с проверкой прав
-func (s *service) DeleteDocument(ctx context.Context, id string) error {
-	log.Printf("ServiceLayer: Удаление документа %s", id)
+// DeleteDocument - перемещение документа в корзину с проверкой прав пользователя.
+// Содержимое остается в хранилище до восстановления или окончательного удаления из корзины
+func (s *service) DeleteDocument(ctx context.Context, id, userID string) error {
+	log.Printf("ServiceLayer: Удаление документа %s пользователем %s", id, userID)
 
 	if id == "" {
 		return fmt.Errorf("document ID is required")
 	}
 
-	// Получаем документ для проверки существования
 	doc, err := s.repo.GetDocument(ctx, id)
 	if err != nil {
 		log.Printf("ServiceLayer: Документ %s не найден для удаления: %v", id, err)
 		return fmt.Errorf("document not found: %w", err)
 	}
 
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может удалить документ %s: %v", userID, id, err)
+		return err
+	}
+
+	if _, err := s.repo.TrashDocument(ctx, id); err != nil {
+		log.Printf("ServiceLayer: Ошибка перемещения документа %s в корзину: %v", id, err)
+		return fmt.Errorf("failed to delete document: %w", err)
+	}
+
+	// Документ пропадает из списков, кэша и проверок доступа сразу, а не по истечении TTL
+	s.invalidateRemovedDocument(ctx, id, doc.UserID)
+
+	log.Printf("ServiceLayer: Документ %s (%s) перемещен в корзину", doc.Name, id)
+	return nil
+}
+
+// PurgeDocument - окончательное удаление документа без проверки прав (фоновые задачи и администрирование).
+// Возвращает ключи хранилища, на которые больше не ссылается ни один документ
+func (s *service) PurgeDocument(ctx context.Context, id string) ([]string, error) {
+	if id == "" {
+		return nil, fmt.Errorf("document ID is required")
+	}
+
+	// Получаем документ для проверки существования
+	doc, err := s.repo.GetDocument(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Документ %s не найден для удаления: %v", id, err)
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
 	// Удаляем документ
-	if err := s.repo.DeleteDocument(ctx, id); err != nil {
+	released, err := s.repo.DeleteDocument(ctx, id)
+	if err != nil {
 		log.Printf("ServiceLayer: Ошибка удаления документа %s: %v", id, err)
-		return fmt.Errorf("failed to delete document: %w", err)
+		return nil, fmt.Errorf("failed to delete document: %w", err)
 	}
 
-	// Инвалидируем кэш для документа и пользователя
+	s.invalidateRemovedDocument(ctx, id, doc.UserID)
+
+	log.Printf("ServiceLayer: Документ %s (%s) успешно удален, кэш инвалидирован", doc.Name, id)
+	return released, nil
+}
+
+// invalidateRemovedDocument - инвалидация кэша документа, его версий и списков владельца
+func (s *service) invalidateRemovedDocument(ctx context.Context, id, ownerID string) {
 	if err := s.cacheManager.InvalidateDocument(ctx, id); err != nil {
 		log.Printf("ServiceLayer: Ошибка инвалидации кэша документа: %v", err)
 	}
-	if err := s.cacheManager.InvalidateUserDocuments(ctx, doc.UserID); err != nil {
+	if err := s.cacheManager.InvalidateDocumentVersions(ctx, id, true); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша версий документа: %v", err)
+	}
+	if err := s.cacheManager.InvalidateUserDocuments(ctx, ownerID); err != nil {
 		log.Printf("ServiceLayer: Ошибка инвалидации кэша документов пользователя: %v", err)
 	}
-
-	log.Printf("ServiceLayer: Документ %s (%s) успешно удален, кэш инвалидирован", doc.Name, id)
-	return nil
 }
@@ -0,0 +1,348 @@
+package docs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateFolder - создание папки в корне пользователя или во вложенной папке (folder.ParentID)
+func (s *service) CreateFolder(ctx context.Context, userID string, folder model.Folder) (model.Folder, error) {
+	log.Printf("ServiceLayer: Создание папки %s пользователем %s", folder.Name, userID)
+
+	folder.Name = strings.TrimSpace(folder.Name)
+	folder.ParentID = strings.TrimSpace(folder.ParentID)
+	folder.Grants = normalizeGrants(folder.Grants)
+	if err := validateFolderName(folder.Name); err != nil {
+		return model.Folder{}, err
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Folder{}, err
+	}
+	if err := s.accessManager.CheckCreateDocument(user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s с ролью %s не может создавать папки", user.Login, user.Role)
+		return model.Folder{}, err
+	}
+	folder.UserID = user.ID
+
+	var parent *model.Folder
+	if folder.ParentID != "" {
+		found, err := s.repo.GetFolder(ctx, folder.ParentID)
+		if err != nil {
+			log.Printf("ServiceLayer: Родительская папка %s не найдена: %v", folder.ParentID, err)
+			return model.Folder{}, fmt.Errorf("parent folder not found: %w", err)
+		}
+		if err := s.accessManager.CheckModifyFolder(found, user); err != nil {
+			log.Printf("ServiceLayer: Пользователь %s не может создавать папки в %s: %v", userID, found.ID, err)
+			return model.Folder{}, err
+		}
+		if found.Depth() >= model.MaxFolderDepth {
+			return model.Folder{}, model.NewValidationError(fmt.Sprintf("Превышена вложенность папок (максимум %d)", model.MaxFolderDepth), model.ErrInvalidInput)
+		}
+		// Папка принадлежит владельцу родительской папки, даже если ее создает администратор
+		folder.UserID = found.UserID
+		parent = &found
+	}
+
+	if err := s.validateGrants(ctx, folder.Grants); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации grants: %v", err)
+		return model.Folder{}, fmt.Errorf("invalid grants: %w", err)
+	}
+
+	folder.ID = uuid.New().String()
+	folder.Path = model.FolderPath(parent, folder.ID)
+	folder.CreatedAt = time.Now().UTC()
+
+	created, err := s.repo.CreateFolder(ctx, folder)
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return model.Folder{}, model.NewBusinessError("Папка с именем '"+folder.Name+"' уже существует", model.ErrConflict)
+		}
+		log.Printf("ServiceLayer: Ошибка создания папки: %v", err)
+		return model.Folder{}, fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	created.NamePath = "/" + created.Name
+	if parent != nil {
+		path, err := s.repo.GetFolderPath(ctx, *parent)
+		if err != nil {
+			return model.Folder{}, fmt.Errorf("failed to get folder path: %w", err)
+		}
+		created.NamePath = folderNamePath(path) + created.NamePath
+	}
+
+	log.Printf("ServiceLayer: Папка %s создана с ID %s", created.NamePath, created.ID)
+	return created, nil
+}
+
+// GetFolder - папка с путем из имен, если она доступна пользователю
+func (s *service) GetFolder(ctx context.Context, userID, id string) (model.Folder, error) {
+	if id == "" {
+		return model.Folder{}, fmt.Errorf("folder ID is required")
+	}
+
+	folder, err := s.repo.GetFolder(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Папка %s не найдена: %v", id, err)
+		return model.Folder{}, fmt.Errorf("folder not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Folder{}, err
+	}
+
+	path, err := s.repo.GetFolderPath(ctx, folder)
+	if err != nil {
+		return model.Folder{}, fmt.Errorf("failed to get folder path: %w", err)
+	}
+	if !s.accessManager.CanAccessFolder(path, user) {
+		log.Printf("ServiceLayer: Пользователю %s недоступна папка %s", userID, id)
+		return model.Folder{}, model.ErrAccessDenied
+	}
+
+	folder.NamePath = folderNamePath(path)
+	return folder, nil
+}
+
+// ListFolder - вложенные папки и страница документов папки parentID (пустой - корень пользователя)
+func (s *service) ListFolder(ctx context.Context, userID, parentID string, query model.DocumentQuery) (model.FolderListing, error) {
+	log.Printf("ServiceLayer: Получение содержимого папки %q для пользователя %s", parentID, userID)
+
+	var listing model.FolderListing
+	ownerID := userID
+	folderFilter := model.RootFolder
+	if parentID != "" {
+		folder, err := s.GetFolder(ctx, userID, parentID)
+		if err != nil {
+			return model.FolderListing{}, err
+		}
+		listing.Folder = &folder
+		ownerID = folder.UserID
+		folderFilter = folder.ID
+	}
+
+	folders, err := s.repo.ListFolders(ctx, ownerID, parentID)
+	if err != nil {
+		return model.FolderListing{}, fmt.Errorf("failed to list folders: %w", err)
+	}
+	for i := range folders {
+		if listing.Folder != nil {
+			folders[i].NamePath = listing.Folder.NamePath
+		}
+		folders[i].NamePath += "/" + folders[i].Name
+	}
+	listing.Folders = folders
+
+	// Документы папки видны всем, кому доступна папка: фильтр folder расширяет выборку на документы владельца папки
+	query.Filters = []model.DocumentCondition{{Field: model.FilterFieldFolder, Op: model.FilterEq, Value: folderFilter}}
+	if listing.Docs, err = s.GetListDocuments(ctx, userID, query); err != nil {
+		return model.FolderListing{}, err
+	}
+
+	log.Printf("ServiceLayer: В папке %q найдено папок %d, документов на странице %d", parentID, len(listing.Folders), len(listing.Docs.Docs))
+	return listing, nil
+}
+
+// UpdateFolder - переименование, перенос и изменение доступа к папке. Публичность и grants папки
+// действуют на все ее содержимое, включая вложенные папки
+func (s *service) UpdateFolder(ctx context.Context, userID, id string, update model.FolderUpdate) (model.Folder, error) {
+	log.Printf("ServiceLayer: Изменение папки %s пользователем %s", id, userID)
+
+	if update.IsEmpty() {
+		return model.Folder{}, fmt.Errorf("nothing to update: %w", model.ErrInvalidInput)
+	}
+
+	folder, err := s.repo.GetFolder(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Папка %s не найдена для изменения: %v", id, err)
+		return model.Folder{}, fmt.Errorf("folder not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Folder{}, err
+	}
+	if err := s.accessManager.CheckModifyFolder(folder, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может изменять папку %s: %v", userID, id, err)
+		return model.Folder{}, err
+	}
+
+	changed := folder
+	if update.Name != nil {
+		changed.Name = strings.TrimSpace(*update.Name)
+		if err := validateFolderName(changed.Name); err != nil {
+			return model.Folder{}, err
+		}
+	}
+	if update.IsPublic != nil {
+		changed.IsPublic = *update.IsPublic
+	}
+	if update.Grants != nil {
+		changed.Grants = normalizeGrants(*update.Grants)
+		if err := s.validateGrants(ctx, changed.Grants); err != nil {
+			log.Printf("ServiceLayer: Ошибка валидации grants: %v", err)
+			return model.Folder{}, fmt.Errorf("invalid grants: %w", err)
+		}
+	}
+	if update.ParentID != nil {
+		if changed, err = s.moveFolder(ctx, changed, strings.TrimSpace(*update.ParentID)); err != nil {
+			return model.Folder{}, err
+		}
+	}
+
+	updated, err := s.repo.UpdateFolder(ctx, changed, folder.Path)
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return model.Folder{}, model.NewBusinessError("Папка с именем '"+changed.Name+"' уже существует или папка изменена параллельным запросом", model.ErrConflict)
+		}
+		log.Printf("ServiceLayer: Ошибка изменения папки %s: %v", id, err)
+		return model.Folder{}, fmt.Errorf("failed to update folder: %w", err)
+	}
+
+	// Унаследованный доступ меняется у всего содержимого папки
+	if update.IsPublic != nil || update.Grants != nil || updated.Path != folder.Path {
+		if err := s.cacheManager.InvalidateAccess(ctx); err != nil {
+			log.Printf("ServiceLayer: Ошибка инвалидации кэша прав доступа: %v", err)
+		}
+	}
+
+	path, err := s.repo.GetFolderPath(ctx, updated)
+	if err != nil {
+		return model.Folder{}, fmt.Errorf("failed to get folder path: %w", err)
+	}
+	updated.NamePath = folderNamePath(path)
+
+	log.Printf("ServiceLayer: Папка %s изменена: %s", id, updated.NamePath)
+	return updated, nil
+}
+
+// moveFolder - новый родитель и путь папки с проверкой владельца, циклов и вложенности
+func (s *service) moveFolder(ctx context.Context, folder model.Folder, parentID string) (model.Folder, error) {
+	if parentID == folder.ParentID {
+		return folder, nil
+	}
+
+	var parent *model.Folder
+	depth := 1
+	if parentID != "" {
+		found, err := s.repo.GetFolder(ctx, parentID)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				return model.Folder{}, model.NewValidationError("Папка "+parentID+" не найдена", model.ErrInvalidInput)
+			}
+			return model.Folder{}, fmt.Errorf("failed to get folder: %w", err)
+		}
+		if found.UserID != folder.UserID {
+			return model.Folder{}, model.NewValidationError("Папку можно перенести только в папку ее владельца", model.ErrInvalidInput)
+		}
+		if found.Contains(folder.ID) {
+			return model.Folder{}, model.NewValidationError("Папку нельзя перенести в саму себя или во вложенную папку", model.ErrInvalidInput)
+		}
+		parent = &found
+		depth = found.Depth() + 1
+	}
+
+	treeDepth, err := s.repo.GetFolderTreeDepth(ctx, folder)
+	if err != nil {
+		return model.Folder{}, fmt.Errorf("failed to get folder depth: %w", err)
+	}
+	if depth+treeDepth-folder.Depth() > model.MaxFolderDepth {
+		return model.Folder{}, model.NewValidationError(fmt.Sprintf("Превышена вложенность папок (максимум %d)", model.MaxFolderDepth), model.ErrInvalidInput)
+	}
+
+	folder.ParentID = parentID
+	folder.Path = model.FolderPath(parent, folder.ID)
+	return folder, nil
+}
+
+// DeleteFolder - удаление папки; непустая папка удаляется только с recursive вместе с вложенными папками,
+// а ее документы перемещаются в корзину. Возвращает удаленные папки и перемещенные в корзину документы
+func (s *service) DeleteFolder(ctx context.Context, userID, id string, recursive bool) (model.FolderDeletion, error) {
+	log.Printf("ServiceLayer: Удаление папки %s пользователем %s (рекурсивно: %t)", id, userID, recursive)
+
+	folder, err := s.repo.GetFolder(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Папка %s не найдена для удаления: %v", id, err)
+		return model.FolderDeletion{}, fmt.Errorf("folder not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.FolderDeletion{}, err
+	}
+	if err := s.accessManager.CheckModifyFolder(folder, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может удалить папку %s: %v", userID, id, err)
+		return model.FolderDeletion{}, err
+	}
+
+	result, err := s.repo.DeleteFolder(ctx, folder, recursive)
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return model.FolderDeletion{}, model.NewBusinessError("Папка не пуста, для удаления вместе с содержимым укажите recursive=true", model.ErrConflict)
+		}
+		log.Printf("ServiceLayer: Ошибка удаления папки %s: %v", id, err)
+		return model.FolderDeletion{}, fmt.Errorf("failed to delete folder: %w", err)
+	}
+
+	for _, docID := range result.DeletedDocuments {
+		if err := s.cacheManager.InvalidateDocument(ctx, docID); err != nil {
+			log.Printf("ServiceLayer: Ошибка инвалидации кэша документа: %v", err)
+		}
+		if err := s.cacheManager.InvalidateDocumentVersions(ctx, docID, true); err != nil {
+			log.Printf("ServiceLayer: Ошибка инвалидации кэша версий документа: %v", err)
+		}
+	}
+	if err := s.cacheManager.InvalidateUserDocuments(ctx, folder.UserID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документов пользователя: %v", err)
+	}
+
+	log.Printf("ServiceLayer: Папка %s удалена: папок %d, документов %d", id, len(result.DeletedFolders), len(result.DeletedDocuments))
+	return result, nil
+}
+
+// hasAccessToFolder - пользователю доступна папка и ее содержимое (владение, роль или доступ папки либо ее предков)
+func (s *service) hasAccessToFolder(ctx context.Context, folderID string, user model.User) (bool, error) {
+	folder, err := s.repo.GetFolder(ctx, folderID)
+	if err != nil {
+		return false, fmt.Errorf("folder not found: %w", err)
+	}
+	path, err := s.repo.GetFolderPath(ctx, folder)
+	if err != nil {
+		return false, fmt.Errorf("failed to get folder path: %w", err)
+	}
+	return s.accessManager.CanAccessFolder(path, user), nil
+}
+
+// validateFolderName - имя папки: непустое, не длиннее 255 символов, без разделителей пути
+func validateFolderName(name string) error {
+	switch {
+	case name == "":
+		return model.NewValidationError("Имя папки не может быть пустым", model.ErrInvalidInput)
+	case utf8.RuneCountInString(name) > 255:
+		return model.NewValidationError("Имя папки слишком длинное (максимум 255 символов)", model.ErrInvalidInput)
+	case strings.ContainsAny(name, `/\`), name == ".", name == "..":
+		return model.NewValidationError("Имя папки не может содержать / и \\ или быть . и ..", model.ErrInvalidInput)
+	}
+	return nil
+}
+
+// folderNamePath - путь из имен папок от корня, например "/Отчеты/2024"
+func folderNamePath(path []model.Folder) string {
+	var b strings.Builder
+	for _, folder := range path {
+		b.WriteString("/")
+		b.WriteString(folder.Name)
+	}
+	return b.String()
+}
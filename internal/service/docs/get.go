@@ -40,42 +40,30 @@ func (s *service) GetDocument(ctx context.Context, id string) (model.Document, e
 	return doc, nil
 }
 
-// GetDocumentsForUser - получение документов с учетом прав доступа
-func (s *service) GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string) ([]model.Document, error) {
+// GetDocumentsForUser - страница документов с учетом прав доступа
+func (s *service) GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string, query model.DocumentQuery) (model.DocumentPage, error) {
 	log.Printf("ServiceLayer: Получение документов пользователя %s для пользователя %s", targetUserID, requestUserID)
 
-	// Если запрашивает свои документы
-	if requestUserID == targetUserID {
+	// Если запрашивает свои документы (без фильтров, расширяющих выборку на других владельцев)
+	if requestUserID == targetUserID && !model.HasScopeFilter(query.Filters) {
 		log.Printf("ServiceLayer: Запрос собственных документов")
-		return s.GetListDocuments(ctx, targetUserID)
+		return s.GetListDocuments(ctx, targetUserID, query)
 	}
 
-	// Получаем все документы целевого пользователя
-	log.Printf("ServiceLayer: Получение документов целевого пользователя %s", targetUserID)
-	allDocs, err := s.repo.GetListDocuments(ctx, targetUserID)
-	if err != nil {
-		log.Printf("ServiceLayer: Ошибка получения документов целевого пользователя: %v", err)
-		return nil, fmt.Errorf("failed to get target user documents: %w", err)
-	}
-	log.Printf("ServiceLayer: Найдено %d документов целевого пользователя", len(allDocs))
-
-	// Получаем пользователя-запросчика для проверки его логина
+	// Получаем пользователя-запросчика для проверки его логина и роли
 	log.Printf("ServiceLayer: Получение пользователя-запросчика %s", requestUserID)
 	requestUser, err := s.repo.GetUserByID(ctx, requestUserID)
 	if err != nil {
 		log.Printf("ServiceLayer: Ошибка получения пользователя-запросчика: %v", err)
-		return nil, fmt.Errorf("failed to get request user: %w", err)
+		return model.DocumentPage{}, fmt.Errorf("failed to get request user: %w", err)
 	}
 	log.Printf("ServiceLayer: Пользователь-запросчик найден: %s", requestUser.Login)
 
-	// Фильтруем документы: публичные + те, к которым есть доступ
-	var accessibleDocs []model.Document
-	for _, doc := range allDocs {
-		if s.hasAccessToDocument(doc, requestUser.Login) {
-			accessibleDocs = append(accessibleDocs, doc)
-		}
+	page, err := s.listVisibleDocuments(ctx, requestUser, targetUserID, query)
+	if err != nil {
+		return model.DocumentPage{}, err
 	}
 
-	log.Printf("ServiceLayer: Пользователь %s имеет доступ к %d из %d документов", requestUserID, len(accessibleDocs), len(allDocs))
-	return s.sortDocuments(accessibleDocs), nil
+	log.Printf("ServiceLayer: Пользователю %s доступно %d документов пользователя %s на странице", requestUserID, len(page.Docs), targetUserID)
+	return page, nil
 }
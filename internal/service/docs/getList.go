@@ -4,54 +4,139 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
 )
 
-// GetListDocuments - получение списка документов с сортировкой
-func (s *service) GetListDocuments(ctx context.Context, userID string) ([]model.Document, error) {
+const (
+	defaultDocumentsLimit = 100
+	maxDocumentsLimit     = 1000
+)
+
+// GetListDocuments - страница собственных документов пользователя. Фильтры owner, granted и folder
+// расширяют выборку на документы других владельцев, видимые пользователю
+func (s *service) GetListDocuments(ctx context.Context, userID string, query model.DocumentQuery) (model.DocumentPage, error) {
 	log.Printf("ServiceLayer: Получение списка документов для пользователя %s", userID)
 
 	if userID == "" {
-		return nil, fmt.Errorf("user ID is required")
+		return model.DocumentPage{}, fmt.Errorf("user ID is required")
+	}
+
+	if model.HasScopeFilter(query.Filters) {
+		user, err := s.repo.GetUserByID(ctx, userID)
+		if err != nil {
+			log.Printf("ServiceLayer: Пользователь %s не найден: %v", userID, err)
+			return model.DocumentPage{}, fmt.Errorf("user not found: %w", err)
+		}
+		return s.listVisibleDocuments(ctx, user, "", query)
+	}
+
+	query.OwnerID = userID
+	query.ViewerID = userID
+	if err := s.prepareDocumentQuery(&query); err != nil {
+		return model.DocumentPage{}, err
 	}
 
 	// Пытаемся получить из кэша
-	if cachedDocs, found := s.cacheManager.GetDocumentList(ctx, userID, "", "", 0); found {
-		docs := make([]model.Document, len(cachedDocs))
-		for i, docInterface := range cachedDocs {
-			if doc, ok := docInterface.(model.Document); ok {
-				docs[i] = doc
-			}
+	cacheFilter := documentListFilter(query)
+	if cachedPage, found := s.cacheManager.GetDocumentList(ctx, userID, cacheFilter, query.Limit); found {
+		if page, ok := cachedPage.(model.DocumentPage); ok {
+			log.Printf("ServiceLayer: Страница документов пользователя %s найдена в кэше", userID)
+			return page, nil
 		}
-		log.Printf("ServiceLayer: Список документов для пользователя %s найден в кэше", userID)
-		return docs, nil
 	}
 
 	// Проверяем, что пользователь существует
 	if _, err := s.repo.GetUserByID(ctx, userID); err != nil {
 		log.Printf("ServiceLayer: Пользователь %s не найден: %v", userID, err)
-		return nil, fmt.Errorf("user not found: %w", err)
+		return model.DocumentPage{}, fmt.Errorf("user not found: %w", err)
 	}
 
-	docs, err := s.repo.GetListDocuments(ctx, userID)
+	page, err := s.listDocuments(ctx, query)
 	if err != nil {
-		log.Printf("ServiceLayer: Ошибка получения документов: %v", err)
-		return nil, fmt.Errorf("failed to get documents: %w", err)
+		return model.DocumentPage{}, err
 	}
 
-	// Применяем бизнес-логику сортировки (согласно заданию - по имени и дате создания)
-	sortedDocs := s.sortDocuments(docs)
-
 	// Сохраняем в кэш
-	docsInterface := make([]interface{}, len(sortedDocs))
-	for i, doc := range sortedDocs {
-		docsInterface[i] = doc
-	}
-	if err := s.cacheManager.SetDocumentList(ctx, userID, "", "", 0, docsInterface); err != nil {
+	if err := s.cacheManager.SetDocumentList(ctx, userID, cacheFilter, query.Limit, page); err != nil {
 		log.Printf("ServiceLayer: Ошибка сохранения списка документов в кэш: %v", err)
 	}
 
-	log.Printf("ServiceLayer: Найдено %d документов для пользователя %s и сохранено в кэш", len(sortedDocs), userID)
-	return sortedDocs, nil
+	log.Printf("ServiceLayer: Найдено %d документов для пользователя %s и сохранено в кэш", len(page.Docs), userID)
+	return page, nil
+}
+
+// listVisibleDocuments - страница документов владельца ownerID (пустой - любых владельцев), видимых
+// пользователю: роли с чтением всех документов видят все, остальные - свои, публичные и выданные через grants
+// (в том числе через папку или ее предков)
+func (s *service) listVisibleDocuments(ctx context.Context, user model.User, ownerID string, query model.DocumentQuery) (model.DocumentPage, error) {
+	query.OwnerID = ownerID
+	query.ViewerID = user.ID
+	query.ViewerLogin = user.Login
+	query.ViewAll = s.accessManager.Can(user, validate.PermissionReadAllDocuments)
+	if err := s.prepareDocumentQuery(&query); err != nil {
+		return model.DocumentPage{}, err
+	}
+	return s.listDocuments(ctx, query)
+}
+
+// prepareDocumentQuery - проверка фильтра, сортировки и курсора, ограничение размера страницы
+func (s *service) prepareDocumentQuery(query *model.DocumentQuery) error {
+	if len(query.Filters) > model.MaxDocumentFilters {
+		return model.NewValidationError(fmt.Sprintf("Не более %d условий фильтра", model.MaxDocumentFilters), model.ErrInvalidInput)
+	}
+	if query.Sort.Field == "" {
+		query.Sort.Field = model.DocumentSortName
+	}
+	if query.Limit <= 0 {
+		query.Limit = defaultDocumentsLimit
+	}
+	if query.Limit > maxDocumentsLimit {
+		query.Limit = maxDocumentsLimit
+	}
+
+	query.After = nil
+	if query.Cursor != "" {
+		after, err := decodeCursor(query.Cursor, query.Sort)
+		if err != nil {
+			log.Printf("ServiceLayer: Отклонен курсор списка документов: %v", err)
+			return err
+		}
+		query.After = after
+	}
+	return nil
+}
+
+// listDocuments - выборка страницы из репозитория. Запрашивается на один документ больше,
+// чтобы понять, есть ли следующая страница
+func (s *service) listDocuments(ctx context.Context, query model.DocumentQuery) (model.DocumentPage, error) {
+	limit := query.Limit
+	query.Limit = limit + 1
+
+	docs, total, err := s.repo.ListDocuments(ctx, query)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения документов: %v", err)
+		return model.DocumentPage{}, fmt.Errorf("failed to get documents: %w", err)
+	}
+
+	page := model.DocumentPage{Docs: docs, Limit: limit}
+	if len(docs) > limit {
+		page.Docs = docs[:limit]
+		page.NextCursor = encodeCursor(query.Sort, page.Docs[limit-1])
+	}
+	if query.WithTotal {
+		page.Total = &total
+	}
+	return page, nil
+}
+
+// documentListFilter - часть ключа кэша страницы, зависящая от параметров выборки
+func documentListFilter(query model.DocumentQuery) string {
+	filters := make([]string, 0, len(query.Filters))
+	for _, cond := range query.Filters {
+		filters = append(filters, cond.String())
+	}
+	return fmt.Sprintf("%s|%s|%s|total=%t", query.Sort, strings.Join(filters, "&"), query.Cursor, query.WithTotal)
 }
@@ -0,0 +1,81 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetDocumentVersion - получение конкретной версии документа
+func (s *service) GetDocumentVersion(ctx context.Context, documentID string, version int) (model.DocumentVersion, error) {
+	log.Printf("ServiceLayer: Получение версии %d документа %s", version, documentID)
+
+	if documentID == "" {
+		return model.DocumentVersion{}, fmt.Errorf("document ID is required")
+	}
+	if version < 1 {
+		return model.DocumentVersion{}, fmt.Errorf("invalid version %d: %w", version, model.ErrInvalidInput)
+	}
+
+	// Версии неизменяемы, поэтому кэшируются без инвалидации при загрузке новых
+	if cached, found := s.cacheManager.GetDocumentVersion(ctx, documentID, version); found {
+		if v, ok := cached.(model.DocumentVersion); ok {
+			log.Printf("ServiceLayer: Версия %d документа %s найдена в кэше", version, documentID)
+			return v, nil
+		}
+	}
+
+	v, err := s.repo.GetDocumentVersion(ctx, documentID, version)
+	if err != nil {
+		log.Printf("ServiceLayer: Версия %d документа %s не найдена: %v", version, documentID, err)
+		return model.DocumentVersion{}, fmt.Errorf("document version not found: %w", err)
+	}
+
+	if err := s.cacheManager.SetDocumentVersion(ctx, documentID, version, v); err != nil {
+		log.Printf("ServiceLayer: Ошибка сохранения версии в кэш: %v", err)
+	}
+
+	return v, nil
+}
+
+// GetDocumentVersions - история версий документа, новые первыми
+func (s *service) GetDocumentVersions(ctx context.Context, documentID string) ([]model.DocumentVersion, error) {
+	log.Printf("ServiceLayer: Получение истории версий документа %s", documentID)
+
+	if documentID == "" {
+		return nil, fmt.Errorf("document ID is required")
+	}
+
+	if cached, found := s.cacheManager.GetDocumentVersions(ctx, documentID); found {
+		versions := make([]model.DocumentVersion, 0, len(cached))
+		for _, item := range cached {
+			if v, ok := item.(model.DocumentVersion); ok {
+				versions = append(versions, v)
+			}
+		}
+		log.Printf("ServiceLayer: История версий документа %s найдена в кэше", documentID)
+		return versions, nil
+	}
+
+	if _, err := s.repo.GetDocument(ctx, documentID); err != nil {
+		return nil, fmt.Errorf("document not found: %w", err)
+	}
+
+	versions, err := s.repo.GetDocumentVersions(ctx, documentID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения истории версий: %v", err)
+		return nil, fmt.Errorf("failed to get document versions: %w", err)
+	}
+
+	cached := make([]interface{}, len(versions))
+	for i, v := range versions {
+		cached[i] = v
+	}
+	if err := s.cacheManager.SetDocumentVersions(ctx, documentID, cached); err != nil {
+		log.Printf("ServiceLayer: Ошибка сохранения истории версий в кэш: %v", err)
+	}
+
+	return versions, nil
+}
@@ -18,30 +18,39 @@ func (s *service) HasAccessToDocument(ctx context.Context, userID, documentID st
 		return false, fmt.Errorf("document not found: %w", err)
 	}
 
-	// Владелец всегда имеет доступ
-	if doc.UserID == userID {
-		// Сохраняем в кэш
-		s.cacheManager.SetAccess(ctx, documentID, userID, true)
-		return true, nil
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return false, err
 	}
 
-	// Публичные документы доступны всем
-	if doc.IsPublic {
-		// Сохраняем в кэш
-		s.cacheManager.SetAccess(ctx, documentID, userID, true)
-		return true, nil
-	}
+	// Владелец, публичный документ, grants или роль с чтением всех документов
+	hasAccess := s.accessManager.CanAccessDocument(doc, user)
 
-	// Проверяем grants
-	user, err := s.repo.GetUserByID(ctx, userID)
-	if err != nil {
-		return false, fmt.Errorf("user not found: %w", err)
+	// Доступ, унаследованный от папки документа или ее предков
+	if !hasAccess && doc.FolderID != "" {
+		if hasAccess, err = s.hasAccessToFolder(ctx, doc.FolderID, user); err != nil {
+			return false, err
+		}
 	}
 
-	hasAccess := s.hasAccessToDocument(doc, user.Login)
-
 	// Сохраняем в кэш
 	s.cacheManager.SetAccess(ctx, documentID, userID, hasAccess)
 
 	return hasAccess, nil
 }
+
+// AuthorizeDocumentChange - проверка права изменять документ до приема содержимого новой версии,
+// чтобы не загружать в хранилище файл, который все равно будет отклонен
+func (s *service) AuthorizeDocumentChange(ctx context.Context, userID, documentID string) error {
+	doc, err := s.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return fmt.Errorf("document not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return s.accessManager.CheckModifyDocument(doc, user)
+}
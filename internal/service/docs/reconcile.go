@@ -0,0 +1,65 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetStoredFiles - все ссылки версий документов на объекты хранилища
+func (s *service) GetStoredFiles(ctx context.Context) ([]model.StoredFile, error) {
+	files, err := s.repo.GetStoredFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stored files: %w", err)
+	}
+	return files, nil
+}
+
+// DeleteStorageObject - удаление объекта хранилища через remove, если он не используется ни одним документом.
+// Ключи, освобожденные удалением документов, удаляются только так: между фиксацией удаления строк
+// и удалением объекта то же содержимое могла загрузить другая транзакция
+func (s *service) DeleteStorageObject(ctx context.Context, key string, remove model.CommitHook) (bool, error) {
+	if key == "" {
+		return false, fmt.Errorf("storage key is required: %w", model.ErrInvalidInput)
+	}
+	deleted, err := s.repo.DeleteStorageObject(ctx, key, remove)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete storage object %s: %w", key, err)
+	}
+	return deleted, nil
+}
+
+// DeleteReleasedObjects - удаление объектов, освобожденных удалением документов, через DeleteStorageObject:
+// объект удаляется, только если его не успела переиспользовать новая загрузка. Ошибки по отдельным ключам
+// не прерывают удаление остальных. Возвращает число удаленных объектов
+func (s *service) DeleteReleasedObjects(ctx context.Context, keys []string, remove func(ctx context.Context, key string) error) int {
+	deleted := 0
+	for _, key := range keys {
+		ok, err := s.DeleteStorageObject(ctx, key, func(ctx context.Context) error { return remove(ctx, key) })
+		if err != nil {
+			log.Printf("ServiceLayer: Предупреждение - не удалось удалить объект хранилища %s: %v", key, err)
+			continue
+		}
+		if ok {
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		log.Printf("ServiceLayer: Удалено освобожденных объектов хранилища: %d из %d", deleted, len(keys))
+	}
+	return deleted
+}
+
+// ReconcileBlobReferences - проверка (и при repair - исправление) счетчиков ссылок на содержимое
+func (s *service) ReconcileBlobReferences(ctx context.Context, repair bool) (int, error) {
+	mismatches, err := s.repo.ReconcileBlobReferences(ctx, repair)
+	if err != nil {
+		return 0, fmt.Errorf("failed to reconcile blob references: %w", err)
+	}
+	if mismatches > 0 {
+		log.Printf("ServiceLayer: Расхождений счетчиков ссылок на содержимое: %d (исправлены: %t)", mismatches, repair)
+	}
+	return mismatches, nil
+}
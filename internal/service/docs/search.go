@@ -0,0 +1,81 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+	maxSearchQuery     = 256
+)
+
+// snippetMarkup - замена границ совпадений на разметку после экранирования текста сниппета
+var snippetMarkup = strings.NewReplacer(model.SnippetStart, "<mark>", model.SnippetStop, "</mark>")
+
+// SearchDocuments - полнотекстовый поиск по имени, JSON данным и тексту содержимого документов,
+// видимых пользователю так же, как при HasAccessToDocument
+func (s *service) SearchDocuments(ctx context.Context, userID string, search model.DocumentSearch) (model.SearchPage, error) {
+	search.Query = strings.TrimSpace(search.Query)
+	if search.Query == "" {
+		return model.SearchPage{}, model.NewValidationError("Пустой поисковый запрос", model.ErrInvalidInput)
+	}
+	if utf8.RuneCountInString(search.Query) > maxSearchQuery {
+		return model.SearchPage{}, model.NewValidationError(fmt.Sprintf("Поисковый запрос длиннее %d символов", maxSearchQuery), model.ErrInvalidInput)
+	}
+	if len(search.Filters) > model.MaxDocumentFilters {
+		return model.SearchPage{}, model.NewValidationError(fmt.Sprintf("Не более %d условий фильтра", model.MaxDocumentFilters), model.ErrInvalidInput)
+	}
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+	if search.Offset < 0 {
+		search.Offset = 0
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.SearchPage{}, err
+	}
+	search.ViewerID = user.ID
+	search.ViewerLogin = user.Login
+	search.ViewAll = s.accessManager.Can(user, validate.PermissionReadAllDocuments)
+
+	hits, total, err := s.repo.SearchDocuments(ctx, search)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка поиска документов: %v", err)
+		return model.SearchPage{}, fmt.Errorf("failed to search documents: %w", err)
+	}
+	for i := range hits {
+		hits[i].Snippet = snippetMarkup.Replace(html.EscapeString(hits[i].Snippet))
+	}
+
+	log.Printf("ServiceLayer: По запросу пользователя %s найдено %d документов", userID, total)
+	return model.SearchPage{
+		Hits:   hits,
+		Total:  total,
+		Limit:  search.Limit,
+		Offset: search.Offset,
+	}, nil
+}
+
+// GetDocumentsToIndex - очередь фонового индексатора: документы с непроиндексированной текущей версией
+func (s *service) GetDocumentsToIndex(ctx context.Context, limit int) ([]model.Document, error) {
+	return s.repo.GetDocumentsToIndex(ctx, limit)
+}
+
+// SetDocumentText - сохранение текста, извлеченного из версии документа для поиска
+func (s *service) SetDocumentText(ctx context.Context, id string, version int, text string) error {
+	return s.repo.SetDocumentText(ctx, id, version, text)
+}
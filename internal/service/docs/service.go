@@ -2,145 +2,217 @@ package docs
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/NarthurN/FileServerService/internal/cache"
+	"github.com/NarthurN/FileServerService/internal/config"
 	"github.com/NarthurN/FileServerService/internal/model"
 	"github.com/NarthurN/FileServerService/internal/repository"
+	"github.com/NarthurN/FileServerService/internal/service/validate"
 )
 
 type service struct {
-	repo         repository.FileServerRepository
-	cacheManager *cache.CacheManager
+	repo             repository.FileServerRepository
+	cacheManager     *cache.CacheManager
+	accessManager    *validate.AccessManager
+	shareIPLimiter   *validate.RateLimiter // Частота проверок пароля ссылок с одного IP; nil - без ограничения
+	shareLinkLimiter *validate.RateLimiter // Частота проверок пароля одной ссылки; nil - без ограничения
 }
 
-func NewService(repo repository.FileServerRepository, cacheManager *cache.CacheManager) *service {
-	return &service{
-		repo:         repo,
-		cacheManager: cacheManager,
+func NewService(repo repository.FileServerRepository, cacheManager *cache.CacheManager, accessManager *validate.AccessManager, shareCfg config.ShareConfig) *service {
+	s := &service{
+		repo:          repo,
+		cacheManager:  cacheManager,
+		accessManager: accessManager,
 	}
+
+	if shareCfg.RateWindow > 0 {
+		if shareCfg.RatePerIP > 0 {
+			s.shareIPLimiter = validate.NewRateLimiter(shareCfg.RatePerIP, shareCfg.RateWindow)
+		}
+		if shareCfg.RatePerLink > 0 {
+			s.shareLinkLimiter = validate.NewRateLimiter(shareCfg.RatePerLink, shareCfg.RateWindow)
+		}
+	}
+
+	return s
 }
 
-// Вспомогательные методы с бизнес-логикой
-func (s *service) validateDocumentForCreation(doc model.Document) error {
-	if doc.Name == "" {
-		return fmt.Errorf("document name is required")
+// CheckNewDocument - проверка документа перед созданием: роль владельца, имя, папка, уникальность имени
+// в ней и grants. Содержимое не проверяется, поэтому проверку проходят и будущие документы сессий загрузки.
+// Возвращает нормализованный документ
+func (s *service) CheckNewDocument(ctx context.Context, doc model.Document) (model.Document, error) {
+	doc = s.normalizeDocument(doc)
+	if err := validateDocumentFields(doc); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации документа %s: %v", doc.Name, err)
+		return model.Document{}, fmt.Errorf("validation failed: %w", err)
 	}
 
-	if len(doc.Name) > 255 {
-		return fmt.Errorf("document name too long (max 255 characters)")
+	// Проверяем, что пользователь существует и его роль позволяет создавать документы
+	user, err := s.getUser(ctx, doc.UserID)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if err := s.accessManager.CheckCreateDocument(user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s с ролью %s не может создавать документы", user.Login, user.Role)
+		return model.Document{}, err
 	}
 
-	if doc.UserID == "" {
-		return fmt.Errorf("user ID is required")
+	// Проверяем папку и уникальность имени документа в ней
+	if err := s.checkDocumentFolder(ctx, doc.FolderID, doc.UserID); err != nil {
+		return model.Document{}, err
+	}
+	if err := s.checkNameUnique(ctx, doc.UserID, doc.FolderID, doc.Name, ""); err != nil {
+		return model.Document{}, err
 	}
 
-	if doc.MimeType == "" {
-		return fmt.Errorf("MIME type is required")
+	// Валидируем grants (проверяем, что пользователи существуют)
+	if err := s.validateGrants(ctx, doc.Grants); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации grants: %v", err)
+		return model.Document{}, fmt.Errorf("invalid grants: %w", err)
+	}
+	return doc, nil
+}
+
+// Вспомогательные методы с бизнес-логикой
+func (s *service) validateDocumentForCreation(doc model.Document) error {
+	if err := validateDocumentFields(doc); err != nil {
+		return err
 	}
 
 	// Если это файл, должен быть указан путь к файлу
 	if doc.IsFile && doc.FilePath == "" {
-		return fmt.Errorf("file path is required for file documents")
+		return fmt.Errorf("file path is required for file documents: %w", model.ErrDocumentNoContent)
 	}
 
 	// Если это не файл, должны быть JSON данные
 	if !doc.IsFile && doc.JSONData == nil {
-		return fmt.Errorf("JSON data is required for non-file documents")
+		return fmt.Errorf("JSON data is required for non-file documents: %w", model.ErrDocumentNoContent)
 	}
 
 	return nil
 }
 
+// validateDocumentFields - проверка имени, владельца и MIME-типа документа
+func validateDocumentFields(doc model.Document) error {
+	if doc.Name == "" {
+		return model.ErrDocumentNameEmpty
+	}
+
+	if len(doc.Name) > 255 {
+		return fmt.Errorf("%w (max 255 characters)", model.ErrDocumentNameTooLong)
+	}
+
+	if doc.UserID == "" {
+		return fmt.Errorf("user ID: %w", model.ErrRequired)
+	}
+
+	if doc.MimeType == "" {
+		return fmt.Errorf("MIME type: %w", model.ErrRequired)
+	}
+	return nil
+}
+
 func (s *service) normalizeDocument(doc model.Document) model.Document {
-	// Нормализуем имя файла
+	// Нормализуем имя файла и папку
 	doc.Name = strings.TrimSpace(doc.Name)
+	doc.FolderID = strings.TrimSpace(doc.FolderID)
 
 	// Нормализуем MIME type
 	doc.MimeType = strings.ToLower(strings.TrimSpace(doc.MimeType))
 
-	// Нормализуем grants (убираем дубликаты и пустые значения)
-	normalizedGrants := make([]string, 0, len(doc.Grants))
+	doc.Grants = normalizeGrants(doc.Grants)
+
+	return doc
+}
+
+// normalizeGrants - grants без дубликатов и пустых значений
+func normalizeGrants(grants []string) []string {
+	normalizedGrants := make([]string, 0, len(grants))
 	seenGrants := make(map[string]bool)
 
-	for _, grant := range doc.Grants {
+	for _, grant := range grants {
 		grant = strings.TrimSpace(grant)
 		if grant != "" && !seenGrants[grant] {
 			normalizedGrants = append(normalizedGrants, grant)
 			seenGrants[grant] = true
 		}
 	}
-	doc.Grants = normalizedGrants
-
-	return doc
+	return normalizedGrants
 }
 
-func (s *service) validateGrants(ctx context.Context, grants []string) error {
-	for _, login := range grants {
-		if login == "" {
-			continue // пропускаем пустые
-		}
-
-		// Проверяем, что пользователь с таким логином существует
-		if _, err := s.repo.GetUserByLogin(ctx, login); err != nil {
-			if err == model.ErrNotFound {
-				return fmt.Errorf("user with login '%s' not found", login)
-			}
-			return fmt.Errorf("failed to validate user '%s': %w", login, err)
-		}
+// checkNameUnique - проверка уникальности имени среди документов пользователя в папке
+// (folderID пустой - корень; excludeID - сам изменяемый документ)
+func (s *service) checkNameUnique(ctx context.Context, userID, folderID, name, excludeID string) error {
+	exists, err := s.repo.DocumentNameExists(ctx, userID, folderID, name, excludeID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка проверки имени документа: %v", err)
+		return fmt.Errorf("failed to check existing documents: %w", err)
+	}
+	if exists {
+		log.Printf("ServiceLayer: Документ с именем %s уже существует", name)
+		return fmt.Errorf("%w: '%s'", model.ErrDocumentNameExists, name)
 	}
 	return nil
 }
 
-func (s *service) hasAccessToDocument(doc model.Document, userLogin string) bool {
-	// Публичные документы доступны всем
-	if doc.IsPublic {
-		return true
+// checkDocumentFolder - папка, в которую помещается документ владельца ownerID, существует и принадлежит ему
+func (s *service) checkDocumentFolder(ctx context.Context, folderID, ownerID string) error {
+	if folderID == "" {
+		return nil
 	}
-
-	// Проверяем grants
-	for _, grantedLogin := range doc.Grants {
-		if grantedLogin == userLogin {
-			return true
+	folder, err := s.repo.GetFolder(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.NewValidationError("Папка "+folderID+" не найдена", model.ErrInvalidInput)
 		}
+		return fmt.Errorf("failed to get folder: %w", err)
 	}
-
-	return false
+	if folder.UserID != ownerID {
+		return model.NewValidationError("Документ можно поместить только в папку его владельца", model.ErrInvalidInput)
+	}
+	return nil
 }
 
-func (s *service) sortDocuments(docs []model.Document) []model.Document {
-	// Согласно заданию - сортировка по имени и дате создания
-	// Реализуем простую сортировку
-	log.Printf("ServiceLayer: Сортировка %d документов", len(docs))
-
-	if len(docs) <= 1 {
-		return docs
+// jsonSize - размер JSON данных документа в сериализованном виде
+func jsonSize(data model.JSONData) int64 {
+	if data == nil {
+		return 0
 	}
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return 0
+	}
+	return int64(len(raw))
+}
 
-	// Создаем копию для сортировки
-	sortedDocs := make([]model.Document, len(docs))
-	copy(sortedDocs, docs)
+func (s *service) validateGrants(ctx context.Context, grants []string) error {
+	for _, login := range grants {
+		if login == "" {
+			continue // пропускаем пустые
+		}
 
-	// Простая bubble sort (для production лучше использовать sort.Slice)
-	for i := 0; i < len(sortedDocs)-1; i++ {
-		for j := 0; j < len(sortedDocs)-i-1; j++ {
-			if s.shouldSwapDocuments(sortedDocs[j], sortedDocs[j+1]) {
-				sortedDocs[j], sortedDocs[j+1] = sortedDocs[j+1], sortedDocs[j]
+		// Проверяем, что пользователь с таким логином существует
+		if _, err := s.repo.GetUserByLogin(ctx, login); err != nil {
+			if err == model.ErrNotFound {
+				return fmt.Errorf("%w: user with login '%s' not found", model.ErrDocumentInvalidGrant, login)
 			}
+			return fmt.Errorf("failed to validate user '%s': %w", login, err)
 		}
 	}
-
-	return sortedDocs
+	return nil
 }
 
-func (s *service) shouldSwapDocuments(doc1, doc2 model.Document) bool {
-	// Сначала сортируем по имени
-	if doc1.Name != doc2.Name {
-		return doc1.Name > doc2.Name
+// getUser - пользователь, от имени которого выполняется действие (роль берется из БД, а не из токена)
+func (s *service) getUser(ctx context.Context, userID string) (model.User, error) {
+	user, err := s.repo.GetUserByID(ctx, userID)
+	if err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не найден: %v", userID, err)
+		return model.User{}, fmt.Errorf("user not found: %w", err)
 	}
-
-	// Если имена одинаковые, сортируем по дате создания (новые первыми)
-	return doc1.CreatedAt.Before(doc2.CreatedAt)
+	return user, nil
 }
@@ -0,0 +1,215 @@
+package docs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// shareSlugAttempts - число попыток подобрать свободный slug
+const shareSlugAttempts = 3
+
+// CreateShareLink - создание публичной ссылки на документ. Ссылки создает тот, кто может изменять документ
+func (s *service) CreateShareLink(ctx context.Context, documentID, userID string, spec model.ShareLinkSpec) (model.ShareLink, error) {
+	log.Printf("ServiceLayer: Создание ссылки на документ %s пользователем %s", documentID, userID)
+
+	if _, err := s.getModifiableDocument(ctx, documentID, userID); err != nil {
+		return model.ShareLink{}, err
+	}
+
+	now := time.Now().UTC()
+	if err := validateShareLinkSpec(spec, now); err != nil {
+		return model.ShareLink{}, err
+	}
+
+	link := model.ShareLink{
+		DocumentID:   documentID,
+		UserID:       userID,
+		ExpiresAt:    spec.ExpiresAt,
+		MaxDownloads: spec.MaxDownloads,
+		CreatedAt:    now,
+	}
+	if spec.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(spec.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return model.ShareLink{}, fmt.Errorf("failed to hash share password: %w", err)
+		}
+		link.PasswordHash = string(hash)
+	}
+
+	// Совпадение случайного slug практически невозможно, но уникальность гарантирует только БД
+	for attempt := 0; ; attempt++ {
+		slug, err := generateShareSlug()
+		if err != nil {
+			return model.ShareLink{}, err
+		}
+		link.ID = uuid.New().String()
+		link.Slug = slug
+
+		created, err := s.repo.CreateShareLink(ctx, link)
+		if err == nil {
+			log.Printf("ServiceLayer: Ссылка %s на документ %s создана", created.ID, documentID)
+			return created, nil
+		}
+		if !errors.Is(err, model.ErrConflict) || attempt+1 >= shareSlugAttempts {
+			log.Printf("ServiceLayer: Ошибка создания ссылки на документ %s: %v", documentID, err)
+			return model.ShareLink{}, fmt.Errorf("failed to create share link: %w", err)
+		}
+	}
+}
+
+// ListShareLinks - ссылки на документ, включая отозванные и истекшие
+func (s *service) ListShareLinks(ctx context.Context, documentID, userID string) ([]model.ShareLink, error) {
+	if _, err := s.getModifiableDocument(ctx, documentID, userID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.repo.ListShareLinks(ctx, documentID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения ссылок на документ %s: %v", documentID, err)
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	return links, nil
+}
+
+// RevokeShareLink - отзыв ссылки на документ; по отозванной ссылке документ больше не открывается
+func (s *service) RevokeShareLink(ctx context.Context, documentID, linkID, userID string) (model.ShareLink, error) {
+	log.Printf("ServiceLayer: Отзыв ссылки %s на документ %s пользователем %s", linkID, documentID, userID)
+
+	if _, err := s.getModifiableDocument(ctx, documentID, userID); err != nil {
+		return model.ShareLink{}, err
+	}
+
+	link, err := s.repo.GetShareLink(ctx, linkID)
+	if err != nil {
+		return model.ShareLink{}, fmt.Errorf("share link not found: %w", err)
+	}
+	if link.DocumentID != documentID {
+		return model.ShareLink{}, fmt.Errorf("share link not found: %w", model.ErrNotFound)
+	}
+
+	revoked, err := s.repo.RevokeShareLink(ctx, linkID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка отзыва ссылки %s: %v", linkID, err)
+		return model.ShareLink{}, fmt.Errorf("failed to revoke share link: %w", err)
+	}
+	return revoked, nil
+}
+
+// OpenSharedDocument - документ по публичной ссылке. Неизвестная и отозванная ссылка, документ в корзине
+// и документ, к которому создатель ссылки потерял доступ, неотличимы - model.ErrNotFound.
+// Проверки пароля ограничены по частоте для IP клиента и для ссылки - model.ThrottleError
+func (s *service) OpenSharedDocument(ctx context.Context, slug, password string, client model.ClientInfo) (model.SharedDocument, error) {
+	link, err := s.repo.GetShareLinkBySlug(ctx, slug)
+	if err != nil {
+		return model.SharedDocument{}, fmt.Errorf("share link not found: %w", err)
+	}
+	if link.RevokedAt != nil {
+		return model.SharedDocument{}, fmt.Errorf("share link revoked: %w", model.ErrNotFound)
+	}
+
+	if link.HasPassword() {
+		if err := s.checkSharePasswordRate(link, client); err != nil {
+			return model.SharedDocument{}, err
+		}
+		if password == "" || bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+			log.Printf("ServiceLayer: Неверный пароль ссылки %s", link.ID)
+			return model.SharedDocument{}, model.ErrSharePasswordWrong
+		}
+	}
+	if !link.Active(time.Now().UTC()) {
+		return model.SharedDocument{}, model.ErrShareLinkExpired
+	}
+
+	doc, err := s.GetDocument(ctx, link.DocumentID)
+	if err != nil {
+		return model.SharedDocument{}, err
+	}
+	hasAccess, err := s.HasAccessToDocument(ctx, link.UserID, doc.ID)
+	if err != nil {
+		return model.SharedDocument{}, err
+	}
+	if !hasAccess {
+		log.Printf("ServiceLayer: Создатель ссылки %s больше не имеет доступа к документу %s", link.ID, doc.ID)
+		return model.SharedDocument{}, fmt.Errorf("share link owner lost access: %w", model.ErrNotFound)
+	}
+
+	return model.SharedDocument{Link: link, Document: doc}, nil
+}
+
+// checkSharePasswordRate - ограничение частоты проверок пароля ссылки с одного IP и для одной ссылки (в памяти экземпляра)
+func (s *service) checkSharePasswordRate(link model.ShareLink, client model.ClientInfo) error {
+	if s.shareIPLimiter != nil && client.IPAddress != "" && !s.shareIPLimiter.Allow(client.IPAddress) {
+		log.Printf("ServiceLayer: Превышена частота проверок пароля ссылок с адреса %s", client.IPAddress)
+		return model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: s.shareIPLimiter.RetryAfter(client.IPAddress)}
+	}
+	if s.shareLinkLimiter != nil && !s.shareLinkLimiter.Allow(link.Slug) {
+		log.Printf("ServiceLayer: Превышена частота проверок пароля ссылки %s", link.ID)
+		return model.ThrottleError{Cause: model.ErrTooManyAttempts, RetryAfter: s.shareLinkLimiter.RetryAfter(link.Slug)}
+	}
+	return nil
+}
+
+// RecordShareDownload - учет скачивания по ссылке. Ссылка истекла или лимит исчерпан
+// после открытия - model.ErrShareLinkExpired
+func (s *service) RecordShareDownload(ctx context.Context, link model.ShareLink) (model.ShareLink, error) {
+	recorded, err := s.repo.RecordShareDownload(ctx, link.ID, time.Now().UTC())
+	if err != nil {
+		if errors.Is(err, model.ErrConflict) {
+			return model.ShareLink{}, model.ErrShareLinkExpired
+		}
+		log.Printf("ServiceLayer: Ошибка учета скачивания по ссылке %s: %v", link.ID, err)
+		return model.ShareLink{}, fmt.Errorf("failed to record share download: %w", err)
+	}
+	return recorded, nil
+}
+
+// getModifiableDocument - документ, который пользователь может изменять
+func (s *service) getModifiableDocument(ctx context.Context, documentID, userID string) (model.Document, error) {
+	doc, err := s.repo.GetDocument(ctx, documentID)
+	if err != nil {
+		return model.Document{}, fmt.Errorf("document not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может управлять ссылками на документ %s: %v", userID, documentID, err)
+		return model.Document{}, err
+	}
+	return doc, nil
+}
+
+// validateShareLinkSpec - проверка параметров создаваемой ссылки
+func validateShareLinkSpec(spec model.ShareLinkSpec, now time.Time) error {
+	if len(spec.Password) > model.MaxSharePasswordLength {
+		return model.NewValidationError(fmt.Sprintf("Пароль ссылки длиннее %d байт", model.MaxSharePasswordLength), model.ErrInvalidInput)
+	}
+	if spec.ExpiresAt != nil && !spec.ExpiresAt.After(now) {
+		return model.NewValidationError("Срок действия ссылки должен быть в будущем", model.ErrInvalidInput)
+	}
+	if spec.MaxDownloads != nil && (*spec.MaxDownloads < 1 || *spec.MaxDownloads > model.MaxShareDownloads) {
+		return model.NewValidationError(fmt.Sprintf("Лимит скачиваний должен быть от 1 до %d", model.MaxShareDownloads), model.ErrInvalidInput)
+	}
+	return nil
+}
+
+// generateShareSlug - случайный slug ссылки в base64url
+func generateShareSlug() (string, error) {
+	raw := make([]byte, model.ShareSlugBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate share slug: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
@@ -0,0 +1,38 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetVersionsToSize - очередь фоновой задачи SizeBackfill: версии файлов, перенесенные миграцией 005
+// без настоящего размера
+func (s *service) GetVersionsToSize(ctx context.Context, limit int) ([]model.DocumentVersion, error) {
+	versions, err := s.repo.GetVersionsToSize(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get versions to size: %w", err)
+	}
+	return versions, nil
+}
+
+// SetVersionSize - сохранение размера версии, полученного из хранилища. Версии кэшируются
+// как неизменяемые, поэтому кэш версий документа сбрасывается целиком
+func (s *service) SetVersionSize(ctx context.Context, version model.DocumentVersion, size int64) error {
+	if size < 0 {
+		return fmt.Errorf("invalid size %d: %w", size, model.ErrInvalidInput)
+	}
+	if err := s.repo.SetVersionSize(ctx, version.ID, size); err != nil {
+		return fmt.Errorf("failed to set version size: %w", err)
+	}
+
+	if err := s.cacheManager.InvalidateDocument(ctx, version.DocumentID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документа: %v", err)
+	}
+	if err := s.cacheManager.InvalidateDocumentVersions(ctx, version.DocumentID, true); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша версий документа: %v", err)
+	}
+	return nil
+}
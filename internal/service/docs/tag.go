@@ -0,0 +1,115 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// AddDocumentTags - добавление тегов документу. Теги принадлежат владельцу документа и
+// хранятся в нижнем регистре; у документа не больше model.MaxDocumentTags тегов
+func (s *service) AddDocumentTags(ctx context.Context, id, userID string, tags []string) (model.Document, error) {
+	log.Printf("ServiceLayer: Добавление тегов документу %s пользователем %s", id, userID)
+
+	tags, err := model.NormalizeTags(tags)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if len(tags) == 0 {
+		return model.Document{}, model.NewValidationError("Не указаны теги", model.ErrInvalidInput)
+	}
+
+	doc, err := s.documentForTags(ctx, id, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+
+	added := len(doc.Tags)
+	for _, tag := range tags {
+		if !slices.Contains(doc.Tags, tag) {
+			added++
+		}
+	}
+	if added > model.MaxDocumentTags {
+		return model.Document{}, model.NewValidationError(fmt.Sprintf("У документа может быть не более %d тегов", model.MaxDocumentTags), model.ErrInvalidInput)
+	}
+
+	if err := s.repo.AddDocumentTags(ctx, doc, tags); err != nil {
+		log.Printf("ServiceLayer: Ошибка добавления тегов документу %s: %v", id, err)
+		return model.Document{}, fmt.Errorf("failed to add tags: %w", err)
+	}
+	return s.reloadTaggedDocument(ctx, doc)
+}
+
+// RemoveDocumentTag - снятие тега с документа; тега нет у документа - model.ErrNotFound
+func (s *service) RemoveDocumentTag(ctx context.Context, id, userID, tag string) (model.Document, error) {
+	log.Printf("ServiceLayer: Снятие тега %s с документа %s пользователем %s", tag, id, userID)
+
+	tag, err := model.NormalizeTag(tag)
+	if err != nil {
+		return model.Document{}, err
+	}
+
+	doc, err := s.documentForTags(ctx, id, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+
+	if err := s.repo.RemoveDocumentTag(ctx, doc, tag); err != nil {
+		log.Printf("ServiceLayer: Ошибка снятия тега %s с документа %s: %v", tag, id, err)
+		return model.Document{}, fmt.Errorf("failed to remove tag: %w", err)
+	}
+	return s.reloadTaggedDocument(ctx, doc)
+}
+
+// ListTags - теги пользователя с количеством его документов
+func (s *service) ListTags(ctx context.Context, userID string) ([]model.Tag, error) {
+	log.Printf("ServiceLayer: Получение тегов пользователя %s", userID)
+
+	tags, err := s.repo.ListTags(ctx, userID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения тегов пользователя %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	return tags, nil
+}
+
+// documentForTags - документ, теги которого пользователь может менять (те же права, что на изменение документа)
+func (s *service) documentForTags(ctx context.Context, id, userID string) (model.Document, error) {
+	doc, err := s.repo.GetDocument(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Документ %s не найден: %v", id, err)
+		return model.Document{}, fmt.Errorf("document not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может изменять теги документа %s: %v", userID, id, err)
+		return model.Document{}, err
+	}
+	return doc, nil
+}
+
+// reloadTaggedDocument - документ с новыми тегами; кэш документа и списков владельца инвалидируется,
+// так как теги входят в закэшированные страницы
+func (s *service) reloadTaggedDocument(ctx context.Context, doc model.Document) (model.Document, error) {
+	if err := s.cacheManager.InvalidateDocument(ctx, doc.ID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документа: %v", err)
+	}
+	if err := s.cacheManager.InvalidateUserDocuments(ctx, doc.UserID); err != nil {
+		log.Printf("ServiceLayer: Ошибка инвалидации кэша документов пользователя: %v", err)
+	}
+
+	updated, err := s.repo.GetDocument(ctx, doc.ID)
+	if err != nil {
+		return model.Document{}, fmt.Errorf("failed to reload document: %w", err)
+	}
+	log.Printf("ServiceLayer: Теги документа %s: %v", doc.ID, updated.Tags)
+	return updated, nil
+}
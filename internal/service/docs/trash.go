@@ -0,0 +1,107 @@
+package docs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// ListTrash - документы в корзине пользователя, последние удаленные первыми
+func (s *service) ListTrash(ctx context.Context, userID string) ([]model.Document, error) {
+	docs, err := s.repo.ListTrash(ctx, userID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения корзины пользователя %s: %v", userID, err)
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+	return docs, nil
+}
+
+// RestoreDocument - возврат документа из корзины на прежнее место с проверкой прав пользователя.
+// Если там уже появился документ с тем же именем, восстановление отклоняется
+func (s *service) RestoreDocument(ctx context.Context, id, userID string) (model.Document, error) {
+	log.Printf("ServiceLayer: Восстановление документа %s пользователем %s", id, userID)
+
+	doc, err := s.repo.GetTrashedDocument(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Документ %s не найден в корзине: %v", id, err)
+		return model.Document{}, fmt.Errorf("document not found in trash: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может восстановить документ %s: %v", userID, id, err)
+		return model.Document{}, err
+	}
+
+	if err := s.checkNameUnique(ctx, doc.UserID, doc.FolderID, doc.Name, doc.ID); err != nil {
+		return model.Document{}, err
+	}
+
+	restored, err := s.repo.RestoreDocument(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка восстановления документа %s: %v", id, err)
+		return model.Document{}, fmt.Errorf("failed to restore document: %w", err)
+	}
+
+	s.invalidateRemovedDocument(ctx, id, restored.UserID)
+
+	log.Printf("ServiceLayer: Документ %s (%s) восстановлен из корзины", restored.Name, id)
+	return restored, nil
+}
+
+// EmptyTrash - окончательное удаление всех документов из корзины пользователя
+func (s *service) EmptyTrash(ctx context.Context, userID string) (model.TrashPurge, error) {
+	log.Printf("ServiceLayer: Очистка корзины пользователя %s", userID)
+
+	docs, err := s.repo.ListTrash(ctx, userID)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения корзины пользователя %s: %v", userID, err)
+		return model.TrashPurge{}, fmt.Errorf("failed to list trash: %w", err)
+	}
+
+	result, err := s.purgeTrashed(ctx, docs)
+	if err != nil {
+		return result, err
+	}
+
+	log.Printf("ServiceLayer: Корзина пользователя %s очищена, удалено документов: %d", userID, len(result.PurgedDocuments))
+	return result, nil
+}
+
+// PurgeExpiredTrash - окончательное удаление до limit документов, перемещенных в корзину раньше before
+func (s *service) PurgeExpiredTrash(ctx context.Context, before time.Time, limit int) (model.TrashPurge, error) {
+	docs, err := s.repo.GetExpiredTrash(ctx, before, limit)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка получения документов с истекшим сроком хранения: %v", err)
+		return model.TrashPurge{}, fmt.Errorf("failed to get expired trash: %w", err)
+	}
+	return s.purgeTrashed(ctx, docs)
+}
+
+// purgeTrashed - окончательное удаление документов корзины. Документ, восстановленный
+// после чтения корзины, пропускается; при ошибке возвращается уже удаленное
+func (s *service) purgeTrashed(ctx context.Context, docs []model.Document) (model.TrashPurge, error) {
+	var result model.TrashPurge
+	for _, doc := range docs {
+		released, err := s.repo.DeleteTrashedDocument(ctx, doc.ID)
+		if err != nil {
+			if errors.Is(err, model.ErrNotFound) {
+				continue
+			}
+			log.Printf("ServiceLayer: Ошибка окончательного удаления документа %s: %v", doc.ID, err)
+			return result, fmt.Errorf("failed to purge document: %w", err)
+		}
+
+		s.invalidateRemovedDocument(ctx, doc.ID, doc.UserID)
+		result.PurgedDocuments = append(result.PurgedDocuments, doc.ID)
+		result.Released = append(result.Released, released...)
+	}
+	return result, nil
+}
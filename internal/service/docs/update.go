@@ -0,0 +1,111 @@
+package docs
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// UpdateDocument - частичное обновление свойств, папки, пользовательских метаданных и JSON данных документа владельцем
+func (s *service) UpdateDocument(ctx context.Context, id, userID string, update model.DocumentUpdate) (model.Document, error) {
+	log.Printf("ServiceLayer: Обновление документа %s пользователем %s", id, userID)
+
+	if id == "" {
+		return model.Document{}, fmt.Errorf("document ID: %w", model.ErrRequired)
+	}
+	if update.IsEmpty() {
+		return model.Document{}, fmt.Errorf("nothing to update: %w", model.ErrInvalidInput)
+	}
+
+	doc, err := s.repo.GetDocument(ctx, id)
+	if err != nil {
+		log.Printf("ServiceLayer: Документ %s не найден для обновления: %v", id, err)
+		return model.Document{}, fmt.Errorf("document not found: %w", err)
+	}
+
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return model.Document{}, err
+	}
+	if err := s.accessManager.CheckModifyDocument(doc, user); err != nil {
+		log.Printf("ServiceLayer: Пользователь %s не может изменять документ %s: %v", userID, id, err)
+		return model.Document{}, err
+	}
+
+	if update.JSONData != nil && doc.IsFile {
+		return model.Document{}, fmt.Errorf("JSON data cannot be set on file document: %w", model.ErrInvalidInput)
+	}
+
+	// Применяем изменения поверх текущего состояния и нормализуем так же, как при создании
+	changed := doc
+	if update.Name != nil {
+		changed.Name = *update.Name
+	}
+	if update.IsPublic != nil {
+		changed.IsPublic = *update.IsPublic
+	}
+	if update.Grants != nil {
+		changed.Grants = *update.Grants
+	}
+	if update.FolderID != nil {
+		changed.FolderID = *update.FolderID
+	}
+	if update.Metadata != nil {
+		if changed.Metadata, err = model.NormalizeMetadata(model.MergeMetadata(doc.Metadata, update.Metadata)); err != nil {
+			log.Printf("ServiceLayer: Ошибка валидации метаданных документа %s: %v", id, err)
+			return model.Document{}, err
+		}
+	}
+	changed = s.normalizeDocument(changed)
+
+	if err := s.validateDocumentForCreation(changed); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации документа %s: %v", id, err)
+		return model.Document{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	if update.FolderID != nil && changed.FolderID != doc.FolderID {
+		if err := s.checkDocumentFolder(ctx, changed.FolderID, doc.UserID); err != nil {
+			return model.Document{}, err
+		}
+	}
+	if update.Name != nil || changed.FolderID != doc.FolderID {
+		if err := s.checkNameUnique(ctx, doc.UserID, changed.FolderID, changed.Name, doc.ID); err != nil {
+			return model.Document{}, err
+		}
+	}
+
+	if update.Grants != nil {
+		if err := s.validateGrants(ctx, changed.Grants); err != nil {
+			log.Printf("ServiceLayer: Ошибка валидации grants: %v", err)
+			return model.Document{}, fmt.Errorf("invalid grants: %w", err)
+		}
+	}
+
+	// Новые JSON данные сохраняются как очередная версия, чтобы не терять историю;
+	// свойства и версия сохраняются одной транзакцией
+	var version *model.DocumentVersion
+	if update.JSONData != nil {
+		prepared, err := prepareVersion(doc, model.DocumentVersion{
+			DocumentID: doc.ID,
+			MimeType:   doc.MimeType,
+			JSONData:   update.JSONData,
+			AuthorID:   userID,
+		})
+		if err != nil {
+			return model.Document{}, err
+		}
+		version = &prepared
+	}
+
+	if doc, err = s.repo.UpdateDocument(ctx, changed, version); err != nil {
+		log.Printf("ServiceLayer: Ошибка обновления документа %s в репозитории: %v", id, err)
+		return model.Document{}, fmt.Errorf("failed to update document: %w", err)
+	}
+
+	s.invalidateDocumentVersions(ctx, doc)
+
+	log.Printf("ServiceLayer: Документ %s успешно обновлен, кэш инвалидирован", id)
+	return doc, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/NarthurN/FileServerService/internal/model"
 )
@@ -9,25 +10,115 @@ import (
 // FileServerService - интерфейс сервиса для работы с документами
 type FileServerService interface {
 	// Документы
-	CreateDocument(ctx context.Context, doc model.Document) (model.Document, error)
+	CreateDocument(ctx context.Context, doc model.Document, commit model.CommitHook) (model.Document, error)
 	GetDocument(ctx context.Context, id string) (model.Document, error)
-	GetListDocuments(ctx context.Context, userID string) ([]model.Document, error)
-	DeleteDocument(ctx context.Context, id string) error
+	GetListDocuments(ctx context.Context, userID string, query model.DocumentQuery) (model.DocumentPage, error)
+	DeleteDocument(ctx context.Context, id, userID string) error
+	PurgeDocument(ctx context.Context, id string) ([]string, error)
+	UpdateDocument(ctx context.Context, id, userID string, update model.DocumentUpdate) (model.Document, error)
 
 	// Получение документов для пользователя
-	GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string) ([]model.Document, error)
+	GetDocumentsForUser(ctx context.Context, requestUserID, targetUserID string, query model.DocumentQuery) (model.DocumentPage, error)
 	// Проверка прав доступа к документу
 	HasAccessToDocument(ctx context.Context, userID, documentID string) (bool, error)
+	// Проверка права изменять документ (до приема содержимого новой версии)
+	AuthorizeDocumentChange(ctx context.Context, userID, documentID string) error
+
+	// Версии документов
+	CreateDocumentVersion(ctx context.Context, version model.DocumentVersion, commit model.CommitHook) (model.DocumentVersion, error)
+	GetDocumentVersion(ctx context.Context, documentID string, version int) (model.DocumentVersion, error)
+	GetDocumentVersions(ctx context.Context, documentID string) ([]model.DocumentVersion, error)
+	GetVersionsToSize(ctx context.Context, limit int) ([]model.DocumentVersion, error)
+	SetVersionSize(ctx context.Context, version model.DocumentVersion, size int64) error
+	RestoreDocumentVersion(ctx context.Context, documentID string, version int, authorID string) (model.DocumentVersion, error)
+
+	// Сверка хранилища и БД
+	GetStoredFiles(ctx context.Context) ([]model.StoredFile, error)
+	DeleteStorageObject(ctx context.Context, key string, remove model.CommitHook) (bool, error)
+	DeleteReleasedObjects(ctx context.Context, keys []string, remove func(ctx context.Context, key string) error) int
+	ReconcileBlobReferences(ctx context.Context, repair bool) (int, error)
+
+	// Полнотекстовый поиск
+	SearchDocuments(ctx context.Context, userID string, search model.DocumentSearch) (model.SearchPage, error)
+	GetDocumentsToIndex(ctx context.Context, limit int) ([]model.Document, error)
+	SetDocumentText(ctx context.Context, id string, version int, text string) error
+
+	// Папки
+	CreateFolder(ctx context.Context, userID string, folder model.Folder) (model.Folder, error)
+	GetFolder(ctx context.Context, userID, id string) (model.Folder, error)
+	ListFolder(ctx context.Context, userID, parentID string, query model.DocumentQuery) (model.FolderListing, error)
+	UpdateFolder(ctx context.Context, userID, id string, update model.FolderUpdate) (model.Folder, error)
+	DeleteFolder(ctx context.Context, userID, id string, recursive bool) (model.FolderDeletion, error)
+
+	// Теги
+	AddDocumentTags(ctx context.Context, id, userID string, tags []string) (model.Document, error)
+	RemoveDocumentTag(ctx context.Context, id, userID, tag string) (model.Document, error)
+	ListTags(ctx context.Context, userID string) ([]model.Tag, error)
+
+	// Корзина
+	ListTrash(ctx context.Context, userID string) ([]model.Document, error)
+	RestoreDocument(ctx context.Context, id, userID string) (model.Document, error)
+	EmptyTrash(ctx context.Context, userID string) (model.TrashPurge, error)
+	PurgeExpiredTrash(ctx context.Context, before time.Time, limit int) (model.TrashPurge, error)
+
+	// Публичные ссылки
+	CreateShareLink(ctx context.Context, documentID, userID string, spec model.ShareLinkSpec) (model.ShareLink, error)
+	ListShareLinks(ctx context.Context, documentID, userID string) ([]model.ShareLink, error)
+	RevokeShareLink(ctx context.Context, documentID, linkID, userID string) (model.ShareLink, error)
+	OpenSharedDocument(ctx context.Context, slug, password string, client model.ClientInfo) (model.SharedDocument, error)
+	RecordShareDownload(ctx context.Context, link model.ShareLink) (model.ShareLink, error)
+
+	// Возобновляемые загрузки
+	CreateUploadSession(ctx context.Context, session model.UploadSession) (model.UploadSession, error)
+	GetUploadSession(ctx context.Context, id, userID string) (model.UploadSession, error)
+	GetExpiredUploadSessions(ctx context.Context) ([]model.UploadSession, error)
+	UploadChunkLimit(session model.UploadSession) int64
+	AdvanceUploadSession(ctx context.Context, session model.UploadSession, from, written int64, chunkKey string) (model.UploadSession, error)
+	DeleteUploadSession(ctx context.Context, id string) error
 
 	// Регистрация и аутентификация
-	RegisterUser(ctx context.Context, adminToken, login, password string) (model.User, error)
-	AuthenticateUser(ctx context.Context, login, password string) (string, error)
+	RegisterUser(ctx context.Context, adminID, login, password string, role model.Role) (model.User, error)
+	BootstrapAdmin(ctx context.Context, login, password string) (model.User, error)
+	AuthenticateUser(ctx context.Context, login, password string, client model.ClientInfo) (model.LoginResult, error)
 	ValidateToken(ctx context.Context, tokenValue string) (model.User, error)
 	LogoutUser(ctx context.Context, tokenValue string) error
 
 	// Управление токенами
-	RefreshToken(ctx context.Context, oldToken string) (string, error)
+	RefreshToken(ctx context.Context, oldToken string, client model.ClientInfo) (string, error)
 	GetUserByToken(ctx context.Context, tokenValue string) (model.User, error)
+	SyncRevokedTokens(ctx context.Context) error
+
+	// Сессии пользователя
+	ListSessions(ctx context.Context, userID, currentToken string) ([]model.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	LogoutEverywhere(ctx context.Context, userID string) (int, error)
+
+	// Смена и сброс пароля
+	ChangePassword(ctx context.Context, userID, currentToken, currentPassword, newPassword string, client model.ClientInfo) (int, error)
+	RequestPasswordReset(ctx context.Context, login string) error
+	ConfirmPasswordReset(ctx context.Context, resetToken, newPassword string) (int, error)
+
+	// API-ключи
+	CreateAPIKey(ctx context.Context, userID string, spec model.APIKey) (model.NewAPIKey, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]model.APIKey, error)
+	RevokeAPIKey(ctx context.Context, userID, keyID string) error
+
+	// Двухфакторная аутентификация
+	EnrollTOTP(ctx context.Context, userID string) (model.TOTPEnrollment, error)
+	ConfirmTOTP(ctx context.Context, userID, code string) ([]string, error)
+	DisableTOTP(ctx context.Context, userID, code string) error
+	VerifySecondFactor(ctx context.Context, challengeToken, code string, client model.ClientInfo) (string, error)
+
+	// Вход через OpenID Connect
+	StartOIDCLogin(ctx context.Context) (string, error)
+	CompleteOIDCLogin(ctx context.Context, code, state string, client model.ClientInfo) (model.LoginResult, error)
+
+	// Управление пользователями (администратор)
+	ListUsers(ctx context.Context, adminID string, filter model.UserFilter) (model.UserPage, error)
+	UpdateUser(ctx context.Context, adminID, userID string, update model.UserUpdate) (model.User, error)
+	ResetUserPassword(ctx context.Context, adminID, userID string) (model.PasswordReset, error)
+	UnlockUser(ctx context.Context, adminID, userID string) (model.User, error)
+	DeleteUser(ctx context.Context, adminID, userID, transferTo string) (model.UserDeletion, error)
 
 	// Получение пользователя по логину
 	GetUserByLogin(ctx context.Context, login string) (model.User, error)
@@ -0,0 +1,34 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// AdvanceUploadSession - фиксация принятого фрагмента размером written, сохраненного под ключом chunkKey
+func (s *service) AdvanceUploadSession(ctx context.Context, session model.UploadSession, from, written int64, chunkKey string) (model.UploadSession, error) {
+	log.Printf("ServiceLayer: Фиксация фрагмента загрузки %s: смещение %d, %d байт", session.ID, from, written)
+
+	if written <= 0 {
+		return model.UploadSession{}, fmt.Errorf("empty chunk: %w", model.ErrInvalidInput)
+	}
+	if from+written > session.Size {
+		return model.UploadSession{}, fmt.Errorf("chunk exceeds upload size %d: %w", session.Size, model.ErrInvalidInput)
+	}
+
+	// Каждый принятый фрагмент продлевает жизнь сессии
+	expiresAt := time.Now().UTC().Add(s.config.SessionTTL)
+
+	updated, err := s.repo.AdvanceUploadSession(ctx, session.ID, from, from+written, chunkKey, expiresAt)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка фиксации фрагмента загрузки %s: %v", session.ID, err)
+		return model.UploadSession{}, fmt.Errorf("failed to advance upload: %w", err)
+	}
+
+	log.Printf("ServiceLayer: Загрузка %s: принято %d из %d байт", updated.ID, updated.Offset, updated.Size)
+	return updated, nil
+}
@@ -0,0 +1,43 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// CreateUploadSession - создание сессии возобновляемой загрузки
+func (s *service) CreateUploadSession(ctx context.Context, session model.UploadSession) (model.UploadSession, error) {
+	log.Printf("ServiceLayer: Создание сессии загрузки %s (%d байт) для пользователя %s", session.Name, session.Size, session.UserID)
+
+	if err := s.validateSession(session); err != nil {
+		log.Printf("ServiceLayer: Ошибка валидации сессии загрузки %s: %v", session.Name, err)
+		return model.UploadSession{}, fmt.Errorf("validation failed: %w", err)
+	}
+
+	// Роль пользователя, имя, папка и grants проверяются так же, как при создании документа
+	checked, err := s.checkSessionDocument(ctx, session)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка проверки документа сессии загрузки %s: %v", session.Name, err)
+		return model.UploadSession{}, err
+	}
+	session = checked
+
+	now := time.Now().UTC()
+	session.Offset = 0
+	session.Chunks = model.StringArray{}
+	session.CreatedAt = now
+	session.ExpiresAt = now.Add(s.config.SessionTTL)
+
+	created, err := s.repo.CreateUploadSession(ctx, session)
+	if err != nil {
+		log.Printf("ServiceLayer: Ошибка создания сессии загрузки: %v", err)
+		return model.UploadSession{}, fmt.Errorf("failed to create upload session: %w", err)
+	}
+
+	log.Printf("ServiceLayer: Сессия загрузки %s создана, истекает %s", created.ID, created.ExpiresAt.Format(time.RFC3339))
+	return created, nil
+}
@@ -0,0 +1,23 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// DeleteUploadSession - удаление сессии загрузки (после завершения, отмены или истечения)
+func (s *service) DeleteUploadSession(ctx context.Context, id string) error {
+	log.Printf("ServiceLayer: Удаление сессии загрузки %s", id)
+
+	if id == "" {
+		return fmt.Errorf("upload ID is required")
+	}
+
+	if err := s.repo.DeleteUploadSession(ctx, id); err != nil {
+		log.Printf("ServiceLayer: Ошибка удаления сессии загрузки %s: %v", id, err)
+		return fmt.Errorf("failed to delete upload session: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// GetUploadSession - получение активной сессии загрузки ее владельцем
+func (s *service) GetUploadSession(ctx context.Context, id, userID string) (model.UploadSession, error) {
+	if id == "" {
+		return model.UploadSession{}, fmt.Errorf("upload ID is required")
+	}
+
+	session, err := s.repo.GetUploadSession(ctx, id)
+	if err != nil {
+		return model.UploadSession{}, fmt.Errorf("upload session not found: %w", err)
+	}
+
+	// Истекшая сессия для клиента уже не существует, даже если ее еще не удалила фоновая очистка
+	if session.IsExpired(time.Now().UTC()) {
+		log.Printf("ServiceLayer: Сессия загрузки %s истекла", id)
+		return model.UploadSession{}, fmt.Errorf("upload session expired: %w", model.ErrNotFound)
+	}
+
+	if session.UserID != userID {
+		log.Printf("ServiceLayer: Пользователь %s не является владельцем загрузки %s", userID, id)
+		return model.UploadSession{}, model.ErrOwnershipRequired
+	}
+
+	return session, nil
+}
+
+// GetExpiredUploadSessions - сессии, истекшие к текущему моменту
+func (s *service) GetExpiredUploadSessions(ctx context.Context) ([]model.UploadSession, error) {
+	sessions, err := s.repo.GetExpiredUploadSessions(ctx, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get expired upload sessions: %w", err)
+	}
+	return sessions, nil
+}
+
+// UploadChunkLimit - максимальный размер следующего фрагмента для сессии
+func (s *service) UploadChunkLimit(session model.UploadSession) int64 {
+	limit := session.Size - session.Offset
+	if s.config.MaxChunkSize > 0 && limit > s.config.MaxChunkSize {
+		limit = s.config.MaxChunkSize
+	}
+	return limit
+}
@@ -0,0 +1,64 @@
+package uploads
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/model"
+	"github.com/NarthurN/FileServerService/internal/repository"
+)
+
+// DocumentChecker - проверки будущего документа, общие с созданием документа напрямую
+type DocumentChecker interface {
+	CheckNewDocument(ctx context.Context, doc model.Document) (model.Document, error)
+}
+
+type service struct {
+	repo      repository.FileServerRepository
+	config    config.UploadConfig
+	documents DocumentChecker
+}
+
+func NewService(repo repository.FileServerRepository, cfg config.UploadConfig, documents DocumentChecker) *service {
+	return &service{
+		repo:      repo,
+		config:    cfg,
+		documents: documents,
+	}
+}
+
+// checkSessionDocument - документ, который будет создан из сессии, проверяется заранее,
+// чтобы не принимать файл, который нельзя сохранить. Возвращает сессию с нормализованными полями документа
+func (s *service) checkSessionDocument(ctx context.Context, session model.UploadSession) (model.UploadSession, error) {
+	doc, err := s.documents.CheckNewDocument(ctx, model.Document{
+		UserID:   session.UserID,
+		Name:     session.Name,
+		MimeType: session.MimeType,
+		IsFile:   true,
+		Grants:   session.Grants,
+		FolderID: session.FolderID,
+	})
+	if err != nil {
+		return model.UploadSession{}, err
+	}
+
+	session.Name = doc.Name
+	session.MimeType = doc.MimeType
+	session.Grants = doc.Grants
+	session.FolderID = doc.FolderID
+	return session, nil
+}
+
+// validateSession - проверка размера загрузки
+func (s *service) validateSession(session model.UploadSession) error {
+	if session.Size <= 0 {
+		return fmt.Errorf("upload size must be positive: %w", model.ErrInvalidInput)
+	}
+
+	if s.config.MaxSize > 0 && session.Size > s.config.MaxSize {
+		return fmt.Errorf("upload too large: %d bytes (max %d): %w", session.Size, s.config.MaxSize, model.ErrInvalidInput)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/NarthurN/FileServerService/internal/model"
@@ -106,16 +107,76 @@ func (dnv *DocumentNameValidator) ValidateName(name string) error {
 	return nil
 }
 
-// AccessManager - управление правами доступа
-type AccessManager struct{}
+// Permission - действие, разрешаемое ролью пользователя
+type Permission string
 
-func NewAccessManager() *AccessManager {
-	return &AccessManager{}
+const (
+	PermissionWriteDocuments     Permission = "documents:write"      // Создание и изменение своих документов
+	PermissionReadAllDocuments   Permission = "documents:read_all"   // Чтение любых документов
+	PermissionManageAllDocuments Permission = "documents:manage_all" // Изменение и удаление любых документов
+	PermissionManageUsers        Permission = "users:manage"         // Регистрация пользователей и управление ими
+)
+
+// rolePermissions - разрешения ролей. Чтение своих, публичных и выданных через grants документов доступно всем ролям
+var rolePermissions = map[model.Role]map[Permission]bool{
+	model.RoleAdmin: {
+		PermissionWriteDocuments:     true,
+		PermissionReadAllDocuments:   true,
+		PermissionManageAllDocuments: true,
+		PermissionManageUsers:        true,
+	},
+	model.RoleUser: {
+		PermissionWriteDocuments: true,
+	},
+	model.RoleService: {
+		PermissionWriteDocuments:   true,
+		PermissionReadAllDocuments: true,
+	},
+	model.RoleReadOnly: {},
+}
+
+// AccessManager - политика доступа: решения принимаются по роли пользователя и владению документом
+type AccessManager struct {
+	secondFactorRoles map[model.Role]bool // Роли, разрешения которых действуют только при включенной 2FA
 }
 
-func (am *AccessManager) CanAccessDocument(doc model.Document, userID, userLogin string) bool {
+// NewAccessManager - политика доступа; secondFactorRoles - роли, для которых обязательна 2FA
+func NewAccessManager(secondFactorRoles []string) (*AccessManager, error) {
+	am := &AccessManager{secondFactorRoles: make(map[model.Role]bool, len(secondFactorRoles))}
+	for _, name := range secondFactorRoles {
+		role := model.Role(strings.ToLower(strings.TrimSpace(name)))
+		if !role.Valid() {
+			return nil, fmt.Errorf("unknown role %q in two-factor policy", name)
+		}
+		am.secondFactorRoles[role] = true
+	}
+	return am, nil
+}
+
+// Can - роль пользователя дает разрешение
+func (am *AccessManager) Can(user model.User, permission Permission) bool {
+	if am.SecondFactorRequired(user) && !user.TOTPEnabled {
+		return false
+	}
+	return rolePermissions[user.Role][permission]
+}
+
+// SecondFactorRequired - политика требует 2FA для роли пользователя
+func (am *AccessManager) SecondFactorRequired(user model.User) bool {
+	return am.secondFactorRoles[user.Role]
+}
+
+// denied - ошибка отказа в разрешении роли: без 2FA, если ее отсутствие - причина отказа
+func (am *AccessManager) denied(user model.User, permission Permission) error {
+	if rolePermissions[user.Role][permission] && am.SecondFactorRequired(user) && !user.TOTPEnabled {
+		return model.ErrSecondFactorRequired
+	}
+	return model.ErrAccessDenied
+}
+
+func (am *AccessManager) CanAccessDocument(doc model.Document, user model.User) bool {
 	// Владелец всегда имеет доступ
-	if doc.UserID == userID {
+	if doc.UserID == user.ID {
 		return true
 	}
 
@@ -124,9 +185,13 @@ func (am *AccessManager) CanAccessDocument(doc model.Document, userID, userLogin
 		return true
 	}
 
+	if am.Can(user, PermissionReadAllDocuments) {
+		return true
+	}
+
 	// Проверяем grants
 	for _, grantedLogin := range doc.Grants {
-		if grantedLogin == userLogin {
+		if grantedLogin == user.Login {
 			return true
 		}
 	}
@@ -134,21 +199,82 @@ func (am *AccessManager) CanAccessDocument(doc model.Document, userID, userLogin
 	return false
 }
 
-func (am *AccessManager) CanModifyDocument(doc model.Document, userID string) bool {
-	// Только владелец может изменять документ
-	return doc.UserID == userID
+// CanAccessFolder - доступ к папке и ее содержимому: владелец, роль с чтением всех документов
+// или папка на пути (сама или любой предок) публична либо выдана пользователю через grants.
+// path - папки от корня до проверяемой включительно
+func (am *AccessManager) CanAccessFolder(path []model.Folder, user model.User) bool {
+	if len(path) == 0 {
+		return false
+	}
+	if path[len(path)-1].UserID == user.ID || am.Can(user, PermissionReadAllDocuments) {
+		return true
+	}
+
+	for _, folder := range path {
+		if folder.IsPublic {
+			return true
+		}
+		for _, grantedLogin := range folder.Grants {
+			if grantedLogin == user.Login {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckCreateDocument - nil, если роль пользователя позволяет создавать документы
+func (am *AccessManager) CheckCreateDocument(user model.User) error {
+	if !am.Can(user, PermissionWriteDocuments) {
+		return am.denied(user, PermissionWriteDocuments)
+	}
+	return nil
 }
 
-func (am *AccessManager) CanDeleteDocument(doc model.Document, userID string) bool {
-	// Только владелец может удалять документ
-	return doc.UserID == userID
+// CheckModifyDocument - nil, если пользователь может изменять документ, загружать его версии и удалять его
+func (am *AccessManager) CheckModifyDocument(doc model.Document, user model.User) error {
+	if am.Can(user, PermissionManageAllDocuments) {
+		return nil
+	}
+	if doc.UserID != user.ID {
+		return model.ErrOwnershipRequired
+	}
+	if !am.Can(user, PermissionWriteDocuments) {
+		return am.denied(user, PermissionWriteDocuments)
+	}
+	return nil
 }
 
-// RateLimiter - простой rate limiter для операций
+// CheckModifyFolder - nil, если пользователь может изменять, переносить и удалять папку и создавать в ней документы.
+// Доступ через grants или публичность папки дает только чтение
+func (am *AccessManager) CheckModifyFolder(folder model.Folder, user model.User) error {
+	if am.Can(user, PermissionManageAllDocuments) {
+		return nil
+	}
+	if folder.UserID != user.ID {
+		return model.ErrOwnershipRequired
+	}
+	if !am.Can(user, PermissionWriteDocuments) {
+		return am.denied(user, PermissionWriteDocuments)
+	}
+	return nil
+}
+
+// CheckManageUsers - nil, если пользователь может регистрировать пользователей и управлять ими
+func (am *AccessManager) CheckManageUsers(user model.User) error {
+	if !am.Can(user, PermissionManageUsers) {
+		return am.denied(user, PermissionManageUsers)
+	}
+	return nil
+}
+
+// RateLimiter - ограничение числа операций по ключу в скользящем окне; безопасен для использования из нескольких горутин
 type RateLimiter struct {
+	mu          sync.Mutex
 	attempts    map[string][]time.Time
 	maxAttempts int
 	window      time.Duration
+	lastCleanup time.Time
 }
 
 func NewRateLimiter(maxAttempts int, window time.Duration) *RateLimiter {
@@ -156,21 +282,20 @@ func NewRateLimiter(maxAttempts int, window time.Duration) *RateLimiter {
 		attempts:    make(map[string][]time.Time),
 		maxAttempts: maxAttempts,
 		window:      window,
+		lastCleanup: time.Now(),
 	}
 }
 
+// Allow - учет попытки; false, если лимит по ключу в текущем окне исчерпан (такая попытка не учитывается)
 func (rl *RateLimiter) Allow(key string) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
 	now := time.Now()
+	rl.cleanup(now)
 
 	// Очищаем старые попытки
-	attempts := rl.attempts[key]
-	validAttempts := make([]time.Time, 0, len(attempts))
-
-	for _, attempt := range attempts {
-		if now.Sub(attempt) < rl.window {
-			validAttempts = append(validAttempts, attempt)
-		}
-	}
+	validAttempts := rl.validAttempts(key, now)
 
 	// Проверяем лимит
 	if len(validAttempts) >= rl.maxAttempts {
@@ -185,6 +310,56 @@ func (rl *RateLimiter) Allow(key string) bool {
 	return true
 }
 
+// RetryAfter - через сколько освободится место для попытки по ключу; 0 - попытка возможна сейчас
+func (rl *RateLimiter) RetryAfter(key string) time.Duration {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	validAttempts := rl.validAttempts(key, now)
+	if len(validAttempts) < rl.maxAttempts {
+		return 0
+	}
+	// Место освободится, когда из окна выйдет самая старая из последних maxAttempts попыток
+	oldest := validAttempts[len(validAttempts)-rl.maxAttempts]
+	return oldest.Add(rl.window).Sub(now)
+}
+
+// Reset - забыть попытки по ключу (например, после успешного входа)
+func (rl *RateLimiter) Reset(key string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	delete(rl.attempts, key)
+}
+
+// validAttempts - попытки по ключу, попадающие в окно; вызывается под mu
+func (rl *RateLimiter) validAttempts(key string, now time.Time) []time.Time {
+	attempts := rl.attempts[key]
+	validAttempts := make([]time.Time, 0, len(attempts))
+
+	for _, attempt := range attempts {
+		if now.Sub(attempt) < rl.window {
+			validAttempts = append(validAttempts, attempt)
+		}
+	}
+	return validAttempts
+}
+
+// cleanup - удаление ключей без попыток в окне, не чаще раза за окно; вызывается под mu
+func (rl *RateLimiter) cleanup(now time.Time) {
+	if now.Sub(rl.lastCleanup) < rl.window {
+		return
+	}
+	rl.lastCleanup = now
+
+	for key, attempts := range rl.attempts {
+		if len(attempts) == 0 || now.Sub(attempts[len(attempts)-1]) >= rl.window {
+			delete(rl.attempts, key)
+		}
+	}
+}
+
 // SecurityUtils - утилиты безопасности
 type SecurityUtils struct{}
 
@@ -0,0 +1,20 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// DigestKey - ключ объекта с содержимым, адресуемым по SHA-256 (первые два символа - поддиректория)
+func DigestKey(digest string) string {
+	return "blobs/sha256/" + digest[:2] + "/" + digest
+}
+
+// DigestHeader - значение заголовка Digest (RFC 3230) для SHA-256 в hex
+func DigestHeader(digest string) string {
+	raw, err := hex.DecodeString(digest)
+	if err != nil {
+		return ""
+	}
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(raw)
+}
@@ -0,0 +1,235 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LocalStore - хранилище файлов в локальной директории
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore - создание локального хранилища с корнем в dir
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if dir == "" {
+		dir = filepath.Join("bin", "storage")
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create storage dir %s: %w", dir, err)
+	}
+
+	return &LocalStore{root: dir}, nil
+}
+
+// Root - корневая директория хранилища
+func (s *LocalStore) Root() string {
+	return s.root
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put - запись объекта через временный файл и атомарное переименование
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (BlobInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+	dst, err := s.path(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return BlobInfo{}, fmt.Errorf("failed to create dir for %s: %w", key, err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), tempFilePrefix+"*")
+	if err != nil {
+		return BlobInfo{}, fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if err != nil {
+		tmp.Close()
+		return BlobInfo{}, fmt.Errorf("failed to write %s: %w", key, err)
+	}
+	if size >= 0 && written != size {
+		tmp.Close()
+		return BlobInfo{}, fmt.Errorf("short write for %s: %d of %d bytes", key, written, size)
+	}
+
+	// Содержимое должно оказаться на диске до того, как объект станет видимым под своим ключом
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return BlobInfo{}, fmt.Errorf("failed to sync %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return BlobInfo{}, fmt.Errorf("failed to close temp file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), dst); err != nil {
+		return BlobInfo{}, fmt.Errorf("failed to move %s into place: %w", key, err)
+	}
+	if err := syncDir(filepath.Dir(dst)); err != nil {
+		return BlobInfo{}, fmt.Errorf("failed to sync dir for %s: %w", key, err)
+	}
+
+	return s.Stat(ctx, key)
+}
+
+// Get - открытие объекта на чтение
+func (s *LocalStore) Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+
+	file, err := os.Open(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, BlobInfo{}, notFound(key)
+		}
+		return nil, BlobInfo{}, err
+	}
+
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, BlobInfo{}, err
+	}
+
+	return file, s.info(key, stat), nil
+}
+
+// Stat - метаданные объекта
+func (s *LocalStore) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+
+	stat, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return BlobInfo{}, notFound(key)
+		}
+		return BlobInfo{}, err
+	}
+	if stat.IsDir() {
+		return BlobInfo{}, notFound(key)
+	}
+
+	return s.info(key, stat), nil
+}
+
+// Delete - удаление объекта
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete %s: %w", key, err)
+	}
+	return nil
+}
+
+// Move - атомарное переименование объекта в пределах директории хранилища
+func (s *LocalStore) Move(ctx context.Context, src, dst string) error {
+	from, err := s.path(src)
+	if err != nil {
+		return err
+	}
+	to, err := s.path(dst)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+		return fmt.Errorf("failed to create dir for %s: %w", dst, err)
+	}
+	if err := os.Rename(from, to); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return notFound(src)
+		}
+		return fmt.Errorf("failed to move %s to %s: %w", src, dst, err)
+	}
+	return syncDir(filepath.Dir(to))
+}
+
+// syncDir - сброс на диск записи директории, чтобы переименование пережило сбой питания
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// List - обход директории хранилища с фильтрацией по префиксу ключа. Временные файлы, оставленные
+// сбоем до переименования, возвращаются под своими ключами (см. IsTempKey), как только они старше staleTempAge
+func (s *LocalStore) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	var blobs []BlobInfo
+	staleBefore := time.Now().Add(-staleTempAge)
+
+	err := filepath.WalkDir(s.root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		stat, err := d.Info()
+		if err != nil {
+			return err
+		}
+		// Временный файл идущей записи еще не объект
+		if strings.HasPrefix(d.Name(), tempFilePrefix) && stat.ModTime().After(staleBefore) {
+			return nil
+		}
+		blobs = append(blobs, s.info(key, stat))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list storage dir: %w", err)
+	}
+
+	return blobs, nil
+}
+
+func (s *LocalStore) info(key string, stat fs.FileInfo) BlobInfo {
+	return BlobInfo{
+		Key:         key,
+		Size:        stat.Size(),
+		ContentType: mime.TypeByExtension(filepath.Ext(key)),
+		ModTime:     stat.ModTime().UTC(),
+	}
+}
@@ -0,0 +1,443 @@
+package storage
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+)
+
+// S3Store - хранилище в S3-совместимом объектном сторадже (AWS S3, MinIO и т.п.)
+type S3Store struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	pathStyle bool
+	client    *http.Client
+}
+
+// NewS3Store - создание клиента S3-совместимого хранилища
+func NewS3Store(cfg config.S3Config) (*S3Store, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, fmt.Errorf("s3 credentials are required")
+	}
+
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	u, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3Store{
+		endpoint:  u,
+		region:    region,
+		bucket:    cfg.Bucket,
+		accessKey: cfg.AccessKey,
+		secretKey: cfg.SecretKey,
+		pathStyle: cfg.PathStyle,
+		client:    &http.Client{},
+	}, nil
+}
+
+// objectURL - адрес объекта с учетом path-style/virtual-host адресации
+func (s *S3Store) objectURL(key string, query url.Values) string {
+	var b strings.Builder
+	b.WriteString(s.endpoint.Scheme)
+	b.WriteString("://")
+	if s.pathStyle {
+		b.WriteString(s.endpoint.Host)
+		b.WriteString("/")
+		b.WriteString(uriEncode(s.bucket, true))
+	} else {
+		b.WriteString(s.bucket)
+		b.WriteString(".")
+		b.WriteString(s.endpoint.Host)
+	}
+	b.WriteString("/")
+	b.WriteString(uriEncode(key, false))
+	if len(query) > 0 {
+		b.WriteString("?")
+		b.WriteString(canonicalQuery(query))
+	}
+	return b.String()
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method, key string, query url.Values, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key, query), body)
+	if err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func (s *S3Store) do(req *http.Request) (*http.Response, error) {
+	s.sign(req, time.Now().UTC())
+	return s.client.Do(req)
+}
+
+// Put - загрузка объекта; поток неизвестной длины предварительно сохраняется во временный файл
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (BlobInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+
+	if size < 0 {
+		tmp, err := os.CreateTemp("", "s3-upload-*")
+		if err != nil {
+			return BlobInfo{}, fmt.Errorf("failed to buffer upload: %w", err)
+		}
+		defer os.Remove(tmp.Name())
+		defer tmp.Close()
+
+		if size, err = io.Copy(tmp, r); err != nil {
+			return BlobInfo{}, fmt.Errorf("failed to buffer upload: %w", err)
+		}
+		if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+			return BlobInfo{}, err
+		}
+		r = tmp
+	}
+
+	// Пустое тело без http.NoBody ушло бы chunked без Content-Length, что S3 отклоняет
+	var body io.Reader = http.NoBody
+	if size > 0 {
+		body = io.NopCloser(r)
+	}
+	req, err := s.newRequest(ctx, http.MethodPut, key, nil, body)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return BlobInfo{}, fmt.Errorf("s3 put %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return BlobInfo{}, s.responseError("put", key, resp)
+	}
+
+	return BlobInfo{
+		Key:         key,
+		Size:        size,
+		ContentType: contentType,
+		ModTime:     time.Now().UTC(),
+	}, nil
+}
+
+// Get - потоковое чтение объекта
+func (s *S3Store) Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, BlobInfo{}, fmt.Errorf("s3 get %s: %w", key, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, BlobInfo{}, notFound(key)
+		}
+		return nil, BlobInfo{}, s.responseError("get", key, resp)
+	}
+
+	return resp.Body, infoFromHeader(key, resp), nil
+}
+
+// GetRange - чтение части объекта через заголовок Range
+func (s *S3Store) GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if length < 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	} else {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", offset, offset+length-1))
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 get %s: %w", key, err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		return resp.Body, nil
+	case http.StatusOK:
+		// Сервер проигнорировал Range - пропускаем начало объекта вручную
+		if _, err := io.CopyN(io.Discard, resp.Body, offset); err != nil {
+			resp.Body.Close()
+			return nil, fmt.Errorf("s3 get %s: %w", key, err)
+		}
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, notFound(key)
+	default:
+		defer resp.Body.Close()
+		return nil, s.responseError("get", key, resp)
+	}
+}
+
+// Stat - метаданные объекта через HEAD запрос
+func (s *S3Store) Stat(ctx context.Context, key string) (BlobInfo, error) {
+	key, err := cleanKey(key)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodHead, key, nil, nil)
+	if err != nil {
+		return BlobInfo{}, err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return BlobInfo{}, fmt.Errorf("s3 head %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return infoFromHeader(key, resp), nil
+	case http.StatusNotFound:
+		return BlobInfo{}, notFound(key)
+	default:
+		return BlobInfo{}, s.responseError("head", key, resp)
+	}
+}
+
+// Delete - удаление объекта
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	key, err := cleanKey(key)
+	if err != nil {
+		return err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("s3 delete %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s.responseError("delete", key, resp)
+	}
+	return nil
+}
+
+// Move - перенос объекта копированием на стороне сервера (CopyObject) с последующим удалением исходного
+func (s *S3Store) Move(ctx context.Context, src, dst string) error {
+	src, err := cleanKey(src)
+	if err != nil {
+		return err
+	}
+	dst, err = cleanKey(dst)
+	if err != nil {
+		return err
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, dst, nil, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Amz-Copy-Source", "/"+uriEncode(s.bucket, true)+"/"+uriEncode(src, false))
+
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("s3 copy %s to %s: %w", src, dst, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return notFound(src)
+	case resp.StatusCode != http.StatusOK:
+		return s.responseError("copy", src, resp)
+	}
+
+	// CopyObject может вернуть 200 с ошибкой в теле ответа
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		return fmt.Errorf("s3 copy %s to %s: %w", src, dst, err)
+	}
+	if strings.Contains(string(body), "<Error>") {
+		return fmt.Errorf("s3 copy %s to %s failed: %s", src, dst, strings.TrimSpace(string(body)))
+	}
+
+	return s.Delete(ctx, src)
+}
+
+// listBucketResult - ответ ListObjectsV2
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List - постраничный обход объектов бакета по префиксу (ListObjectsV2)
+func (s *S3Store) List(ctx context.Context, prefix string) ([]BlobInfo, error) {
+	var blobs []BlobInfo
+	token := ""
+
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		if prefix != "" {
+			query.Set("prefix", prefix)
+		}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+
+		req, err := s.newRequest(ctx, http.MethodGet, "", query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := s.do(req)
+		if err != nil {
+			return nil, fmt.Errorf("s3 list: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			err := s.responseError("list", prefix, resp)
+			resp.Body.Close()
+			return nil, err
+		}
+
+		var result listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&result)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("s3 list: failed to decode response: %w", err)
+		}
+
+		for _, obj := range result.Contents {
+			blobs = append(blobs, BlobInfo{
+				Key:     obj.Key,
+				Size:    obj.Size,
+				ModTime: obj.LastModified.UTC(),
+			})
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return blobs, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// EnsureBucket - создание бакета, если он отсутствует (удобно для локального MinIO)
+func (s *S3Store) EnsureBucket(ctx context.Context) error {
+	req, err := s.newRequest(ctx, http.MethodHead, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req)
+	if err != nil {
+		return fmt.Errorf("s3 head bucket: %w", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	if resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("s3 head bucket %s: unexpected status %d", s.bucket, resp.StatusCode)
+	}
+
+	req, err = s.newRequest(ctx, http.MethodPut, "", nil, nil)
+	if err != nil {
+		return err
+	}
+	resp, err = s.do(req)
+	if err != nil {
+		return fmt.Errorf("s3 create bucket: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s.responseError("create bucket", s.bucket, resp)
+	}
+	return nil
+}
+
+// responseError - ошибка с кодом и сообщением из XML ответа S3
+func (s *S3Store) responseError(op, key string, resp *http.Response) error {
+	var body struct {
+		Code    string `xml:"Code"`
+		Message string `xml:"Message"`
+	}
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err := xml.Unmarshal(data, &body); err == nil && body.Code != "" {
+		return fmt.Errorf("s3 %s %s: %s: %s", op, key, body.Code, body.Message)
+	}
+	return fmt.Errorf("s3 %s %s: unexpected status %d", op, key, resp.StatusCode)
+}
+
+func infoFromHeader(key string, resp *http.Response) BlobInfo {
+	info := BlobInfo{
+		Key:         key,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); err == nil {
+		info.Size = size
+	}
+	if modTime, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		info.ModTime = modTime.UTC()
+	}
+	return info
+}
@@ -0,0 +1,501 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+const (
+	testAccessKey = "test-access"
+	testSecretKey = "test-secret"
+	testRegion    = "eu-test-1"
+	testBucket    = "docs"
+)
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modTime     time.Time
+}
+
+// fakeS3 - минимальная замена S3 для тестов: проверяет подпись SigV4 так, как ее считает сервер
+// (по раскодированному пути и параметрам), и хранит объекты одного бакета в памяти
+type fakeS3 struct {
+	pathStyle bool
+	pageSize  int // Ключей на страницу ListObjectsV2
+
+	mu      sync.Mutex
+	bucket  bool
+	objects map[string]fakeObject
+	fail    map[string]int // Метод -> код ответа с ошибкой в XML
+	badSign []string       // Запросы, отклоненные из-за подписи
+}
+
+func newFakeS3(pathStyle bool) *fakeS3 {
+	return &fakeS3{pathStyle: pathStyle, pageSize: 1000, bucket: true, objects: make(map[string]fakeObject)}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := verifySignature(r); err != nil {
+		f.mu.Lock()
+		f.badSign = append(f.badSign, fmt.Sprintf("%s %s: %v", r.Method, r.URL, err))
+		f.mu.Unlock()
+		writeS3Error(w, http.StatusForbidden, "SignatureDoesNotMatch", err.Error())
+		return
+	}
+
+	bucket, key := f.target(r)
+	if bucket != testBucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", bucket)
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if status, ok := f.fail[r.Method]; ok {
+		writeS3Error(w, status, "InternalError", "injected failure")
+		return
+	}
+
+	if key == "" {
+		f.serveBucket(w, r)
+		return
+	}
+	if !f.bucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket", bucket)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if source := r.Header.Get("X-Amz-Copy-Source"); source != "" {
+			src := strings.TrimPrefix(source, "/"+testBucket+"/")
+			obj, ok := f.objects[src]
+			if !ok {
+				writeS3Error(w, http.StatusNotFound, "NoSuchKey", src)
+				return
+			}
+			obj.modTime = time.Now().UTC()
+			f.objects[key] = obj
+			fmt.Fprint(w, `<CopyObjectResult><ETag>"copied"</ETag></CopyObjectResult>`)
+			return
+		}
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody", err.Error())
+			return
+		}
+		if r.ContentLength != int64(len(data)) {
+			writeS3Error(w, http.StatusBadRequest, "IncompleteBody", "content length mismatch")
+			return
+		}
+		f.objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modTime: time.Now().UTC()}
+	case http.MethodGet, http.MethodHead:
+		obj, ok := f.objects[key]
+		if !ok {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey", key)
+			return
+		}
+		data, status := obj.data, http.StatusOK
+		if rng := r.Header.Get("Range"); rng != "" {
+			data, status = rangeOf(obj.data, rng), http.StatusPartialContent
+		}
+		w.Header().Set("Content-Type", obj.contentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.Header().Set("Last-Modified", obj.modTime.Format(http.TimeFormat))
+		w.WriteHeader(status)
+		if r.Method == http.MethodGet {
+			w.Write(data)
+		}
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed", r.Method)
+	}
+}
+
+// serveBucket - операции над бакетом: HeadBucket, CreateBucket, ListObjectsV2
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodHead:
+		if !f.bucket {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	case http.MethodPut:
+		f.bucket = true
+	case http.MethodGet:
+		query := r.URL.Query()
+		if query.Get("list-type") != "2" {
+			writeS3Error(w, http.StatusBadRequest, "InvalidArgument", "list-type")
+			return
+		}
+		keys := make([]string, 0, len(f.objects))
+		for key := range f.objects {
+			if strings.HasPrefix(key, query.Get("prefix")) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		start := 0
+		if token := query.Get("continuation-token"); token != "" {
+			start, _ = strconv.Atoi(token)
+		}
+		end := min(start+f.pageSize, len(keys))
+
+		var result listBucketResult
+		for _, key := range keys[start:end] {
+			obj := f.objects[key]
+			result.Contents = append(result.Contents, struct {
+				Key          string    `xml:"Key"`
+				Size         int64     `xml:"Size"`
+				LastModified time.Time `xml:"LastModified"`
+			}{Key: key, Size: int64(len(obj.data)), LastModified: obj.modTime})
+		}
+		if end < len(keys) {
+			result.IsTruncated = true
+			result.NextContinuationToken = strconv.Itoa(end)
+		}
+		body, err := xml.Marshal(struct {
+			XMLName xml.Name `xml:"ListBucketResult"`
+			listBucketResult
+		}{listBucketResult: result})
+		if err != nil {
+			writeS3Error(w, http.StatusInternalServerError, "InternalError", err.Error())
+			return
+		}
+		w.Write(body)
+	}
+}
+
+// target - бакет и ключ запроса с учетом path-style/virtual-host адресации
+func (f *fakeS3) target(r *http.Request) (string, string) {
+	p := strings.TrimPrefix(r.URL.Path, "/")
+	if f.pathStyle {
+		bucket, key, _ := strings.Cut(p, "/")
+		return bucket, key
+	}
+	bucket, _, _ := strings.Cut(r.Host, ".")
+	return bucket, p
+}
+
+// verifySignature - проверка подписи SigV4 по запросу в том виде, в котором его получил сервер
+func verifySignature(r *http.Request) error {
+	auth, ok := strings.CutPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 ")
+	if !ok {
+		return errors.New("missing sigv4 authorization")
+	}
+	fields := make(map[string]string)
+	for _, part := range strings.Split(auth, ", ") {
+		name, value, _ := strings.Cut(part, "=")
+		fields[name] = value
+	}
+
+	credential := strings.Split(fields["Credential"], "/")
+	if len(credential) != 5 || credential[0] != testAccessKey || credential[2] != testRegion {
+		return fmt.Errorf("unexpected credential %q", fields["Credential"])
+	}
+	amzDate := r.Header.Get("X-Amz-Date")
+	if !strings.HasPrefix(amzDate, credential[1]) {
+		return fmt.Errorf("date %q does not match credential scope", amzDate)
+	}
+
+	signed := strings.Split(fields["SignedHeaders"], ";")
+	if !slices.Contains(signed, "host") {
+		return errors.New("host header is not signed")
+	}
+	for name := range r.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-amz-") && !slices.Contains(signed, lower) {
+			return fmt.Errorf("header %s is not signed", lower)
+		}
+	}
+	var headers strings.Builder
+	for _, name := range signed {
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		headers.WriteString(name + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		uriEncode(r.URL.Path, false),
+		canonicalQuery(r.URL.Query()),
+		headers.String(),
+		fields["SignedHeaders"],
+		r.Header.Get("X-Amz-Content-Sha256"),
+	}, "\n")
+	scope := strings.Join(credential[1:], "/")
+	sum := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(sum[:])
+
+	key := hmacSHA256([]byte("AWS4"+testSecretKey), credential[1])
+	key = hmacSHA256(key, credential[2])
+	key = hmacSHA256(key, credential[3])
+	key = hmacSHA256(key, credential[4])
+	if want := hex.EncodeToString(hmacSHA256(key, stringToSign)); fields["Signature"] != want {
+		return fmt.Errorf("signature mismatch for canonical request:\n%s", canonicalRequest)
+	}
+	return nil
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, message)
+}
+
+// rangeOf - часть содержимого по заголовку Range вида bytes=a-b или bytes=a-
+func rangeOf(data []byte, header string) []byte {
+	spec := strings.TrimPrefix(header, "bytes=")
+	from, to, _ := strings.Cut(spec, "-")
+	start, _ := strconv.Atoi(from)
+	end := len(data) - 1
+	if to != "" {
+		end, _ = strconv.Atoi(to)
+	}
+	return data[start:min(end+1, len(data))]
+}
+
+// newTestS3Store - S3Store, подключенный к fakeS3. При virtual-host адресации соединения
+// с любым хостом направляются на тестовый сервер. Запросы с неверной подписью проваливают тест,
+// если тест не очистил fake.badSign сам
+func newTestS3Store(t *testing.T, pathStyle bool) (*S3Store, *fakeS3) {
+	t.Helper()
+
+	fake := newFakeS3(pathStyle)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(func() {
+		srv.Close()
+		for _, req := range fake.badSign {
+			t.Errorf("request rejected by signature check: %s", req)
+		}
+	})
+
+	store, err := NewS3Store(config.S3Config{
+		Endpoint:  srv.URL,
+		Region:    testRegion,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: testSecretKey,
+		PathStyle: pathStyle,
+	})
+	if err != nil {
+		t.Fatalf("NewS3Store: %v", err)
+	}
+	addr := srv.Listener.Addr().String()
+	store.client = &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}}
+	return store, fake
+}
+
+func TestS3StoreObjectLifecycle(t *testing.T) {
+	for _, pathStyle := range []bool{true, false} {
+		t.Run(fmt.Sprintf("pathStyle=%t", pathStyle), func(t *testing.T) {
+			store, _ := newTestS3Store(t, pathStyle)
+			ctx := context.Background()
+
+			// Ключ с пробелами, не-ASCII символами и вложенными директориями проверяет кодирование пути в подписи
+			key := "docs/отчет 2024/file+name (1).txt"
+			content := []byte("hello, s3")
+
+			info, err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain")
+			if err != nil {
+				t.Fatalf("Put: %v", err)
+			}
+			if info.Key != key || info.Size != int64(len(content)) || info.ContentType != "text/plain" {
+				t.Errorf("Put info = %+v", info)
+			}
+
+			stat, err := store.Stat(ctx, key)
+			if err != nil {
+				t.Fatalf("Stat: %v", err)
+			}
+			if stat.Size != int64(len(content)) || stat.ContentType != "text/plain" || stat.ModTime.IsZero() {
+				t.Errorf("Stat = %+v", stat)
+			}
+
+			body, got, err := store.Get(ctx, key)
+			if err != nil {
+				t.Fatalf("Get: %v", err)
+			}
+			data, err := io.ReadAll(body)
+			body.Close()
+			if err != nil {
+				t.Fatalf("Get read: %v", err)
+			}
+			if !bytes.Equal(data, content) || got.Size != int64(len(content)) {
+				t.Errorf("Get = %q (%+v), want %q", data, got, content)
+			}
+
+			part, err := store.GetRange(ctx, key, 7, 2)
+			if err != nil {
+				t.Fatalf("GetRange: %v", err)
+			}
+			data, _ = io.ReadAll(part)
+			part.Close()
+			if string(data) != "s3" {
+				t.Errorf("GetRange = %q, want %q", data, "s3")
+			}
+
+			if err := store.Delete(ctx, key); err != nil {
+				t.Fatalf("Delete: %v", err)
+			}
+			if _, err := store.Stat(ctx, key); !errors.Is(err, model.ErrNotFound) {
+				t.Errorf("Stat after Delete error = %v, want ErrNotFound", err)
+			}
+			if _, _, err := store.Get(ctx, key); !errors.Is(err, model.ErrNotFound) {
+				t.Errorf("Get after Delete error = %v, want ErrNotFound", err)
+			}
+			// Отсутствие объекта ошибкой удаления не считается
+			if err := store.Delete(ctx, key); err != nil {
+				t.Errorf("Delete missing: %v", err)
+			}
+		})
+	}
+}
+
+func TestS3StorePutUnknownSize(t *testing.T) {
+	store, fake := newTestS3Store(t, true)
+	ctx := context.Background()
+
+	content := strings.Repeat("chunk", 1000)
+	info, err := store.Put(ctx, "staging/stream", io.MultiReader(strings.NewReader(content)), -1, "")
+	if err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if info.Size != int64(len(content)) {
+		t.Errorf("Put size = %d, want %d", info.Size, len(content))
+	}
+	if got := string(fake.objects["staging/stream"].data); got != content {
+		t.Errorf("stored %d bytes, want %d", len(got), len(content))
+	}
+}
+
+func TestS3StoreList(t *testing.T) {
+	store, fake := newTestS3Store(t, true)
+	fake.pageSize = 2 // Несколько страниц с continuation-token
+	ctx := context.Background()
+
+	keys := []string{"ab/one", "ab/two", "ab/three", "ab/four four", "cd/other", "uploads/x/0"}
+	for i, key := range keys {
+		if _, err := store.Put(ctx, key, strings.NewReader(strings.Repeat("x", i)), int64(i), ""); err != nil {
+			t.Fatalf("Put %s: %v", key, err)
+		}
+	}
+
+	blobs, err := store.List(ctx, "ab/")
+	if err != nil {
+		t.Fatalf("List: %v", err)
+	}
+	want := map[string]int64{"ab/one": 0, "ab/two": 1, "ab/three": 2, "ab/four four": 3}
+	if len(blobs) != len(want) {
+		t.Fatalf("List returned %d objects, want %d: %+v", len(blobs), len(want), blobs)
+	}
+	for _, blob := range blobs {
+		size, ok := want[blob.Key]
+		if !ok || blob.Size != size || blob.ModTime.IsZero() {
+			t.Errorf("unexpected object %+v", blob)
+		}
+	}
+
+	all, err := store.List(ctx, "")
+	if err != nil {
+		t.Fatalf("List all: %v", err)
+	}
+	if len(all) != len(keys) {
+		t.Errorf("List all returned %d objects, want %d", len(all), len(keys))
+	}
+}
+
+func TestS3StoreMove(t *testing.T) {
+	store, _ := newTestS3Store(t, true)
+	ctx := context.Background()
+
+	if _, err := store.Put(ctx, "staging/a", strings.NewReader("data"), 4, "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Move(ctx, "staging/a", "sha256/ab/cd"); err != nil {
+		t.Fatalf("Move: %v", err)
+	}
+	if _, err := store.Stat(ctx, "staging/a"); !errors.Is(err, model.ErrNotFound) {
+		t.Errorf("source after Move error = %v, want ErrNotFound", err)
+	}
+	if info, err := store.Stat(ctx, "sha256/ab/cd"); err != nil || info.Size != 4 {
+		t.Errorf("destination after Move = %+v, %v", info, err)
+	}
+	if err := store.Move(ctx, "staging/missing", "sha256/ef"); !errors.Is(err, model.ErrNotFound) {
+		t.Errorf("Move missing error = %v, want ErrNotFound", err)
+	}
+}
+
+func TestS3StoreErrors(t *testing.T) {
+	store, fake := newTestS3Store(t, true)
+	ctx := context.Background()
+
+	fake.fail = map[string]int{http.MethodPut: http.StatusInternalServerError, http.MethodGet: http.StatusServiceUnavailable}
+
+	_, err := store.Put(ctx, "k", strings.NewReader("v"), 1, "")
+	if err == nil || !strings.Contains(err.Error(), "InternalError") {
+		t.Errorf("Put error = %v, want S3 error code", err)
+	}
+	if _, err := store.List(ctx, ""); err == nil || errors.Is(err, model.ErrNotFound) {
+		t.Errorf("List error = %v, want non-NotFound error", err)
+	}
+
+	// Неверный секрет отклоняется сервером
+	fake.fail = nil
+	store.secretKey = "wrong"
+	if _, err := store.Stat(ctx, "k"); err == nil || errors.Is(err, model.ErrNotFound) {
+		t.Errorf("Stat with wrong secret error = %v, want signature error", err)
+	}
+	if len(fake.badSign) != 1 {
+		t.Errorf("rejected %d requests by signature, want 1", len(fake.badSign))
+	}
+	fake.badSign = nil
+}
+
+func TestS3StoreEnsureBucket(t *testing.T) {
+	store, fake := newTestS3Store(t, true)
+	ctx := context.Background()
+
+	fake.bucket = false
+	if err := store.EnsureBucket(ctx); err != nil {
+		t.Fatalf("EnsureBucket: %v", err)
+	}
+	if !fake.bucket {
+		t.Error("EnsureBucket did not create the bucket")
+	}
+	if err := store.EnsureBucket(ctx); err != nil {
+		t.Errorf("EnsureBucket on existing bucket: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// RangeGetter - хранилище, умеющее читать часть объекта (например, S3 через заголовок Range)
+type RangeGetter interface {
+	// GetRange открывает объект на чтение с offset; length < 0 - до конца объекта
+	GetRange(ctx context.Context, key string, offset, length int64) (io.ReadCloser, error)
+}
+
+// OpenSeeker - открытие объекта с произвольным доступом (нужно для Range запросов и http.ServeContent)
+func OpenSeeker(ctx context.Context, store BlobStore, key string) (io.ReadSeekCloser, BlobInfo, error) {
+	// Хранилища с поддержкой диапазонов читаются по требованию без загрузки объекта целиком
+	if ranger, ok := store.(RangeGetter); ok {
+		info, err := store.Stat(ctx, key)
+		if err != nil {
+			return nil, BlobInfo{}, err
+		}
+		return &rangeSeeker{ctx: ctx, ranger: ranger, key: info.Key, size: info.Size}, info, nil
+	}
+
+	body, info, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, BlobInfo{}, err
+	}
+	if seeker, ok := body.(io.ReadSeekCloser); ok {
+		return seeker, info, nil
+	}
+
+	// Запасной вариант для хранилищ без произвольного доступа - временный файл
+	defer body.Close()
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return nil, BlobInfo{}, fmt.Errorf("failed to buffer %s: %w", key, err)
+	}
+	os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, body); err != nil {
+		tmp.Close()
+		return nil, BlobInfo{}, fmt.Errorf("failed to buffer %s: %w", key, err)
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		tmp.Close()
+		return nil, BlobInfo{}, err
+	}
+	return tmp, info, nil
+}
+
+// rangeSeeker - io.ReadSeekCloser поверх RangeGetter: каждое позиционирование открывает новый диапазон
+type rangeSeeker struct {
+	ctx    context.Context
+	ranger RangeGetter
+	key    string
+	size   int64
+	offset int64
+	body   io.ReadCloser
+}
+
+func (s *rangeSeeker) Read(p []byte) (int, error) {
+	if s.offset >= s.size {
+		return 0, io.EOF
+	}
+	if s.body == nil {
+		body, err := s.ranger.GetRange(s.ctx, s.key, s.offset, -1)
+		if err != nil {
+			return 0, err
+		}
+		s.body = body
+	}
+
+	n, err := s.body.Read(p)
+	s.offset += int64(n)
+	return n, err
+}
+
+func (s *rangeSeeker) Seek(offset int64, whence int) (int64, error) {
+	var next int64
+	switch whence {
+	case io.SeekStart:
+		next = offset
+	case io.SeekCurrent:
+		next = s.offset + offset
+	case io.SeekEnd:
+		next = s.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if next < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	if next != s.offset {
+		s.Close()
+		s.offset = next
+	}
+	return next, nil
+}
+
+func (s *rangeSeeker) Close() error {
+	if s.body == nil {
+		return nil
+	}
+	err := s.body.Close()
+	s.body = nil
+	return err
+}
@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// StagingPrefix - префикс ключей загруженного, но еще не зафиксированного в БД содержимого
+const StagingPrefix = "staging/"
+
+// StagedBlob - содержимое, записанное во временный объект до фиксации транзакции БД.
+// Протокол: Stage -> вставка строк в транзакции -> Commit перед COMMIT -> Discard при любой ошибке
+type StagedBlob struct {
+	store       BlobStore
+	stagingKey  string
+	Key         string // Итоговый ключ объекта (адрес по SHA-256)
+	Digest      string // SHA-256 содержимого в hex
+	Size        int64  // Размер в байтах
+	ContentType string // MIME-тип
+	committed   bool
+}
+
+// Stage - потоковая запись содержимого во временный объект с подсчетом SHA-256
+func Stage(ctx context.Context, store BlobStore, r io.Reader, size int64, contentType string) (*StagedBlob, error) {
+	stagingKey := StagingPrefix + uuid.New().String()
+
+	hash := sha256.New()
+	info, err := store.Put(ctx, stagingKey, io.TeeReader(r, hash), size, contentType)
+	if err != nil {
+		return nil, err
+	}
+	digest := hex.EncodeToString(hash.Sum(nil))
+
+	return &StagedBlob{
+		store:       store,
+		stagingKey:  stagingKey,
+		Key:         DigestKey(digest),
+		Digest:      digest,
+		Size:        info.Size,
+		ContentType: contentType,
+	}, nil
+}
+
+// Commit - перенос временного объекта под итоговый ключ; вызывается внутри транзакции БД перед фиксацией,
+// чтобы ошибка переноса откатила создание строк
+func (b *StagedBlob) Commit(ctx context.Context) error {
+	if b.committed {
+		return nil
+	}
+
+	// Такое содержимое уже хранится - временный объект не нужен
+	info, err := b.store.Stat(ctx, b.Key)
+	switch {
+	case err == nil && info.Size == b.Size:
+		if err := b.store.Delete(ctx, b.stagingKey); err != nil {
+			return err
+		}
+	case err != nil && !errors.Is(err, model.ErrNotFound):
+		return err
+	default:
+		if err := Move(ctx, b.store, b.stagingKey, b.Key); err != nil {
+			return fmt.Errorf("failed to commit staged blob %s: %w", b.Digest, err)
+		}
+	}
+
+	b.committed = true
+	return nil
+}
+
+// Discard - удаление временного объекта, если содержимое так и не было зафиксировано
+func (b *StagedBlob) Discard(ctx context.Context) error {
+	if b == nil || b.committed {
+		return nil
+	}
+	return b.store.Delete(ctx, b.stagingKey)
+}
@@ -0,0 +1,139 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/NarthurN/FileServerService/internal/config"
+	"github.com/NarthurN/FileServerService/internal/model"
+)
+
+// Поддерживаемые драйверы хранилища
+const (
+	DriverLocal = "local"
+	DriverS3    = "s3"
+)
+
+// BlobInfo - метаданные объекта в хранилище
+type BlobInfo struct {
+	Key         string    // Ключ объекта
+	Size        int64     // Размер в байтах
+	ContentType string    // MIME-тип (если известен хранилищу)
+	ModTime     time.Time // Время последнего изменения
+}
+
+// BlobStore - интерфейс хранилища содержимого файловых документов
+type BlobStore interface {
+	// Put потоково записывает объект; size = -1, если размер заранее неизвестен
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) (BlobInfo, error)
+	// Get открывает объект на чтение, вызывающий обязан закрыть reader
+	Get(ctx context.Context, key string) (io.ReadCloser, BlobInfo, error)
+	// Stat возвращает метаданные объекта без чтения содержимого
+	Stat(ctx context.Context, key string) (BlobInfo, error)
+	// Delete удаляет объект, отсутствие объекта ошибкой не считается
+	Delete(ctx context.Context, key string) error
+	// List возвращает все объекты с указанным префиксом
+	List(ctx context.Context, prefix string) ([]BlobInfo, error)
+}
+
+// Mover - хранилище, умеющее переносить объект без передачи содержимого через приложение
+type Mover interface {
+	Move(ctx context.Context, src, dst string) error
+}
+
+// New - создание хранилища по настройкам конфигурации
+func New(ctx context.Context, cfg config.StorageConfig) (BlobStore, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		return NewLocalStore(cfg.LocalDir)
+	case DriverS3:
+		store, err := NewS3Store(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		if cfg.S3.AutoCreateBucket {
+			if err := store.EnsureBucket(ctx); err != nil {
+				return nil, err
+			}
+		}
+		return store, nil
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Driver)
+	}
+}
+
+const (
+	// tempFilePrefix - префикс имени временного файла, через который LocalStore записывает объект
+	tempFilePrefix = ".upload-"
+	// staleTempAge - возраст временного файла, после которого запись считается прерванной сбоем:
+	// Put пишет временный файл непрерывно и сразу переименовывает
+	staleTempAge = time.Hour
+)
+
+// IsTempKey - ключ временного файла записи, прерванной сбоем до переименования (см. LocalStore.List).
+// На такие файлы не ссылается ни один документ
+func IsTempKey(key string) bool {
+	return strings.HasPrefix(path.Base(key), tempFilePrefix)
+}
+
+// UploadPrefix - префикс ключей фрагментов возобновляемой загрузки
+func UploadPrefix(uploadID string) string {
+	return "uploads/" + uploadID + "/"
+}
+
+// DeletePrefix - удаление всех объектов с указанным префиксом
+func DeletePrefix(ctx context.Context, store BlobStore, prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("empty prefix: %w", model.ErrInvalidInput)
+	}
+
+	blobs, err := store.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, blob := range blobs {
+		if err := store.Delete(ctx, blob.Key); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Move - перенос объекта под новый ключ; для хранилищ без Mover - копирование и удаление исходного
+func Move(ctx context.Context, store BlobStore, src, dst string) error {
+	if mover, ok := store.(Mover); ok {
+		return mover.Move(ctx, src, dst)
+	}
+
+	r, info, err := store.Get(ctx, src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	if _, err := store.Put(ctx, dst, r, info.Size, info.ContentType); err != nil {
+		return err
+	}
+	return store.Delete(ctx, src)
+}
+
+// cleanKey - нормализация ключа объекта с защитой от выхода за пределы хранилища
+func cleanKey(key string) (string, error) {
+	key = strings.TrimPrefix(path.Clean("/"+strings.ReplaceAll(key, "\\", "/")), "/")
+	if key == "" || key == "." {
+		return "", fmt.Errorf("invalid blob key: %w", model.ErrInvalidInput)
+	}
+	return key, nil
+}
+
+// notFound - ошибка отсутствия объекта, совместимая с errors.Is(err, model.ErrNotFound)
+func notFound(key string) error {
+	return fmt.Errorf("blob %s: %w", key, model.ErrNotFound)
+}
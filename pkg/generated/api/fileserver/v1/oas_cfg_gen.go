@@ -17,7 +17,8 @@ import (
 )
 
 var regexMap = map[string]ogenregex.Regexp{
-	"^[a-zA-Z0-9]{8,}$": ogenregex.MustCompile("^[a-zA-Z0-9]{8,}$"),
+	"^(name|created|size|mime)(:(asc|desc))?$": ogenregex.MustCompile("^(name|created|size|mime)(:(asc|desc))?$"),
+	"^[a-zA-Z0-9]{8,}$":                        ogenregex.MustCompile("^[a-zA-Z0-9]{8,}$"),
 }
 var (
 	// Allocate option closure once.
@@ -0,0 +1,11 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package fileserver_v1
+
+// setDefaults set default value of fields.
+func (s *RegisterRequest) setDefaults() {
+	{
+		val := RegisterRequestRole("user")
+		s.Role.SetTo(val)
+	}
+}
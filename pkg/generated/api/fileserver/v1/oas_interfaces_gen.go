@@ -1,14 +1,86 @@
 // Code generated by ogen, DO NOT EDIT.
 package fileserver_v1
 
+type AddDocumentTagsRes interface {
+	addDocumentTagsRes()
+}
+
+type CancelUploadRes interface {
+	cancelUploadRes()
+}
+
+type ChangePasswordRes interface {
+	changePasswordRes()
+}
+
+type CompleteOidcLoginRes interface {
+	completeOidcLoginRes()
+}
+
+type ConfirmPasswordResetRes interface {
+	confirmPasswordResetRes()
+}
+
+type ConfirmTotpRes interface {
+	confirmTotpRes()
+}
+
+type CreateApiKeyRes interface {
+	createApiKeyRes()
+}
+
 type CreateDocumentRes interface {
 	createDocumentRes()
 }
 
+type CreateDocumentVersionRes interface {
+	createDocumentVersionRes()
+}
+
+type CreateFolderRes interface {
+	createFolderRes()
+}
+
+type CreateShareLinkRes interface {
+	createShareLinkRes()
+}
+
+type CreateUploadRes interface {
+	createUploadRes()
+}
+
 type DeleteDocumentRes interface {
 	deleteDocumentRes()
 }
 
+type DeleteFolderRes interface {
+	deleteFolderRes()
+}
+
+type DeleteSessionRes interface {
+	deleteSessionRes()
+}
+
+type DeleteUserRes interface {
+	deleteUserRes()
+}
+
+type DisableTotpRes interface {
+	disableTotpRes()
+}
+
+type EmptyTrashRes interface {
+	emptyTrashRes()
+}
+
+type EnrollTotpRes interface {
+	enrollTotpRes()
+}
+
+type FinalizeUploadRes interface {
+	finalizeUploadRes()
+}
+
 type GetDocumentHeadRes interface {
 	getDocumentHeadRes()
 }
@@ -17,6 +89,26 @@ type GetDocumentRes interface {
 	getDocumentRes()
 }
 
+type GetFolderRes interface {
+	getFolderRes()
+}
+
+type GetSharedDocumentRes interface {
+	getSharedDocumentRes()
+}
+
+type GetUploadOffsetRes interface {
+	getUploadOffsetRes()
+}
+
+type ListApiKeysRes interface {
+	listApiKeysRes()
+}
+
+type ListDocumentVersionsRes interface {
+	listDocumentVersionsRes()
+}
+
 type ListDocumentsHeadRes interface {
 	listDocumentsHeadRes()
 }
@@ -25,14 +117,110 @@ type ListDocumentsRes interface {
 	listDocumentsRes()
 }
 
+type ListFolderRes interface {
+	listFolderRes()
+}
+
+type ListSessionsRes interface {
+	listSessionsRes()
+}
+
+type ListShareLinksRes interface {
+	listShareLinksRes()
+}
+
+type ListTagsRes interface {
+	listTagsRes()
+}
+
+type ListTrashRes interface {
+	listTrashRes()
+}
+
+type ListUsersRes interface {
+	listUsersRes()
+}
+
 type LoginUserRes interface {
 	loginUserRes()
 }
 
+type LogoutEverywhereRes interface {
+	logoutEverywhereRes()
+}
+
 type LogoutUserRes interface {
 	logoutUserRes()
 }
 
+type RefreshTokenRes interface {
+	refreshTokenRes()
+}
+
 type RegisterUserRes interface {
 	registerUserRes()
 }
+
+type RemoveDocumentTagRes interface {
+	removeDocumentTagRes()
+}
+
+type ReplaceDocumentRes interface {
+	replaceDocumentRes()
+}
+
+type RequestPasswordResetRes interface {
+	requestPasswordResetRes()
+}
+
+type ResetUserPasswordRes interface {
+	resetUserPasswordRes()
+}
+
+type RestoreDocumentRes interface {
+	restoreDocumentRes()
+}
+
+type RestoreDocumentVersionRes interface {
+	restoreDocumentVersionRes()
+}
+
+type RevokeApiKeyRes interface {
+	revokeApiKeyRes()
+}
+
+type RevokeShareLinkRes interface {
+	revokeShareLinkRes()
+}
+
+type SearchDocumentsRes interface {
+	searchDocumentsRes()
+}
+
+type StartOidcLoginRes interface {
+	startOidcLoginRes()
+}
+
+type UnlockUserRes interface {
+	unlockUserRes()
+}
+
+type UpdateDocumentRes interface {
+	updateDocumentRes()
+}
+
+type UpdateFolderRes interface {
+	updateFolderRes()
+}
+
+type UpdateUserRes interface {
+	updateUserRes()
+}
+
+type UploadChunkRes interface {
+	uploadChunkRes()
+}
+
+type VerifySecondFactorRes interface {
+	verifySecondFactorRes()
+}
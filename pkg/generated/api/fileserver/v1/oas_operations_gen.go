@@ -6,13 +6,60 @@ package fileserver_v1
 type OperationName = string
 
 const (
-	CreateDocumentOperation    OperationName = "CreateDocument"
-	DeleteDocumentOperation    OperationName = "DeleteDocument"
-	GetDocumentOperation       OperationName = "GetDocument"
-	GetDocumentHeadOperation   OperationName = "GetDocumentHead"
-	ListDocumentsOperation     OperationName = "ListDocuments"
-	ListDocumentsHeadOperation OperationName = "ListDocumentsHead"
-	LoginUserOperation         OperationName = "LoginUser"
-	LogoutUserOperation        OperationName = "LogoutUser"
-	RegisterUserOperation      OperationName = "RegisterUser"
+	AddDocumentTagsOperation        OperationName = "AddDocumentTags"
+	CancelUploadOperation           OperationName = "CancelUpload"
+	ChangePasswordOperation         OperationName = "ChangePassword"
+	CompleteOidcLoginOperation      OperationName = "CompleteOidcLogin"
+	ConfirmPasswordResetOperation   OperationName = "ConfirmPasswordReset"
+	ConfirmTotpOperation            OperationName = "ConfirmTotp"
+	CreateApiKeyOperation           OperationName = "CreateApiKey"
+	CreateDocumentOperation         OperationName = "CreateDocument"
+	CreateDocumentVersionOperation  OperationName = "CreateDocumentVersion"
+	CreateFolderOperation           OperationName = "CreateFolder"
+	CreateShareLinkOperation        OperationName = "CreateShareLink"
+	CreateUploadOperation           OperationName = "CreateUpload"
+	DeleteDocumentOperation         OperationName = "DeleteDocument"
+	DeleteFolderOperation           OperationName = "DeleteFolder"
+	DeleteSessionOperation          OperationName = "DeleteSession"
+	DeleteUserOperation             OperationName = "DeleteUser"
+	DisableTotpOperation            OperationName = "DisableTotp"
+	EmptyTrashOperation             OperationName = "EmptyTrash"
+	EnrollTotpOperation             OperationName = "EnrollTotp"
+	FinalizeUploadOperation         OperationName = "FinalizeUpload"
+	GetDocumentOperation            OperationName = "GetDocument"
+	GetDocumentHeadOperation        OperationName = "GetDocumentHead"
+	GetFolderOperation              OperationName = "GetFolder"
+	GetSharedDocumentOperation      OperationName = "GetSharedDocument"
+	GetUploadOffsetOperation        OperationName = "GetUploadOffset"
+	ListApiKeysOperation            OperationName = "ListApiKeys"
+	ListDocumentVersionsOperation   OperationName = "ListDocumentVersions"
+	ListDocumentsOperation          OperationName = "ListDocuments"
+	ListDocumentsHeadOperation      OperationName = "ListDocumentsHead"
+	ListFolderOperation             OperationName = "ListFolder"
+	ListSessionsOperation           OperationName = "ListSessions"
+	ListShareLinksOperation         OperationName = "ListShareLinks"
+	ListTagsOperation               OperationName = "ListTags"
+	ListTrashOperation              OperationName = "ListTrash"
+	ListUsersOperation              OperationName = "ListUsers"
+	LoginUserOperation              OperationName = "LoginUser"
+	LogoutEverywhereOperation       OperationName = "LogoutEverywhere"
+	LogoutUserOperation             OperationName = "LogoutUser"
+	RefreshTokenOperation           OperationName = "RefreshToken"
+	RegisterUserOperation           OperationName = "RegisterUser"
+	RemoveDocumentTagOperation      OperationName = "RemoveDocumentTag"
+	ReplaceDocumentOperation        OperationName = "ReplaceDocument"
+	RequestPasswordResetOperation   OperationName = "RequestPasswordReset"
+	ResetUserPasswordOperation      OperationName = "ResetUserPassword"
+	RestoreDocumentOperation        OperationName = "RestoreDocument"
+	RestoreDocumentVersionOperation OperationName = "RestoreDocumentVersion"
+	RevokeApiKeyOperation           OperationName = "RevokeApiKey"
+	RevokeShareLinkOperation        OperationName = "RevokeShareLink"
+	SearchDocumentsOperation        OperationName = "SearchDocuments"
+	StartOidcLoginOperation         OperationName = "StartOidcLogin"
+	UnlockUserOperation             OperationName = "UnlockUser"
+	UpdateDocumentOperation         OperationName = "UpdateDocument"
+	UpdateFolderOperation           OperationName = "UpdateFolder"
+	UpdateUserOperation             OperationName = "UpdateUser"
+	UploadChunkOperation            OperationName = "UploadChunk"
+	VerifySecondFactorOperation     OperationName = "VerifySecondFactor"
 )
@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -49,9 +49,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/"
+		case '/': // Prefix: "/"
 
-			if l := len("/api/"); len(elem) >= l && elem[0:l] == "/api/" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -61,137 +61,1112 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth"
+			case 'a': // Prefix: "api/"
 
-				if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+				if l := len("api/"); len(elem) >= l && elem[0:l] == "api/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch r.Method {
-					case "POST":
-						s.handleLoginUserRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
-
-					return
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'a': // Prefix: "a"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "token"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "dmin/users"
+
+						if l := len("dmin/users"); len(elem) >= l && elem[0:l] == "dmin/users" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListUsersRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "user_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDeleteUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PATCH":
+									s.handleUpdateUserRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,PATCH")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "password-reset"
+
+									if l := len("password-reset"); len(elem) >= l && elem[0:l] == "password-reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleResetUserPasswordRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'u': // Prefix: "unlock"
+
+									if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleUnlockUserRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "uth"
+
+						if l := len("uth"); len(elem) >= l && elem[0:l] == "uth" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleLogoutUserRequest([0]string{}, elemIsEscaped, w, r)
+							case "POST":
+								s.handleLoginUserRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,POST")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '2': // Prefix: "2fa"
+
+								if l := len("2fa"); len(elem) >= l && elem[0:l] == "2fa" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "POST":
+										s.handleEnrollTotpRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "confirm"
+
+										if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleConfirmTotpRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 'd': // Prefix: "disable"
+
+										if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleDisableTotpRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									case 'v': // Prefix: "verify"
+
+										if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleVerifySecondFactorRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'k': // Prefix: "keys"
+
+								if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListApiKeysRequest([0]string{}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateApiKeyRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "key_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRevokeApiKeyRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 'o': // Prefix: "oidc/"
+
+								if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "callback"
+
+									if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleCompleteOidcLoginRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								case 'l': // Prefix: "login"
+
+									if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleStartOidcLoginRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							case 'p': // Prefix: "password"
+
+								if l := len("password"); len(elem) >= l && elem[0:l] == "password" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "POST":
+										s.handleChangePasswordRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/reset"
+
+									if l := len("/reset"); len(elem) >= l && elem[0:l] == "/reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "POST":
+											s.handleRequestPasswordResetRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/confirm"
+
+										if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleConfirmPasswordResetRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "refresh"
+
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRefreshTokenRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 's': // Prefix: "sessions"
+
+								if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "DELETE":
+										s.handleLogoutEverywhereRequest([0]string{}, elemIsEscaped, w, r)
+									case "GET":
+										s.handleListSessionsRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE,GET")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "session_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleDeleteSessionRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'd': // Prefix: "docs"
+
+					if l := len("docs"); len(elem) >= l && elem[0:l] == "docs" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
-						case "DELETE":
-							s.handleLogoutUserRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleListDocumentsRequest([0]string{}, elemIsEscaped, w, r)
+						case "HEAD":
+							s.handleListDocumentsHeadRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateDocumentRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE")
+							s.notAllowed(w, r, "GET,HEAD,POST")
 						}
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			case 'd': // Prefix: "docs"
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-				if l := len("docs"); len(elem) >= l && elem[0:l] == "docs" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteDocumentRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetDocumentRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "HEAD":
+								s.handleGetDocumentHeadRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateDocumentRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleReplaceDocumentRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,HEAD,PATCH,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "shares"
+
+								if l := len("shares"); len(elem) >= l && elem[0:l] == "shares" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListShareLinksRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateShareLinkRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "share_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRevokeShareLinkRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 't': // Prefix: "tags"
+
+								if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "POST":
+										s.handleAddDocumentTagsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "tag"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRemoveDocumentTagRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+								}
+
+							case 'v': // Prefix: "versions"
+
+								if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListDocumentVersionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleCreateDocumentVersionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
+
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "version"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/restore"
+
+										if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleRestoreDocumentVersionRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+							}
+
+						}
 
-				if len(elem) == 0 {
-					switch r.Method {
-					case "GET":
-						s.handleListDocumentsRequest([0]string{}, elemIsEscaped, w, r)
-					case "HEAD":
-						s.handleListDocumentsHeadRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handleCreateDocumentRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,HEAD,POST")
 					}
 
-					return
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'f': // Prefix: "folders"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("folders"); len(elem) >= l && elem[0:l] == "folders" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListFolderRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateFolderRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteFolderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetFolderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUpdateFolderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PATCH")
+							}
+
+							return
+						}
+
+					}
+
+				case 'r': // Prefix: "register"
+
+					if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "POST":
+							s.handleRegisterUserRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+
+				case 's': // Prefix: "search"
+
+					if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
 						switch r.Method {
-						case "DELETE":
-							s.handleDeleteDocumentRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetDocumentRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						case "HEAD":
-							s.handleGetDocumentHeadRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleSearchDocumentsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				case 't': // Prefix: "t"
+
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "ags"
+
+						if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleListTagsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+					case 'r': // Prefix: "rash"
+
+						if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleEmptyTrashRequest([0]string{}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleListTrashRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRestoreDocumentRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "uploads"
+
+					if l := len("uploads"); len(elem) >= l && elem[0:l] == "uploads" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "POST":
+							s.handleCreateUploadRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,HEAD")
+							s.notAllowed(w, r, "POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "upload_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleCancelUploadRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "HEAD":
+								s.handleGetUploadOffsetRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PATCH":
+								s.handleUploadChunkRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,HEAD,PATCH")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/finalize"
+
+							if l := len("/finalize"); len(elem) >= l && elem[0:l] == "/finalize" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleFinalizeUploadRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
 						}
 
-						return
 					}
 
 				}
 
-			case 'r': // Prefix: "register"
+			case 's': // Prefix: "s/"
 
-				if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+				if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "slug"
+				// Leaf parameter, slashes are prohibited
+				idx := strings.IndexByte(elem, '/')
+				if idx >= 0 {
+					break
+				}
+				args[0] = elem
+				elem = ""
+
 				if len(elem) == 0 {
 					// Leaf node.
 					switch r.Method {
-					case "POST":
-						s.handleRegisterUserRequest([0]string{}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetSharedDocumentRequest([1]string{
+							args[0],
+						}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
@@ -211,7 +1186,7 @@ type Route struct {
 	operationID string
 	pathPattern string
 	count       int
-	args        [1]string
+	args        [2]string
 }
 
 // Name returns ogen operation name.
@@ -279,9 +1254,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 			break
 		}
 		switch elem[0] {
-		case '/': // Prefix: "/api/"
+		case '/': // Prefix: "/"
 
-			if l := len("/api/"); len(elem) >= l && elem[0:l] == "/api/" {
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 				elem = elem[l:]
 			} else {
 				break
@@ -291,172 +1266,1312 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth"
+			case 'a': // Prefix: "api/"
 
-				if l := len("auth"); len(elem) >= l && elem[0:l] == "auth" {
+				if l := len("api/"); len(elem) >= l && elem[0:l] == "api/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						r.name = LoginUserOperation
-						r.summary = "Аутентификация пользователя"
-						r.operationID = "loginUser"
-						r.pathPattern = "/api/auth"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
+					break
 				}
 				switch elem[0] {
-				case '/': // Prefix: "/"
+				case 'a': // Prefix: "a"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+					if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "token"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "dmin/users"
+
+						if l := len("dmin/users"); len(elem) >= l && elem[0:l] == "dmin/users" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = ListUsersOperation
+								r.summary = "Список пользователей"
+								r.operationID = "listUsers"
+								r.pathPattern = "/api/admin/users"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "user_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = DeleteUserOperation
+									r.summary = "Удаление пользователя"
+									r.operationID = "deleteUser"
+									r.pathPattern = "/api/admin/users/{user_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PATCH":
+									r.name = UpdateUserOperation
+									r.summary = "Изменение пользователя"
+									r.operationID = "updateUser"
+									r.pathPattern = "/api/admin/users/{user_id}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "password-reset"
+
+									if l := len("password-reset"); len(elem) >= l && elem[0:l] == "password-reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = ResetUserPasswordOperation
+											r.summary = "Сброс пароля пользователя"
+											r.operationID = "resetUserPassword"
+											r.pathPattern = "/api/admin/users/{user_id}/password-reset"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'u': // Prefix: "unlock"
+
+									if l := len("unlock"); len(elem) >= l && elem[0:l] == "unlock" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = UnlockUserOperation
+											r.summary = "Снятие блокировки входа"
+											r.operationID = "unlockUser"
+											r.pathPattern = "/api/admin/users/{user_id}/unlock"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					case 'u': // Prefix: "uth"
+
+						if l := len("uth"); len(elem) >= l && elem[0:l] == "uth" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = LogoutUserOperation
+								r.summary = "Завершение авторизованной сессии"
+								r.operationID = "logoutUser"
+								r.pathPattern = "/api/auth"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = LoginUserOperation
+								r.summary = "Аутентификация пользователя"
+								r.operationID = "loginUser"
+								r.pathPattern = "/api/auth"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '2': // Prefix: "2fa"
+
+								if l := len("2fa"); len(elem) >= l && elem[0:l] == "2fa" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										r.name = EnrollTotpOperation
+										r.summary = "Подключение 2FA"
+										r.operationID = "enrollTotp"
+										r.pathPattern = "/api/auth/2fa"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "confirm"
+
+										if l := len("confirm"); len(elem) >= l && elem[0:l] == "confirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ConfirmTotpOperation
+												r.summary = "Подтверждение 2FA"
+												r.operationID = "confirmTotp"
+												r.pathPattern = "/api/auth/2fa/confirm"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'd': // Prefix: "disable"
+
+										if l := len("disable"); len(elem) >= l && elem[0:l] == "disable" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = DisableTotpOperation
+												r.summary = "Отключение 2FA"
+												r.operationID = "disableTotp"
+												r.pathPattern = "/api/auth/2fa/disable"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'v': // Prefix: "verify"
+
+										if l := len("verify"); len(elem) >= l && elem[0:l] == "verify" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = VerifySecondFactorOperation
+												r.summary = "Второй шаг входа"
+												r.operationID = "verifySecondFactor"
+												r.pathPattern = "/api/auth/2fa/verify"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'k': // Prefix: "keys"
+
+								if l := len("keys"); len(elem) >= l && elem[0:l] == "keys" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListApiKeysOperation
+										r.summary = "API-ключи"
+										r.operationID = "listApiKeys"
+										r.pathPattern = "/api/auth/keys"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "POST":
+										r.name = CreateApiKeyOperation
+										r.summary = "Выпуск API-ключа"
+										r.operationID = "createApiKey"
+										r.pathPattern = "/api/auth/keys"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "key_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = RevokeApiKeyOperation
+											r.summary = "Отзыв API-ключа"
+											r.operationID = "revokeApiKey"
+											r.pathPattern = "/api/auth/keys/{key_id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'o': // Prefix: "oidc/"
+
+								if l := len("oidc/"); len(elem) >= l && elem[0:l] == "oidc/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "callback"
+
+									if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = CompleteOidcLoginOperation
+											r.summary = "Завершение входа через OIDC"
+											r.operationID = "completeOidcLogin"
+											r.pathPattern = "/api/auth/oidc/callback"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'l': // Prefix: "login"
+
+									if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = StartOidcLoginOperation
+											r.summary = "Вход через OIDC"
+											r.operationID = "startOidcLogin"
+											r.pathPattern = "/api/auth/oidc/login"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'p': // Prefix: "password"
+
+								if l := len("password"); len(elem) >= l && elem[0:l] == "password" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										r.name = ChangePasswordOperation
+										r.summary = "Смена пароля"
+										r.operationID = "changePassword"
+										r.pathPattern = "/api/auth/password"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/reset"
+
+									if l := len("/reset"); len(elem) >= l && elem[0:l] == "/reset" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											r.name = RequestPasswordResetOperation
+											r.summary = "Запрос сброса пароля"
+											r.operationID = "requestPasswordReset"
+											r.pathPattern = "/api/auth/password/reset"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/confirm"
+
+										if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = ConfirmPasswordResetOperation
+												r.summary = "Установка пароля по токену сброса"
+												r.operationID = "confirmPasswordReset"
+												r.pathPattern = "/api/auth/password/reset/confirm"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'r': // Prefix: "refresh"
+
+								if l := len("refresh"); len(elem) >= l && elem[0:l] == "refresh" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RefreshTokenOperation
+										r.summary = "Обновление токена"
+										r.operationID = "refreshToken"
+										r.pathPattern = "/api/auth/refresh"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 's': // Prefix: "sessions"
+
+								if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										r.name = LogoutEverywhereOperation
+										r.summary = "Выход на всех устройствах"
+										r.operationID = "logoutEverywhere"
+										r.pathPattern = "/api/auth/sessions"
+										r.args = args
+										r.count = 0
+										return r, true
+									case "GET":
+										r.name = ListSessionsOperation
+										r.summary = "Активные сессии"
+										r.operationID = "listSessions"
+										r.pathPattern = "/api/auth/sessions"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "session_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[0] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = DeleteSessionOperation
+											r.summary = "Завершение сессии"
+											r.operationID = "deleteSession"
+											r.pathPattern = "/api/auth/sessions/{session_id}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'd': // Prefix: "docs"
+
+					if l := len("docs"); len(elem) >= l && elem[0:l] == "docs" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch method {
-						case "DELETE":
-							r.name = LogoutUserOperation
-							r.summary = "Завершение авторизованной сессии"
-							r.operationID = "logoutUser"
-							r.pathPattern = "/api/auth/{token}"
+						case "GET":
+							r.name = ListDocumentsOperation
+							r.summary = "Получение списка документов"
+							r.operationID = "listDocuments"
+							r.pathPattern = "/api/docs"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "HEAD":
+							r.name = ListDocumentsHeadOperation
+							r.summary = "Получение заголовков списка документов"
+							r.operationID = "listDocumentsHead"
+							r.pathPattern = "/api/docs"
 							r.args = args
-							r.count = 1
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateDocumentOperation
+							r.summary = "Загрузка нового документа"
+							r.operationID = "createDocument"
+							r.pathPattern = "/api/docs"
+							r.args = args
+							r.count = 0
 							return r, true
 						default:
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
 
-				}
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-			case 'd': // Prefix: "docs"
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-				if l := len("docs"); len(elem) >= l && elem[0:l] == "docs" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteDocumentOperation
+								r.summary = "Удаление документа"
+								r.operationID = "deleteDocument"
+								r.pathPattern = "/api/docs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetDocumentOperation
+								r.summary = "Получение документа по ID"
+								r.operationID = "getDocument"
+								r.pathPattern = "/api/docs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "HEAD":
+								r.name = GetDocumentHeadOperation
+								r.summary = "Получение заголовков документа по ID"
+								r.operationID = "getDocumentHead"
+								r.pathPattern = "/api/docs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateDocumentOperation
+								r.summary = "Изменение документа"
+								r.operationID = "updateDocument"
+								r.pathPattern = "/api/docs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = ReplaceDocumentOperation
+								r.summary = "Замена содержимого документа"
+								r.operationID = "replaceDocument"
+								r.pathPattern = "/api/docs/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 's': // Prefix: "shares"
+
+								if l := len("shares"); len(elem) >= l && elem[0:l] == "shares" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListShareLinksOperation
+										r.summary = "Публичные ссылки на документ"
+										r.operationID = "listShareLinks"
+										r.pathPattern = "/api/docs/{id}/shares"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateShareLinkOperation
+										r.summary = "Создание публичной ссылки"
+										r.operationID = "createShareLink"
+										r.pathPattern = "/api/docs/{id}/shares"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "share_id"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = RevokeShareLinkOperation
+											r.summary = "Отзыв публичной ссылки"
+											r.operationID = "revokeShareLink"
+											r.pathPattern = "/api/docs/{id}/shares/{share_id}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 't': // Prefix: "tags"
+
+								if l := len("tags"); len(elem) >= l && elem[0:l] == "tags" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										r.name = AddDocumentTagsOperation
+										r.summary = "Добавление тегов документу"
+										r.operationID = "addDocumentTags"
+										r.pathPattern = "/api/docs/{id}/tags"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "tag"
+									// Leaf parameter, slashes are prohibited
+									idx := strings.IndexByte(elem, '/')
+									if idx >= 0 {
+										break
+									}
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "DELETE":
+											r.name = RemoveDocumentTagOperation
+											r.summary = "Снятие тега с документа"
+											r.operationID = "removeDocumentTag"
+											r.pathPattern = "/api/docs/{id}/tags/{tag}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'v': // Prefix: "versions"
+
+								if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = ListDocumentVersionsOperation
+										r.summary = "История версий документа"
+										r.operationID = "listDocumentVersions"
+										r.pathPattern = "/api/docs/{id}/versions"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = CreateDocumentVersionOperation
+										r.summary = "Загрузка новой версии документа"
+										r.operationID = "createDocumentVersion"
+										r.pathPattern = "/api/docs/{id}/versions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "version"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[1] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/restore"
+
+										if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = RestoreDocumentVersionOperation
+												r.summary = "Восстановление версии документа"
+												r.operationID = "restoreDocumentVersion"
+												r.pathPattern = "/api/docs/{id}/versions/{version}/restore"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
+
+						}
 
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						r.name = ListDocumentsOperation
-						r.summary = "Получение списка документов"
-						r.operationID = "listDocuments"
-						r.pathPattern = "/api/docs"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "HEAD":
-						r.name = ListDocumentsHeadOperation
-						r.summary = "Получение заголовков списка документов"
-						r.operationID = "listDocumentsHead"
-						r.pathPattern = "/api/docs"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = CreateDocumentOperation
-						r.summary = "Загрузка нового документа"
-						r.operationID = "createDocument"
-						r.pathPattern = "/api/docs"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
 					}
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				case 'f': // Prefix: "folders"
+
+					if l := len("folders"); len(elem) >= l && elem[0:l] == "folders" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					// Param: "id"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListFolderOperation
+							r.summary = "Содержимое папки"
+							r.operationID = "listFolder"
+							r.pathPattern = "/api/folders"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateFolderOperation
+							r.summary = "Создание папки"
+							r.operationID = "createFolder"
+							r.pathPattern = "/api/folders"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteFolderOperation
+								r.summary = "Удаление папки"
+								r.operationID = "deleteFolder"
+								r.pathPattern = "/api/folders/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetFolderOperation
+								r.summary = "Получение папки по ID"
+								r.operationID = "getFolder"
+								r.pathPattern = "/api/folders/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UpdateFolderOperation
+								r.summary = "Изменение папки"
+								r.operationID = "updateFolder"
+								r.pathPattern = "/api/folders/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'r': // Prefix: "register"
+
+					if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+						elem = elem[l:]
+					} else {
 						break
 					}
-					args[0] = elem
-					elem = ""
 
 					if len(elem) == 0 {
 						// Leaf node.
 						switch method {
-						case "DELETE":
-							r.name = DeleteDocumentOperation
-							r.summary = "Удаление документа"
-							r.operationID = "deleteDocument"
-							r.pathPattern = "/api/docs/{id}"
+						case "POST":
+							r.name = RegisterUserOperation
+							r.summary = "Регистрация нового пользователя"
+							r.operationID = "registerUser"
+							r.pathPattern = "/api/register"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
+						default:
+							return
+						}
+					}
+
+				case 's': // Prefix: "search"
+
+					if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
 						case "GET":
-							r.name = GetDocumentOperation
-							r.summary = "Получение документа по ID"
-							r.operationID = "getDocument"
-							r.pathPattern = "/api/docs/{id}"
+							r.name = SearchDocumentsOperation
+							r.summary = "Полнотекстовый поиск документов"
+							r.operationID = "searchDocuments"
+							r.pathPattern = "/api/search"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
-						case "HEAD":
-							r.name = GetDocumentHeadOperation
-							r.summary = "Получение заголовков документа по ID"
-							r.operationID = "getDocumentHead"
-							r.pathPattern = "/api/docs/{id}"
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "t"
+
+					if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "ags"
+
+						if l := len("ags"); len(elem) >= l && elem[0:l] == "ags" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = ListTagsOperation
+								r.summary = "Теги пользователя"
+								r.operationID = "listTags"
+								r.pathPattern = "/api/tags"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "rash"
+
+						if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = EmptyTrashOperation
+								r.summary = "Очистка корзины"
+								r.operationID = "emptyTrash"
+								r.pathPattern = "/api/trash"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "GET":
+								r.name = ListTrashOperation
+								r.summary = "Корзина"
+								r.operationID = "listTrash"
+								r.pathPattern = "/api/trash"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RestoreDocumentOperation
+										r.summary = "Восстановление документа из корзины"
+										r.operationID = "restoreDocument"
+										r.pathPattern = "/api/trash/{id}/restore"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "uploads"
+
+					if l := len("uploads"); len(elem) >= l && elem[0:l] == "uploads" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = CreateUploadOperation
+							r.summary = "Создание сессии возобновляемой загрузки"
+							r.operationID = "createUpload"
+							r.pathPattern = "/api/uploads"
 							r.args = args
-							r.count = 1
+							r.count = 0
 							return r, true
 						default:
 							return
 						}
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "upload_id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = CancelUploadOperation
+								r.summary = "Отмена загрузки"
+								r.operationID = "cancelUpload"
+								r.pathPattern = "/api/uploads/{upload_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "HEAD":
+								r.name = GetUploadOffsetOperation
+								r.summary = "Текущее смещение загрузки"
+								r.operationID = "getUploadOffset"
+								r.pathPattern = "/api/uploads/{upload_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PATCH":
+								r.name = UploadChunkOperation
+								r.summary = "Передача фрагмента файла"
+								r.operationID = "uploadChunk"
+								r.pathPattern = "/api/uploads/{upload_id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/finalize"
+
+							if l := len("/finalize"); len(elem) >= l && elem[0:l] == "/finalize" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = FinalizeUploadOperation
+									r.summary = "Завершение загрузки"
+									r.operationID = "finalizeUpload"
+									r.pathPattern = "/api/uploads/{upload_id}/finalize"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
 
 				}
 
-			case 'r': // Prefix: "register"
+			case 's': // Prefix: "s/"
 
-				if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+				if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
+				// Param: "slug"
+				// Leaf parameter, slashes are prohibited
+				idx := strings.IndexByte(elem, '/')
+				if idx >= 0 {
+					break
+				}
+				args[0] = elem
+				elem = ""
+
 				if len(elem) == 0 {
 					// Leaf node.
 					switch method {
-					case "POST":
-						r.name = RegisterUserOperation
-						r.summary = "Регистрация нового пользователя"
-						r.operationID = "registerUser"
-						r.pathPattern = "/api/register"
+					case "GET":
+						r.name = GetSharedDocumentOperation
+						r.summary = "Документ по публичной ссылке"
+						r.operationID = "getSharedDocument"
+						r.pathPattern = "/s/{slug}"
 						r.args = args
-						r.count = 0
+						r.count = 1
 						return r, true
 					default:
 						return
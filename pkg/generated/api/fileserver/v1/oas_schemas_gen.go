@@ -4,6 +4,7 @@ package fileserver_v1
 
 import (
 	"io"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
@@ -11,6 +12,225 @@ import (
 	ht "github.com/ogen-go/ogen/http"
 )
 
+// Ref: #/components/schemas/api_key_dto
+type APIKeyDto struct {
+	// Идентификатор ключа.
+	ID string `json:"id"`
+	// Название ключа.
+	Name string `json:"name"`
+	// Начало значения ключа для узнавания в списке.
+	Prefix string `json:"prefix"`
+	// Разрешения ключа.
+	Scopes []APIKeyDtoScopesItem `json:"scopes"`
+	// Подсети, из которых разрешено использовать ключ;
+	// пустой список - любой адрес.
+	AllowedCidrs []string `json:"allowed_cidrs"`
+	// Дата и время выпуска.
+	Created string `json:"created"`
+	// Дата и время истечения; отсутствует у бессрочного
+	// ключа.
+	Expires OptString `json:"expires"`
+	// Дата и время последнего использования (с точностью до
+	// минуты).
+	LastUsed OptString `json:"last_used"`
+	// IP-адрес последнего использования.
+	LastUsedIP OptString `json:"last_used_ip"`
+}
+
+// GetID returns the value of ID.
+func (s *APIKeyDto) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *APIKeyDto) GetName() string {
+	return s.Name
+}
+
+// GetPrefix returns the value of Prefix.
+func (s *APIKeyDto) GetPrefix() string {
+	return s.Prefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *APIKeyDto) GetScopes() []APIKeyDtoScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *APIKeyDto) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetCreated returns the value of Created.
+func (s *APIKeyDto) GetCreated() string {
+	return s.Created
+}
+
+// GetExpires returns the value of Expires.
+func (s *APIKeyDto) GetExpires() OptString {
+	return s.Expires
+}
+
+// GetLastUsed returns the value of LastUsed.
+func (s *APIKeyDto) GetLastUsed() OptString {
+	return s.LastUsed
+}
+
+// GetLastUsedIP returns the value of LastUsedIP.
+func (s *APIKeyDto) GetLastUsedIP() OptString {
+	return s.LastUsedIP
+}
+
+// SetID sets the value of ID.
+func (s *APIKeyDto) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *APIKeyDto) SetName(val string) {
+	s.Name = val
+}
+
+// SetPrefix sets the value of Prefix.
+func (s *APIKeyDto) SetPrefix(val string) {
+	s.Prefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *APIKeyDto) SetScopes(val []APIKeyDtoScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *APIKeyDto) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetCreated sets the value of Created.
+func (s *APIKeyDto) SetCreated(val string) {
+	s.Created = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *APIKeyDto) SetExpires(val OptString) {
+	s.Expires = val
+}
+
+// SetLastUsed sets the value of LastUsed.
+func (s *APIKeyDto) SetLastUsed(val OptString) {
+	s.LastUsed = val
+}
+
+// SetLastUsedIP sets the value of LastUsedIP.
+func (s *APIKeyDto) SetLastUsedIP(val OptString) {
+	s.LastUsedIP = val
+}
+
+type APIKeyDtoScopesItem string
+
+const (
+	APIKeyDtoScopesItemDocsRead   APIKeyDtoScopesItem = "docs:read"
+	APIKeyDtoScopesItemDocsWrite  APIKeyDtoScopesItem = "docs:write"
+	APIKeyDtoScopesItemDocsDelete APIKeyDtoScopesItem = "docs:delete"
+	APIKeyDtoScopesItemAdmin      APIKeyDtoScopesItem = "admin"
+)
+
+// AllValues returns all APIKeyDtoScopesItem values.
+func (APIKeyDtoScopesItem) AllValues() []APIKeyDtoScopesItem {
+	return []APIKeyDtoScopesItem{
+		APIKeyDtoScopesItemDocsRead,
+		APIKeyDtoScopesItemDocsWrite,
+		APIKeyDtoScopesItemDocsDelete,
+		APIKeyDtoScopesItemAdmin,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APIKeyDtoScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case APIKeyDtoScopesItemDocsRead:
+		return []byte(s), nil
+	case APIKeyDtoScopesItemDocsWrite:
+		return []byte(s), nil
+	case APIKeyDtoScopesItemDocsDelete:
+		return []byte(s), nil
+	case APIKeyDtoScopesItemAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APIKeyDtoScopesItem) UnmarshalText(data []byte) error {
+	switch APIKeyDtoScopesItem(data) {
+	case APIKeyDtoScopesItemDocsRead:
+		*s = APIKeyDtoScopesItemDocsRead
+		return nil
+	case APIKeyDtoScopesItemDocsWrite:
+		*s = APIKeyDtoScopesItemDocsWrite
+		return nil
+	case APIKeyDtoScopesItemDocsDelete:
+		*s = APIKeyDtoScopesItemDocsDelete
+		return nil
+	case APIKeyDtoScopesItemAdmin:
+		*s = APIKeyDtoScopesItemAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/accepted_response
+type AcceptedResponse struct {
+	Response AcceptedResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *AcceptedResponse) GetResponse() AcceptedResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *AcceptedResponse) SetResponse(val AcceptedResponseResponse) {
+	s.Response = val
+}
+
+func (*AcceptedResponse) requestPasswordResetRes() {}
+
+type AcceptedResponseResponse struct {
+	// Запрос принят к обработке.
+	Accepted bool `json:"accepted"`
+}
+
+// GetAccepted returns the value of Accepted.
+func (s *AcceptedResponseResponse) GetAccepted() bool {
+	return s.Accepted
+}
+
+// SetAccepted sets the value of Accepted.
+func (s *AcceptedResponseResponse) SetAccepted(val bool) {
+	s.Accepted = val
+}
+
+// Ref: #/components/schemas/add_tags_request
+type AddTagsRequest struct {
+	// Добавляемые теги (хранятся в нижнем регистре, уже
+	// назначенные пропускаются).
+	Tags []string `json:"tags"`
+}
+
+// GetTags returns the value of Tags.
+func (s *AddTagsRequest) GetTags() []string {
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *AddTagsRequest) SetTags(val []string) {
+	s.Tags = val
+}
+
 // Ref: #/components/schemas/bad_request_error
 type BadRequestError struct {
 	Error BadRequestErrorError `json:"error"`
@@ -26,9 +246,36 @@ func (s *BadRequestError) SetError(val BadRequestErrorError) {
 	s.Error = val
 }
 
-func (*BadRequestError) createDocumentRes() {}
-func (*BadRequestError) loginUserRes()      {}
-func (*BadRequestError) registerUserRes()   {}
+func (*BadRequestError) addDocumentTagsRes()       {}
+func (*BadRequestError) changePasswordRes()        {}
+func (*BadRequestError) completeOidcLoginRes()     {}
+func (*BadRequestError) confirmPasswordResetRes()  {}
+func (*BadRequestError) confirmTotpRes()           {}
+func (*BadRequestError) createApiKeyRes()          {}
+func (*BadRequestError) createDocumentRes()        {}
+func (*BadRequestError) createDocumentVersionRes() {}
+func (*BadRequestError) createFolderRes()          {}
+func (*BadRequestError) createShareLinkRes()       {}
+func (*BadRequestError) createUploadRes()          {}
+func (*BadRequestError) deleteFolderRes()          {}
+func (*BadRequestError) deleteUserRes()            {}
+func (*BadRequestError) disableTotpRes()           {}
+func (*BadRequestError) finalizeUploadRes()        {}
+func (*BadRequestError) getFolderRes()             {}
+func (*BadRequestError) listDocumentsRes()         {}
+func (*BadRequestError) listFolderRes()            {}
+func (*BadRequestError) listUsersRes()             {}
+func (*BadRequestError) loginUserRes()             {}
+func (*BadRequestError) registerUserRes()          {}
+func (*BadRequestError) removeDocumentTagRes()     {}
+func (*BadRequestError) replaceDocumentRes()       {}
+func (*BadRequestError) restoreDocumentRes()       {}
+func (*BadRequestError) searchDocumentsRes()       {}
+func (*BadRequestError) updateDocumentRes()        {}
+func (*BadRequestError) updateFolderRes()          {}
+func (*BadRequestError) updateUserRes()            {}
+func (*BadRequestError) uploadChunkRes()           {}
+func (*BadRequestError) verifySecondFactorRes()    {}
 
 type BadRequestErrorError struct {
 	Code int    `json:"code"`
@@ -55,6 +302,302 @@ func (s *BadRequestErrorError) SetText(val string) {
 	s.Text = val
 }
 
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// CancelUploadNoContent is response for CancelUpload operation.
+type CancelUploadNoContent struct{}
+
+func (*CancelUploadNoContent) cancelUploadRes() {}
+
+// Ref: #/components/schemas/change_password_request
+type ChangePasswordRequest struct {
+	// Текущий пароль.
+	Pswd string `json:"pswd"`
+	// Новый пароль (те же требования, что при регистрации).
+	NewPswd string `json:"new_pswd"`
+}
+
+// GetPswd returns the value of Pswd.
+func (s *ChangePasswordRequest) GetPswd() string {
+	return s.Pswd
+}
+
+// GetNewPswd returns the value of NewPswd.
+func (s *ChangePasswordRequest) GetNewPswd() string {
+	return s.NewPswd
+}
+
+// SetPswd sets the value of Pswd.
+func (s *ChangePasswordRequest) SetPswd(val string) {
+	s.Pswd = val
+}
+
+// SetNewPswd sets the value of NewPswd.
+func (s *ChangePasswordRequest) SetNewPswd(val string) {
+	s.NewPswd = val
+}
+
+// Ref: #/components/schemas/change_password_response
+type ChangePasswordResponse struct {
+	Data ChangePasswordResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ChangePasswordResponse) GetData() ChangePasswordResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ChangePasswordResponse) SetData(val ChangePasswordResponseData) {
+	s.Data = val
+}
+
+func (*ChangePasswordResponse) changePasswordRes()       {}
+func (*ChangePasswordResponse) confirmPasswordResetRes() {}
+
+type ChangePasswordResponseData struct {
+	// Количество завершенных сессий.
+	RevokedSessions int `json:"revoked_sessions"`
+}
+
+// GetRevokedSessions returns the value of RevokedSessions.
+func (s *ChangePasswordResponseData) GetRevokedSessions() int {
+	return s.RevokedSessions
+}
+
+// SetRevokedSessions sets the value of RevokedSessions.
+func (s *ChangePasswordResponseData) SetRevokedSessions(val int) {
+	s.RevokedSessions = val
+}
+
+// Ref: #/components/schemas/conflict_error
+type ConflictError struct {
+	Error ConflictErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *ConflictError) GetError() ConflictErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *ConflictError) SetError(val ConflictErrorError) {
+	s.Error = val
+}
+
+func (*ConflictError) confirmTotpRes()           {}
+func (*ConflictError) createDocumentRes()        {}
+func (*ConflictError) createDocumentVersionRes() {}
+func (*ConflictError) createFolderRes()          {}
+func (*ConflictError) deleteFolderRes()          {}
+func (*ConflictError) deleteUserRes()            {}
+func (*ConflictError) enrollTotpRes()            {}
+func (*ConflictError) finalizeUploadRes()        {}
+func (*ConflictError) replaceDocumentRes()       {}
+func (*ConflictError) updateDocumentRes()        {}
+func (*ConflictError) updateFolderRes()          {}
+func (*ConflictError) uploadChunkRes()           {}
+
+type ConflictErrorError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+}
+
+// GetCode returns the value of Code.
+func (s *ConflictErrorError) GetCode() int {
+	return s.Code
+}
+
+// GetText returns the value of Text.
+func (s *ConflictErrorError) GetText() string {
+	return s.Text
+}
+
+// SetCode sets the value of Code.
+func (s *ConflictErrorError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetText sets the value of Text.
+func (s *ConflictErrorError) SetText(val string) {
+	s.Text = val
+}
+
+// Ref: #/components/schemas/create_api_key_request
+type CreateAPIKeyRequest struct {
+	// Название ключа.
+	Name string `json:"name"`
+	// Разрешения ключа; admin доступно только администраторам.
+	Scopes []CreateAPIKeyRequestScopesItem `json:"scopes"`
+	// Подсети или адреса, из которых разрешено
+	// использовать ключ; по умолчанию любой адрес.
+	AllowedCidrs []string `json:"allowed_cidrs"`
+	// Момент истечения ключа (RFC 3339); по умолчанию ключ
+	// бессрочный.
+	Expires OptDateTime `json:"expires"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateAPIKeyRequest) GetName() string {
+	return s.Name
+}
+
+// GetScopes returns the value of Scopes.
+func (s *CreateAPIKeyRequest) GetScopes() []CreateAPIKeyRequestScopesItem {
+	return s.Scopes
+}
+
+// GetAllowedCidrs returns the value of AllowedCidrs.
+func (s *CreateAPIKeyRequest) GetAllowedCidrs() []string {
+	return s.AllowedCidrs
+}
+
+// GetExpires returns the value of Expires.
+func (s *CreateAPIKeyRequest) GetExpires() OptDateTime {
+	return s.Expires
+}
+
+// SetName sets the value of Name.
+func (s *CreateAPIKeyRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *CreateAPIKeyRequest) SetScopes(val []CreateAPIKeyRequestScopesItem) {
+	s.Scopes = val
+}
+
+// SetAllowedCidrs sets the value of AllowedCidrs.
+func (s *CreateAPIKeyRequest) SetAllowedCidrs(val []string) {
+	s.AllowedCidrs = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *CreateAPIKeyRequest) SetExpires(val OptDateTime) {
+	s.Expires = val
+}
+
+type CreateAPIKeyRequestScopesItem string
+
+const (
+	CreateAPIKeyRequestScopesItemDocsRead   CreateAPIKeyRequestScopesItem = "docs:read"
+	CreateAPIKeyRequestScopesItemDocsWrite  CreateAPIKeyRequestScopesItem = "docs:write"
+	CreateAPIKeyRequestScopesItemDocsDelete CreateAPIKeyRequestScopesItem = "docs:delete"
+	CreateAPIKeyRequestScopesItemAdmin      CreateAPIKeyRequestScopesItem = "admin"
+)
+
+// AllValues returns all CreateAPIKeyRequestScopesItem values.
+func (CreateAPIKeyRequestScopesItem) AllValues() []CreateAPIKeyRequestScopesItem {
+	return []CreateAPIKeyRequestScopesItem{
+		CreateAPIKeyRequestScopesItemDocsRead,
+		CreateAPIKeyRequestScopesItemDocsWrite,
+		CreateAPIKeyRequestScopesItemDocsDelete,
+		CreateAPIKeyRequestScopesItemAdmin,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateAPIKeyRequestScopesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateAPIKeyRequestScopesItemDocsRead:
+		return []byte(s), nil
+	case CreateAPIKeyRequestScopesItemDocsWrite:
+		return []byte(s), nil
+	case CreateAPIKeyRequestScopesItemDocsDelete:
+		return []byte(s), nil
+	case CreateAPIKeyRequestScopesItemAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateAPIKeyRequestScopesItem) UnmarshalText(data []byte) error {
+	switch CreateAPIKeyRequestScopesItem(data) {
+	case CreateAPIKeyRequestScopesItemDocsRead:
+		*s = CreateAPIKeyRequestScopesItemDocsRead
+		return nil
+	case CreateAPIKeyRequestScopesItemDocsWrite:
+		*s = CreateAPIKeyRequestScopesItemDocsWrite
+		return nil
+	case CreateAPIKeyRequestScopesItemDocsDelete:
+		*s = CreateAPIKeyRequestScopesItemDocsDelete
+		return nil
+	case CreateAPIKeyRequestScopesItemAdmin:
+		*s = CreateAPIKeyRequestScopesItemAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/create_api_key_response
+type CreateAPIKeyResponse struct {
+	Data CreateAPIKeyResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *CreateAPIKeyResponse) GetData() CreateAPIKeyResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *CreateAPIKeyResponse) SetData(val CreateAPIKeyResponseData) {
+	s.Data = val
+}
+
+func (*CreateAPIKeyResponse) createApiKeyRes() {}
+
+type CreateAPIKeyResponseData struct {
+	// Значение ключа; показывается только один раз.
+	Key    string    `json:"key"`
+	APIKey APIKeyDto `json:"api_key"`
+}
+
+// GetKey returns the value of Key.
+func (s *CreateAPIKeyResponseData) GetKey() string {
+	return s.Key
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *CreateAPIKeyResponseData) GetAPIKey() APIKeyDto {
+	return s.APIKey
+}
+
+// SetKey sets the value of Key.
+func (s *CreateAPIKeyResponseData) SetKey(val string) {
+	s.Key = val
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *CreateAPIKeyResponseData) SetAPIKey(val APIKeyDto) {
+	s.APIKey = val
+}
+
 // Ref: #/components/schemas/create_document_request
 type CreateDocumentRequestMultipart struct {
 	Meta Meta `json:"meta"`
@@ -162,8 +705,234 @@ func (s *CreateDocumentResponseDataJSON) init() CreateDocumentResponseDataJSON {
 	return m
 }
 
-// Ref: #/components/schemas/delete_document_response
-type DeleteDocumentResponse struct {
+// Ref: #/components/schemas/create_folder_request
+type CreateFolderRequest struct {
+	// Имя папки (уникально среди папок родителя).
+	Name string `json:"name"`
+	// ID родительской папки (без поля - корень).
+	Parent OptString `json:"parent"`
+	// Содержимое папки доступно всем.
+	Public OptBool `json:"public"`
+	// Логины пользователей с доступом к содержимому папки.
+	Grant []string `json:"grant"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateFolderRequest) GetName() string {
+	return s.Name
+}
+
+// GetParent returns the value of Parent.
+func (s *CreateFolderRequest) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPublic returns the value of Public.
+func (s *CreateFolderRequest) GetPublic() OptBool {
+	return s.Public
+}
+
+// GetGrant returns the value of Grant.
+func (s *CreateFolderRequest) GetGrant() []string {
+	return s.Grant
+}
+
+// SetName sets the value of Name.
+func (s *CreateFolderRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetParent sets the value of Parent.
+func (s *CreateFolderRequest) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPublic sets the value of Public.
+func (s *CreateFolderRequest) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetGrant sets the value of Grant.
+func (s *CreateFolderRequest) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// Ref: #/components/schemas/create_share_link_request
+type CreateShareLinkRequest struct {
+	// Пароль для открытия ссылки; по умолчанию ссылка без
+	// пароля.
+	Password OptString `json:"password"`
+	// Момент истечения ссылки (RFC 3339); по умолчанию ссылка
+	// бессрочная.
+	Expires OptDateTime `json:"expires"`
+	// Максимальное число скачиваний по ссылке; по
+	// умолчанию без ограничения.
+	MaxDownloads OptInt `json:"max_downloads"`
+}
+
+// GetPassword returns the value of Password.
+func (s *CreateShareLinkRequest) GetPassword() OptString {
+	return s.Password
+}
+
+// GetExpires returns the value of Expires.
+func (s *CreateShareLinkRequest) GetExpires() OptDateTime {
+	return s.Expires
+}
+
+// GetMaxDownloads returns the value of MaxDownloads.
+func (s *CreateShareLinkRequest) GetMaxDownloads() OptInt {
+	return s.MaxDownloads
+}
+
+// SetPassword sets the value of Password.
+func (s *CreateShareLinkRequest) SetPassword(val OptString) {
+	s.Password = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *CreateShareLinkRequest) SetExpires(val OptDateTime) {
+	s.Expires = val
+}
+
+// SetMaxDownloads sets the value of MaxDownloads.
+func (s *CreateShareLinkRequest) SetMaxDownloads(val OptInt) {
+	s.MaxDownloads = val
+}
+
+// Ref: #/components/schemas/create_upload_request
+type CreateUploadRequest struct {
+	// Имя будущего документа.
+	Name string `json:"name"`
+	// MIME тип файла.
+	Mime string `json:"mime"`
+	// Полный размер файла в байтах.
+	Size int64 `json:"size"`
+	// Является ли документ публичным.
+	Public OptBool `json:"public"`
+	// Список логинов пользователей, которым предоставлен
+	// доступ.
+	Grant []string `json:"grant"`
+	// ID папки владельца для будущего документа (без поля -
+	// корень).
+	Folder OptString `json:"folder"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateUploadRequest) GetName() string {
+	return s.Name
+}
+
+// GetMime returns the value of Mime.
+func (s *CreateUploadRequest) GetMime() string {
+	return s.Mime
+}
+
+// GetSize returns the value of Size.
+func (s *CreateUploadRequest) GetSize() int64 {
+	return s.Size
+}
+
+// GetPublic returns the value of Public.
+func (s *CreateUploadRequest) GetPublic() OptBool {
+	return s.Public
+}
+
+// GetGrant returns the value of Grant.
+func (s *CreateUploadRequest) GetGrant() []string {
+	return s.Grant
+}
+
+// GetFolder returns the value of Folder.
+func (s *CreateUploadRequest) GetFolder() OptString {
+	return s.Folder
+}
+
+// SetName sets the value of Name.
+func (s *CreateUploadRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetMime sets the value of Mime.
+func (s *CreateUploadRequest) SetMime(val string) {
+	s.Mime = val
+}
+
+// SetSize sets the value of Size.
+func (s *CreateUploadRequest) SetSize(val int64) {
+	s.Size = val
+}
+
+// SetPublic sets the value of Public.
+func (s *CreateUploadRequest) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetGrant sets the value of Grant.
+func (s *CreateUploadRequest) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// SetFolder sets the value of Folder.
+func (s *CreateUploadRequest) SetFolder(val OptString) {
+	s.Folder = val
+}
+
+// Ref: #/components/schemas/create_version_request
+type CreateVersionRequestMultipart struct {
+	// MIME тип новой версии (если не указан - сохраняется
+	// текущий).
+	Mime OptString `json:"mime"`
+	// JSON данные новой версии (для JSON документов).
+	JSON OptCreateVersionRequestMultipartJSON `json:"json"`
+	// Новое содержимое файла (для файловых документов).
+	File OptMultipartFile `json:"file"`
+}
+
+// GetMime returns the value of Mime.
+func (s *CreateVersionRequestMultipart) GetMime() OptString {
+	return s.Mime
+}
+
+// GetJSON returns the value of JSON.
+func (s *CreateVersionRequestMultipart) GetJSON() OptCreateVersionRequestMultipartJSON {
+	return s.JSON
+}
+
+// GetFile returns the value of File.
+func (s *CreateVersionRequestMultipart) GetFile() OptMultipartFile {
+	return s.File
+}
+
+// SetMime sets the value of Mime.
+func (s *CreateVersionRequestMultipart) SetMime(val OptString) {
+	s.Mime = val
+}
+
+// SetJSON sets the value of JSON.
+func (s *CreateVersionRequestMultipart) SetJSON(val OptCreateVersionRequestMultipartJSON) {
+	s.JSON = val
+}
+
+// SetFile sets the value of File.
+func (s *CreateVersionRequestMultipart) SetFile(val OptMultipartFile) {
+	s.File = val
+}
+
+// JSON данные новой версии (для JSON документов).
+type CreateVersionRequestMultipartJSON map[string]jx.Raw
+
+func (s *CreateVersionRequestMultipartJSON) init() CreateVersionRequestMultipartJSON {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/delete_document_response
+type DeleteDocumentResponse struct {
 	// Результат удаления документа (ID документа -> true).
 	Response DeleteDocumentResponseResponse `json:"response"`
 }
@@ -192,6 +961,107 @@ func (s *DeleteDocumentResponseResponse) init() DeleteDocumentResponseResponse {
 	return m
 }
 
+// Ref: #/components/schemas/delete_folder_response
+type DeleteFolderResponse struct {
+	Response DeleteFolderResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *DeleteFolderResponse) GetResponse() DeleteFolderResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *DeleteFolderResponse) SetResponse(val DeleteFolderResponseResponse) {
+	s.Response = val
+}
+
+func (*DeleteFolderResponse) deleteFolderRes() {}
+
+type DeleteFolderResponseResponse struct {
+	// ID удаленных папок.
+	Folders []string `json:"folders"`
+	// ID документов, перемещенных в корзину.
+	Docs []string `json:"docs"`
+}
+
+// GetFolders returns the value of Folders.
+func (s *DeleteFolderResponseResponse) GetFolders() []string {
+	return s.Folders
+}
+
+// GetDocs returns the value of Docs.
+func (s *DeleteFolderResponseResponse) GetDocs() []string {
+	return s.Docs
+}
+
+// SetFolders sets the value of Folders.
+func (s *DeleteFolderResponseResponse) SetFolders(val []string) {
+	s.Folders = val
+}
+
+// SetDocs sets the value of Docs.
+func (s *DeleteFolderResponseResponse) SetDocs(val []string) {
+	s.Docs = val
+}
+
+// Ref: #/components/schemas/delete_user_response
+type DeleteUserResponse struct {
+	Data DeleteUserResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *DeleteUserResponse) GetData() DeleteUserResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *DeleteUserResponse) SetData(val DeleteUserResponseData) {
+	s.Data = val
+}
+
+func (*DeleteUserResponse) deleteUserRes() {}
+
+type DeleteUserResponseData struct {
+	// ID удаленного пользователя.
+	ID string `json:"id"`
+	// Количество удаленных документов.
+	DeletedDocuments int `json:"deleted_documents"`
+	// Количество документов, переданных другому
+	// пользователю.
+	TransferredDocuments int `json:"transferred_documents"`
+}
+
+// GetID returns the value of ID.
+func (s *DeleteUserResponseData) GetID() string {
+	return s.ID
+}
+
+// GetDeletedDocuments returns the value of DeletedDocuments.
+func (s *DeleteUserResponseData) GetDeletedDocuments() int {
+	return s.DeletedDocuments
+}
+
+// GetTransferredDocuments returns the value of TransferredDocuments.
+func (s *DeleteUserResponseData) GetTransferredDocuments() int {
+	return s.TransferredDocuments
+}
+
+// SetID sets the value of ID.
+func (s *DeleteUserResponseData) SetID(val string) {
+	s.ID = val
+}
+
+// SetDeletedDocuments sets the value of DeletedDocuments.
+func (s *DeleteUserResponseData) SetDeletedDocuments(val int) {
+	s.DeletedDocuments = val
+}
+
+// SetTransferredDocuments sets the value of TransferredDocuments.
+func (s *DeleteUserResponseData) SetTransferredDocuments(val int) {
+	s.TransferredDocuments = val
+}
+
 // Ref: #/components/schemas/document_dto
 type DocumentDto struct {
 	// Уникальный идентификатор документа.
@@ -206,6 +1076,24 @@ type DocumentDto struct {
 	Public bool `json:"public"`
 	// Дата и время создания документа.
 	Created string `json:"created"`
+	// Номер текущей версии документа.
+	Version OptInt `json:"version"`
+	// Размер содержимого текущей версии в байтах.
+	Size OptInt64 `json:"size"`
+	// SHA-256 содержимого текущей версии в hex (только для
+	// файлов).
+	Digest OptString `json:"digest"`
+	// ID папки документа (отсутствует для документов вне
+	// папок).
+	Folder OptString `json:"folder"`
+	// Теги документа по алфавиту.
+	Tags []string `json:"tags"`
+	// Пользовательские метаданные (строки, числа,
+	// логические значения и даты в RFC 3339 UTC).
+	Metadata OptDocumentDtoMetadata `json:"metadata"`
+	// Дата и время перемещения в корзину (только для
+	// документов в корзине).
+	Deleted OptString `json:"deleted"`
 	// Список логинов пользователей с доступом.
 	Grant []string `json:"grant"`
 }
@@ -240,6 +1128,41 @@ func (s *DocumentDto) GetCreated() string {
 	return s.Created
 }
 
+// GetVersion returns the value of Version.
+func (s *DocumentDto) GetVersion() OptInt {
+	return s.Version
+}
+
+// GetSize returns the value of Size.
+func (s *DocumentDto) GetSize() OptInt64 {
+	return s.Size
+}
+
+// GetDigest returns the value of Digest.
+func (s *DocumentDto) GetDigest() OptString {
+	return s.Digest
+}
+
+// GetFolder returns the value of Folder.
+func (s *DocumentDto) GetFolder() OptString {
+	return s.Folder
+}
+
+// GetTags returns the value of Tags.
+func (s *DocumentDto) GetTags() []string {
+	return s.Tags
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *DocumentDto) GetMetadata() OptDocumentDtoMetadata {
+	return s.Metadata
+}
+
+// GetDeleted returns the value of Deleted.
+func (s *DocumentDto) GetDeleted() OptString {
+	return s.Deleted
+}
+
 // GetGrant returns the value of Grant.
 func (s *DocumentDto) GetGrant() []string {
 	return s.Grant
@@ -275,889 +1198,3873 @@ func (s *DocumentDto) SetCreated(val string) {
 	s.Created = val
 }
 
-// SetGrant sets the value of Grant.
-func (s *DocumentDto) SetGrant(val []string) {
-	s.Grant = val
+// SetVersion sets the value of Version.
+func (s *DocumentDto) SetVersion(val OptInt) {
+	s.Version = val
 }
 
-// Ref: #/components/schemas/forbidden_error
-type ForbiddenError struct {
-	Error ForbiddenErrorError `json:"error"`
+// SetSize sets the value of Size.
+func (s *DocumentDto) SetSize(val OptInt64) {
+	s.Size = val
 }
 
-// GetError returns the value of Error.
-func (s *ForbiddenError) GetError() ForbiddenErrorError {
-	return s.Error
+// SetDigest sets the value of Digest.
+func (s *DocumentDto) SetDigest(val OptString) {
+	s.Digest = val
 }
 
-// SetError sets the value of Error.
-func (s *ForbiddenError) SetError(val ForbiddenErrorError) {
-	s.Error = val
+// SetFolder sets the value of Folder.
+func (s *DocumentDto) SetFolder(val OptString) {
+	s.Folder = val
 }
 
-func (*ForbiddenError) deleteDocumentRes() {}
-func (*ForbiddenError) getDocumentRes()    {}
+// SetTags sets the value of Tags.
+func (s *DocumentDto) SetTags(val []string) {
+	s.Tags = val
+}
 
-type ForbiddenErrorError struct {
-	Code int    `json:"code"`
-	Text string `json:"text"`
+// SetMetadata sets the value of Metadata.
+func (s *DocumentDto) SetMetadata(val OptDocumentDtoMetadata) {
+	s.Metadata = val
 }
 
-// GetCode returns the value of Code.
-func (s *ForbiddenErrorError) GetCode() int {
-	return s.Code
+// SetDeleted sets the value of Deleted.
+func (s *DocumentDto) SetDeleted(val OptString) {
+	s.Deleted = val
 }
 
-// GetText returns the value of Text.
-func (s *ForbiddenErrorError) GetText() string {
-	return s.Text
+// SetGrant sets the value of Grant.
+func (s *DocumentDto) SetGrant(val []string) {
+	s.Grant = val
 }
 
-// SetCode sets the value of Code.
-func (s *ForbiddenErrorError) SetCode(val int) {
-	s.Code = val
+// Пользовательские метаданные (строки, числа,
+// логические значения и даты в RFC 3339 UTC).
+type DocumentDtoMetadata map[string]jx.Raw
+
+func (s *DocumentDtoMetadata) init() DocumentDtoMetadata {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
 }
 
-// SetText sets the value of Text.
-func (s *ForbiddenErrorError) SetText(val string) {
-	s.Text = val
+// Ref: #/components/schemas/document_version_dto
+type DocumentVersionDto struct {
+	// Номер версии.
+	Version int `json:"version"`
+	// Размер содержимого версии в байтах.
+	Size int64 `json:"size"`
+	// SHA-256 содержимого версии в hex (только для файлов).
+	Digest OptString `json:"digest"`
+	// MIME тип версии.
+	Mime string `json:"mime"`
+	// Логин пользователя, создавшего версию.
+	Author string `json:"author"`
+	// Дата и время создания версии.
+	Created string `json:"created"`
+	// Является ли версия текущей.
+	Current bool `json:"current"`
 }
 
-// GetDocumentHeadForbidden is response for GetDocumentHead operation.
-type GetDocumentHeadForbidden struct{}
+// GetVersion returns the value of Version.
+func (s *DocumentVersionDto) GetVersion() int {
+	return s.Version
+}
 
-func (*GetDocumentHeadForbidden) getDocumentHeadRes() {}
+// GetSize returns the value of Size.
+func (s *DocumentVersionDto) GetSize() int64 {
+	return s.Size
+}
 
-// GetDocumentHeadInternalServerError is response for GetDocumentHead operation.
-type GetDocumentHeadInternalServerError struct{}
+// GetDigest returns the value of Digest.
+func (s *DocumentVersionDto) GetDigest() OptString {
+	return s.Digest
+}
 
-func (*GetDocumentHeadInternalServerError) getDocumentHeadRes() {}
+// GetMime returns the value of Mime.
+func (s *DocumentVersionDto) GetMime() string {
+	return s.Mime
+}
 
-// GetDocumentHeadNotFound is response for GetDocumentHead operation.
-type GetDocumentHeadNotFound struct{}
+// GetAuthor returns the value of Author.
+func (s *DocumentVersionDto) GetAuthor() string {
+	return s.Author
+}
 
-func (*GetDocumentHeadNotFound) getDocumentHeadRes() {}
+// GetCreated returns the value of Created.
+func (s *DocumentVersionDto) GetCreated() string {
+	return s.Created
+}
 
-// GetDocumentHeadOK is response for GetDocumentHead operation.
-type GetDocumentHeadOK struct{}
+// GetCurrent returns the value of Current.
+func (s *DocumentVersionDto) GetCurrent() bool {
+	return s.Current
+}
 
-func (*GetDocumentHeadOK) getDocumentHeadRes() {}
+// SetVersion sets the value of Version.
+func (s *DocumentVersionDto) SetVersion(val int) {
+	s.Version = val
+}
 
-// GetDocumentHeadUnauthorized is response for GetDocumentHead operation.
-type GetDocumentHeadUnauthorized struct{}
+// SetSize sets the value of Size.
+func (s *DocumentVersionDto) SetSize(val int64) {
+	s.Size = val
+}
 
-func (*GetDocumentHeadUnauthorized) getDocumentHeadRes() {}
+// SetDigest sets the value of Digest.
+func (s *DocumentVersionDto) SetDigest(val OptString) {
+	s.Digest = val
+}
 
-type GetDocumentOKApplicationOctetStream struct {
-	Data io.Reader
+// SetMime sets the value of Mime.
+func (s *DocumentVersionDto) SetMime(val string) {
+	s.Mime = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetDocumentOKApplicationOctetStream) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetAuthor sets the value of Author.
+func (s *DocumentVersionDto) SetAuthor(val string) {
+	s.Author = val
 }
 
-func (*GetDocumentOKApplicationOctetStream) getDocumentRes() {}
+// SetCreated sets the value of Created.
+func (s *DocumentVersionDto) SetCreated(val string) {
+	s.Created = val
+}
 
-// Ref: #/components/schemas/get_document_response
-type GetDocumentResponse struct {
-	// JSON данные документа.
-	Data GetDocumentResponseData `json:"data"`
+// SetCurrent sets the value of Current.
+func (s *DocumentVersionDto) SetCurrent(val bool) {
+	s.Current = val
+}
+
+// Ref: #/components/schemas/document_version_response
+type DocumentVersionResponse struct {
+	Data DocumentVersionDto `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *GetDocumentResponse) GetData() GetDocumentResponseData {
+func (s *DocumentVersionResponse) GetData() DocumentVersionDto {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *GetDocumentResponse) SetData(val GetDocumentResponseData) {
+func (s *DocumentVersionResponse) SetData(val DocumentVersionDto) {
 	s.Data = val
 }
 
-func (*GetDocumentResponse) getDocumentRes() {}
+func (*DocumentVersionResponse) createDocumentVersionRes()  {}
+func (*DocumentVersionResponse) restoreDocumentVersionRes() {}
 
-// JSON данные документа.
-type GetDocumentResponseData map[string]jx.Raw
+// Ref: #/components/schemas/empty_trash_response
+type EmptyTrashResponse struct {
+	Response EmptyTrashResponseResponse `json:"response"`
+}
 
-func (s *GetDocumentResponseData) init() GetDocumentResponseData {
-	m := *s
-	if m == nil {
-		m = map[string]jx.Raw{}
-		*s = m
-	}
-	return m
+// GetResponse returns the value of Response.
+func (s *EmptyTrashResponse) GetResponse() EmptyTrashResponseResponse {
+	return s.Response
 }
 
-// Ref: #/components/schemas/internal_server_error
-type InternalServerError struct {
-	Error InternalServerErrorError `json:"error"`
+// SetResponse sets the value of Response.
+func (s *EmptyTrashResponse) SetResponse(val EmptyTrashResponseResponse) {
+	s.Response = val
 }
 
-// GetError returns the value of Error.
-func (s *InternalServerError) GetError() InternalServerErrorError {
-	return s.Error
+func (*EmptyTrashResponse) emptyTrashRes() {}
+
+type EmptyTrashResponseResponse struct {
+	// ID окончательно удаленных документов.
+	Docs []string `json:"docs"`
 }
 
-// SetError sets the value of Error.
-func (s *InternalServerError) SetError(val InternalServerErrorError) {
-	s.Error = val
+// GetDocs returns the value of Docs.
+func (s *EmptyTrashResponseResponse) GetDocs() []string {
+	return s.Docs
 }
 
-func (*InternalServerError) createDocumentRes() {}
-func (*InternalServerError) deleteDocumentRes() {}
-func (*InternalServerError) getDocumentRes()    {}
-func (*InternalServerError) listDocumentsRes()  {}
-func (*InternalServerError) loginUserRes()      {}
-func (*InternalServerError) logoutUserRes()     {}
-func (*InternalServerError) registerUserRes()   {}
+// SetDocs sets the value of Docs.
+func (s *EmptyTrashResponseResponse) SetDocs(val []string) {
+	s.Docs = val
+}
 
-type InternalServerErrorError struct {
-	Code int    `json:"code"`
-	Text string `json:"text"`
+// Ref: #/components/schemas/finalize_upload_response
+type FinalizeUploadResponse struct {
+	Data DocumentDto `json:"data"`
 }
 
-// GetCode returns the value of Code.
-func (s *InternalServerErrorError) GetCode() int {
-	return s.Code
+// GetData returns the value of Data.
+func (s *FinalizeUploadResponse) GetData() DocumentDto {
+	return s.Data
 }
 
-// GetText returns the value of Text.
-func (s *InternalServerErrorError) GetText() string {
-	return s.Text
+// SetData sets the value of Data.
+func (s *FinalizeUploadResponse) SetData(val DocumentDto) {
+	s.Data = val
 }
 
-// SetCode sets the value of Code.
-func (s *InternalServerErrorError) SetCode(val int) {
-	s.Code = val
+func (*FinalizeUploadResponse) finalizeUploadRes() {}
+
+// Ref: #/components/schemas/folder_dto
+type FolderDto struct {
+	// Уникальный идентификатор папки.
+	ID string `json:"id"`
+	// Имя папки.
+	Name string `json:"name"`
+	// ID родительской папки (отсутствует для папок в корне).
+	Parent OptString `json:"parent"`
+	// Путь папки из имен от корня владельца.
+	Path string `json:"path"`
+	// Содержимое папки доступно всем (наследуется
+	// вложенными папками и документами).
+	Public bool `json:"public"`
+	// Логины пользователей с доступом к содержимому папки
+	// (наследуется вложенными папками и документами).
+	Grant []string `json:"grant"`
+	// Дата и время создания папки.
+	Created string `json:"created"`
 }
 
-// SetText sets the value of Text.
-func (s *InternalServerErrorError) SetText(val string) {
-	s.Text = val
+// GetID returns the value of ID.
+func (s *FolderDto) GetID() string {
+	return s.ID
 }
 
-type Key string
+// GetName returns the value of Name.
+func (s *FolderDto) GetName() string {
+	return s.Name
+}
 
-const (
-	KeyName    Key = "name"
-	KeyMime    Key = "mime"
-	KeyPublic  Key = "public"
-	KeyFile    Key = "file"
-	KeyCreated Key = "created"
-)
+// GetParent returns the value of Parent.
+func (s *FolderDto) GetParent() OptString {
+	return s.Parent
+}
 
-// AllValues returns all Key values.
-func (Key) AllValues() []Key {
-	return []Key{
-		KeyName,
-		KeyMime,
-		KeyPublic,
-		KeyFile,
-		KeyCreated,
-	}
+// GetPath returns the value of Path.
+func (s *FolderDto) GetPath() string {
+	return s.Path
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s Key) MarshalText() ([]byte, error) {
-	switch s {
-	case KeyName:
-		return []byte(s), nil
-	case KeyMime:
-		return []byte(s), nil
-	case KeyPublic:
-		return []byte(s), nil
-	case KeyFile:
-		return []byte(s), nil
-	case KeyCreated:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetPublic returns the value of Public.
+func (s *FolderDto) GetPublic() bool {
+	return s.Public
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *Key) UnmarshalText(data []byte) error {
-	switch Key(data) {
-	case KeyName:
-		*s = KeyName
-		return nil
-	case KeyMime:
-		*s = KeyMime
-		return nil
-	case KeyPublic:
-		*s = KeyPublic
-		return nil
-	case KeyFile:
-		*s = KeyFile
-		return nil
-	case KeyCreated:
-		*s = KeyCreated
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetGrant returns the value of Grant.
+func (s *FolderDto) GetGrant() []string {
+	return s.Grant
 }
 
-// ListDocumentsHeadInternalServerError is response for ListDocumentsHead operation.
-type ListDocumentsHeadInternalServerError struct{}
+// GetCreated returns the value of Created.
+func (s *FolderDto) GetCreated() string {
+	return s.Created
+}
 
-func (*ListDocumentsHeadInternalServerError) listDocumentsHeadRes() {}
+// SetID sets the value of ID.
+func (s *FolderDto) SetID(val string) {
+	s.ID = val
+}
 
-// ListDocumentsHeadOK is response for ListDocumentsHead operation.
-type ListDocumentsHeadOK struct{}
+// SetName sets the value of Name.
+func (s *FolderDto) SetName(val string) {
+	s.Name = val
+}
 
-func (*ListDocumentsHeadOK) listDocumentsHeadRes() {}
+// SetParent sets the value of Parent.
+func (s *FolderDto) SetParent(val OptString) {
+	s.Parent = val
+}
 
-// ListDocumentsHeadUnauthorized is response for ListDocumentsHead operation.
-type ListDocumentsHeadUnauthorized struct{}
+// SetPath sets the value of Path.
+func (s *FolderDto) SetPath(val string) {
+	s.Path = val
+}
 
-func (*ListDocumentsHeadUnauthorized) listDocumentsHeadRes() {}
+// SetPublic sets the value of Public.
+func (s *FolderDto) SetPublic(val bool) {
+	s.Public = val
+}
 
-// Ref: #/components/schemas/list_documents_response
-type ListDocumentsResponse struct {
-	Data ListDocumentsResponseData `json:"data"`
+// SetGrant sets the value of Grant.
+func (s *FolderDto) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// SetCreated sets the value of Created.
+func (s *FolderDto) SetCreated(val string) {
+	s.Created = val
+}
+
+// Ref: #/components/schemas/folder_listing_response
+type FolderListingResponse struct {
+	Data FolderListingResponseData `json:"data"`
 }
 
 // GetData returns the value of Data.
-func (s *ListDocumentsResponse) GetData() ListDocumentsResponseData {
+func (s *FolderListingResponse) GetData() FolderListingResponseData {
 	return s.Data
 }
 
 // SetData sets the value of Data.
-func (s *ListDocumentsResponse) SetData(val ListDocumentsResponseData) {
+func (s *FolderListingResponse) SetData(val FolderListingResponseData) {
 	s.Data = val
 }
 
-func (*ListDocumentsResponse) listDocumentsRes() {}
+func (*FolderListingResponse) listFolderRes() {}
 
-type ListDocumentsResponseData struct {
-	// Список документов.
+type FolderListingResponseData struct {
+	Folder OptFolderDto `json:"folder"`
+	// Вложенные папки по имени.
+	Folders []FolderDto `json:"folders"`
+	// Страница документов папки.
 	Docs []DocumentDto `json:"docs"`
+	// Курсор следующей страницы документов (отсутствует на
+	// последней странице).
+	NextCursor OptString `json:"next_cursor"`
+	// Размер страницы.
+	Limit int `json:"limit"`
 }
 
-// GetDocs returns the value of Docs.
-func (s *ListDocumentsResponseData) GetDocs() []DocumentDto {
-	return s.Docs
+// GetFolder returns the value of Folder.
+func (s *FolderListingResponseData) GetFolder() OptFolderDto {
+	return s.Folder
 }
 
-// SetDocs sets the value of Docs.
-func (s *ListDocumentsResponseData) SetDocs(val []DocumentDto) {
-	s.Docs = val
+// GetFolders returns the value of Folders.
+func (s *FolderListingResponseData) GetFolders() []FolderDto {
+	return s.Folders
 }
 
-// Ref: #/components/schemas/login_request
-type LoginRequest struct {
-	// Логин пользователя.
-	Login string `json:"login"`
-	// Пароль пользователя.
-	Pswd string `json:"pswd"`
+// GetDocs returns the value of Docs.
+func (s *FolderListingResponseData) GetDocs() []DocumentDto {
+	return s.Docs
 }
 
-// GetLogin returns the value of Login.
-func (s *LoginRequest) GetLogin() string {
-	return s.Login
+// GetNextCursor returns the value of NextCursor.
+func (s *FolderListingResponseData) GetNextCursor() OptString {
+	return s.NextCursor
 }
 
-// GetPswd returns the value of Pswd.
-func (s *LoginRequest) GetPswd() string {
-	return s.Pswd
+// GetLimit returns the value of Limit.
+func (s *FolderListingResponseData) GetLimit() int {
+	return s.Limit
 }
 
-// SetLogin sets the value of Login.
-func (s *LoginRequest) SetLogin(val string) {
-	s.Login = val
+// SetFolder sets the value of Folder.
+func (s *FolderListingResponseData) SetFolder(val OptFolderDto) {
+	s.Folder = val
 }
 
-// SetPswd sets the value of Pswd.
-func (s *LoginRequest) SetPswd(val string) {
-	s.Pswd = val
+// SetFolders sets the value of Folders.
+func (s *FolderListingResponseData) SetFolders(val []FolderDto) {
+	s.Folders = val
 }
 
-// Ref: #/components/schemas/login_response
-type LoginResponse struct {
-	Response LoginResponseResponse `json:"response"`
+// SetDocs sets the value of Docs.
+func (s *FolderListingResponseData) SetDocs(val []DocumentDto) {
+	s.Docs = val
 }
 
-// GetResponse returns the value of Response.
-func (s *LoginResponse) GetResponse() LoginResponseResponse {
-	return s.Response
+// SetNextCursor sets the value of NextCursor.
+func (s *FolderListingResponseData) SetNextCursor(val OptString) {
+	s.NextCursor = val
 }
 
-// SetResponse sets the value of Response.
-func (s *LoginResponse) SetResponse(val LoginResponseResponse) {
-	s.Response = val
+// SetLimit sets the value of Limit.
+func (s *FolderListingResponseData) SetLimit(val int) {
+	s.Limit = val
 }
 
-func (*LoginResponse) loginUserRes() {}
-
-type LoginResponseResponse struct {
-	// Токен авторизации.
-	Token string `json:"token"`
+// Ref: #/components/schemas/folder_response
+type FolderResponse struct {
+	Data FolderDto `json:"data"`
 }
 
-// GetToken returns the value of Token.
-func (s *LoginResponseResponse) GetToken() string {
-	return s.Token
+// GetData returns the value of Data.
+func (s *FolderResponse) GetData() FolderDto {
+	return s.Data
 }
 
-// SetToken sets the value of Token.
-func (s *LoginResponseResponse) SetToken(val string) {
-	s.Token = val
+// SetData sets the value of Data.
+func (s *FolderResponse) SetData(val FolderDto) {
+	s.Data = val
 }
 
-// Ref: #/components/schemas/logout_response
-type LogoutResponse struct {
-	// Результат завершения сессии (токен -> true).
-	Response LogoutResponseResponse `json:"response"`
-}
+func (*FolderResponse) createFolderRes() {}
+func (*FolderResponse) getFolderRes()    {}
+func (*FolderResponse) updateFolderRes() {}
 
-// GetResponse returns the value of Response.
-func (s *LogoutResponse) GetResponse() LogoutResponseResponse {
-	return s.Response
+// Ref: #/components/schemas/forbidden_error
+type ForbiddenError struct {
+	Error ForbiddenErrorError `json:"error"`
 }
 
-// SetResponse sets the value of Response.
-func (s *LogoutResponse) SetResponse(val LogoutResponseResponse) {
-	s.Response = val
+// GetError returns the value of Error.
+func (s *ForbiddenError) GetError() ForbiddenErrorError {
+	return s.Error
 }
 
-func (*LogoutResponse) logoutUserRes() {}
+// SetError sets the value of Error.
+func (s *ForbiddenError) SetError(val ForbiddenErrorError) {
+	s.Error = val
+}
 
-// Результат завершения сессии (токен -> true).
-type LogoutResponseResponse map[string]bool
+func (*ForbiddenError) addDocumentTagsRes()        {}
+func (*ForbiddenError) cancelUploadRes()           {}
+func (*ForbiddenError) changePasswordRes()         {}
+func (*ForbiddenError) completeOidcLoginRes()      {}
+func (*ForbiddenError) confirmTotpRes()            {}
+func (*ForbiddenError) createApiKeyRes()           {}
+func (*ForbiddenError) createDocumentRes()         {}
+func (*ForbiddenError) createDocumentVersionRes()  {}
+func (*ForbiddenError) createFolderRes()           {}
+func (*ForbiddenError) createShareLinkRes()        {}
+func (*ForbiddenError) createUploadRes()           {}
+func (*ForbiddenError) deleteDocumentRes()         {}
+func (*ForbiddenError) deleteFolderRes()           {}
+func (*ForbiddenError) deleteSessionRes()          {}
+func (*ForbiddenError) deleteUserRes()             {}
+func (*ForbiddenError) disableTotpRes()            {}
+func (*ForbiddenError) emptyTrashRes()             {}
+func (*ForbiddenError) enrollTotpRes()             {}
+func (*ForbiddenError) finalizeUploadRes()         {}
+func (*ForbiddenError) getDocumentRes()            {}
+func (*ForbiddenError) getFolderRes()              {}
+func (*ForbiddenError) getSharedDocumentRes()      {}
+func (*ForbiddenError) listApiKeysRes()            {}
+func (*ForbiddenError) listDocumentVersionsRes()   {}
+func (*ForbiddenError) listDocumentsRes()          {}
+func (*ForbiddenError) listFolderRes()             {}
+func (*ForbiddenError) listSessionsRes()           {}
+func (*ForbiddenError) listShareLinksRes()         {}
+func (*ForbiddenError) listTagsRes()               {}
+func (*ForbiddenError) listTrashRes()              {}
+func (*ForbiddenError) listUsersRes()              {}
+func (*ForbiddenError) logoutEverywhereRes()       {}
+func (*ForbiddenError) registerUserRes()           {}
+func (*ForbiddenError) removeDocumentTagRes()      {}
+func (*ForbiddenError) replaceDocumentRes()        {}
+func (*ForbiddenError) resetUserPasswordRes()      {}
+func (*ForbiddenError) restoreDocumentRes()        {}
+func (*ForbiddenError) restoreDocumentVersionRes() {}
+func (*ForbiddenError) revokeApiKeyRes()           {}
+func (*ForbiddenError) revokeShareLinkRes()        {}
+func (*ForbiddenError) searchDocumentsRes()        {}
+func (*ForbiddenError) unlockUserRes()             {}
+func (*ForbiddenError) updateDocumentRes()         {}
+func (*ForbiddenError) updateFolderRes()           {}
+func (*ForbiddenError) updateUserRes()             {}
+func (*ForbiddenError) uploadChunkRes()            {}
 
-func (s *LogoutResponseResponse) init() LogoutResponseResponse {
+type ForbiddenErrorError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+}
+
+// GetCode returns the value of Code.
+func (s *ForbiddenErrorError) GetCode() int {
+	return s.Code
+}
+
+// GetText returns the value of Text.
+func (s *ForbiddenErrorError) GetText() string {
+	return s.Text
+}
+
+// SetCode sets the value of Code.
+func (s *ForbiddenErrorError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetText sets the value of Text.
+func (s *ForbiddenErrorError) SetText(val string) {
+	s.Text = val
+}
+
+// GetDocumentHeadForbidden is response for GetDocumentHead operation.
+type GetDocumentHeadForbidden struct{}
+
+func (*GetDocumentHeadForbidden) getDocumentHeadRes() {}
+
+// GetDocumentHeadInternalServerError is response for GetDocumentHead operation.
+type GetDocumentHeadInternalServerError struct{}
+
+func (*GetDocumentHeadInternalServerError) getDocumentHeadRes() {}
+
+// GetDocumentHeadNotFound is response for GetDocumentHead operation.
+type GetDocumentHeadNotFound struct{}
+
+func (*GetDocumentHeadNotFound) getDocumentHeadRes() {}
+
+// GetDocumentHeadOK is response for GetDocumentHead operation.
+type GetDocumentHeadOK struct{}
+
+func (*GetDocumentHeadOK) getDocumentHeadRes() {}
+
+// GetDocumentHeadUnauthorized is response for GetDocumentHead operation.
+type GetDocumentHeadUnauthorized struct{}
+
+func (*GetDocumentHeadUnauthorized) getDocumentHeadRes() {}
+
+type GetDocumentOKApplicationOctetStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetDocumentOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetDocumentOKApplicationOctetStream) getDocumentRes() {}
+
+// Ref: #/components/schemas/get_document_response
+type GetDocumentResponse struct {
+	// JSON данные документа.
+	Data GetDocumentResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *GetDocumentResponse) GetData() GetDocumentResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *GetDocumentResponse) SetData(val GetDocumentResponseData) {
+	s.Data = val
+}
+
+func (*GetDocumentResponse) getDocumentRes()       {}
+func (*GetDocumentResponse) getSharedDocumentRes() {}
+
+// JSON данные документа.
+type GetDocumentResponseData map[string]jx.Raw
+
+func (s *GetDocumentResponseData) init() GetDocumentResponseData {
 	m := *s
 	if m == nil {
-		m = map[string]bool{}
+		m = map[string]jx.Raw{}
 		*s = m
 	}
 	return m
 }
 
-// Ref: #/components/schemas/meta
-type Meta struct {
-	// Имя документа.
-	Name string `json:"name"`
-	// Является ли документ файлом.
-	File bool `json:"file"`
-	// Является ли документ публичным.
-	Public bool `json:"public"`
-	// Токен авторизации.
-	Token string `json:"token"`
-	// MIME тип документа.
-	Mime string `json:"mime"`
-	// Список логинов пользователей, которым предоставлен
-	// доступ.
-	Grant []string `json:"grant"`
+type GetSharedDocumentOKApplicationOctetStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetSharedDocumentOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetSharedDocumentOKApplicationOctetStream) getSharedDocumentRes() {}
+
+// GetUploadOffsetForbidden is response for GetUploadOffset operation.
+type GetUploadOffsetForbidden struct{}
+
+func (*GetUploadOffsetForbidden) getUploadOffsetRes() {}
+
+// GetUploadOffsetInternalServerError is response for GetUploadOffset operation.
+type GetUploadOffsetInternalServerError struct{}
+
+func (*GetUploadOffsetInternalServerError) getUploadOffsetRes() {}
+
+// GetUploadOffsetNotFound is response for GetUploadOffset operation.
+type GetUploadOffsetNotFound struct{}
+
+func (*GetUploadOffsetNotFound) getUploadOffsetRes() {}
+
+// GetUploadOffsetOK is response for GetUploadOffset operation.
+type GetUploadOffsetOK struct {
+	CacheControl string
+	UploadLength int64
+	UploadOffset int64
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetUploadOffsetOK) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetUploadLength returns the value of UploadLength.
+func (s *GetUploadOffsetOK) GetUploadLength() int64 {
+	return s.UploadLength
+}
+
+// GetUploadOffset returns the value of UploadOffset.
+func (s *GetUploadOffsetOK) GetUploadOffset() int64 {
+	return s.UploadOffset
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetUploadOffsetOK) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetUploadLength sets the value of UploadLength.
+func (s *GetUploadOffsetOK) SetUploadLength(val int64) {
+	s.UploadLength = val
+}
+
+// SetUploadOffset sets the value of UploadOffset.
+func (s *GetUploadOffsetOK) SetUploadOffset(val int64) {
+	s.UploadOffset = val
+}
+
+func (*GetUploadOffsetOK) getUploadOffsetRes() {}
+
+// GetUploadOffsetUnauthorized is response for GetUploadOffset operation.
+type GetUploadOffsetUnauthorized struct{}
+
+func (*GetUploadOffsetUnauthorized) getUploadOffsetRes() {}
+
+// Ref: #/components/schemas/internal_server_error
+type InternalServerError struct {
+	Error InternalServerErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *InternalServerError) GetError() InternalServerErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *InternalServerError) SetError(val InternalServerErrorError) {
+	s.Error = val
+}
+
+func (*InternalServerError) addDocumentTagsRes()        {}
+func (*InternalServerError) cancelUploadRes()           {}
+func (*InternalServerError) changePasswordRes()         {}
+func (*InternalServerError) completeOidcLoginRes()      {}
+func (*InternalServerError) confirmPasswordResetRes()   {}
+func (*InternalServerError) confirmTotpRes()            {}
+func (*InternalServerError) createApiKeyRes()           {}
+func (*InternalServerError) createDocumentRes()         {}
+func (*InternalServerError) createDocumentVersionRes()  {}
+func (*InternalServerError) createFolderRes()           {}
+func (*InternalServerError) createShareLinkRes()        {}
+func (*InternalServerError) createUploadRes()           {}
+func (*InternalServerError) deleteDocumentRes()         {}
+func (*InternalServerError) deleteFolderRes()           {}
+func (*InternalServerError) deleteSessionRes()          {}
+func (*InternalServerError) deleteUserRes()             {}
+func (*InternalServerError) disableTotpRes()            {}
+func (*InternalServerError) emptyTrashRes()             {}
+func (*InternalServerError) enrollTotpRes()             {}
+func (*InternalServerError) finalizeUploadRes()         {}
+func (*InternalServerError) getDocumentRes()            {}
+func (*InternalServerError) getFolderRes()              {}
+func (*InternalServerError) getSharedDocumentRes()      {}
+func (*InternalServerError) listApiKeysRes()            {}
+func (*InternalServerError) listDocumentVersionsRes()   {}
+func (*InternalServerError) listDocumentsRes()          {}
+func (*InternalServerError) listFolderRes()             {}
+func (*InternalServerError) listSessionsRes()           {}
+func (*InternalServerError) listShareLinksRes()         {}
+func (*InternalServerError) listTagsRes()               {}
+func (*InternalServerError) listTrashRes()              {}
+func (*InternalServerError) listUsersRes()              {}
+func (*InternalServerError) loginUserRes()              {}
+func (*InternalServerError) logoutEverywhereRes()       {}
+func (*InternalServerError) logoutUserRes()             {}
+func (*InternalServerError) refreshTokenRes()           {}
+func (*InternalServerError) registerUserRes()           {}
+func (*InternalServerError) removeDocumentTagRes()      {}
+func (*InternalServerError) replaceDocumentRes()        {}
+func (*InternalServerError) requestPasswordResetRes()   {}
+func (*InternalServerError) resetUserPasswordRes()      {}
+func (*InternalServerError) restoreDocumentRes()        {}
+func (*InternalServerError) restoreDocumentVersionRes() {}
+func (*InternalServerError) revokeApiKeyRes()           {}
+func (*InternalServerError) revokeShareLinkRes()        {}
+func (*InternalServerError) searchDocumentsRes()        {}
+func (*InternalServerError) startOidcLoginRes()         {}
+func (*InternalServerError) unlockUserRes()             {}
+func (*InternalServerError) updateDocumentRes()         {}
+func (*InternalServerError) updateFolderRes()           {}
+func (*InternalServerError) updateUserRes()             {}
+func (*InternalServerError) uploadChunkRes()            {}
+func (*InternalServerError) verifySecondFactorRes()     {}
+
+type InternalServerErrorError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+}
+
+// GetCode returns the value of Code.
+func (s *InternalServerErrorError) GetCode() int {
+	return s.Code
+}
+
+// GetText returns the value of Text.
+func (s *InternalServerErrorError) GetText() string {
+	return s.Text
+}
+
+// SetCode sets the value of Code.
+func (s *InternalServerErrorError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetText sets the value of Text.
+func (s *InternalServerErrorError) SetText(val string) {
+	s.Text = val
+}
+
+type Key string
+
+const (
+	KeyName    Key = "name"
+	KeyMime    Key = "mime"
+	KeyPublic  Key = "public"
+	KeyFile    Key = "file"
+	KeyCreated Key = "created"
+)
+
+// AllValues returns all Key values.
+func (Key) AllValues() []Key {
+	return []Key{
+		KeyName,
+		KeyMime,
+		KeyPublic,
+		KeyFile,
+		KeyCreated,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Key) MarshalText() ([]byte, error) {
+	switch s {
+	case KeyName:
+		return []byte(s), nil
+	case KeyMime:
+		return []byte(s), nil
+	case KeyPublic:
+		return []byte(s), nil
+	case KeyFile:
+		return []byte(s), nil
+	case KeyCreated:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Key) UnmarshalText(data []byte) error {
+	switch Key(data) {
+	case KeyName:
+		*s = KeyName
+		return nil
+	case KeyMime:
+		*s = KeyMime
+		return nil
+	case KeyPublic:
+		*s = KeyPublic
+		return nil
+	case KeyFile:
+		*s = KeyFile
+		return nil
+	case KeyCreated:
+		*s = KeyCreated
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/list_api_keys_response
+type ListAPIKeysResponse struct {
+	Data ListAPIKeysResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListAPIKeysResponse) GetData() ListAPIKeysResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListAPIKeysResponse) SetData(val ListAPIKeysResponseData) {
+	s.Data = val
+}
+
+func (*ListAPIKeysResponse) listApiKeysRes() {}
+
+type ListAPIKeysResponseData struct {
+	// API-ключи пользователя (новые первыми).
+	Keys []APIKeyDto `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *ListAPIKeysResponseData) GetKeys() []APIKeyDto {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *ListAPIKeysResponseData) SetKeys(val []APIKeyDto) {
+	s.Keys = val
+}
+
+// ListDocumentsHeadForbidden is response for ListDocumentsHead operation.
+type ListDocumentsHeadForbidden struct{}
+
+func (*ListDocumentsHeadForbidden) listDocumentsHeadRes() {}
+
+// ListDocumentsHeadInternalServerError is response for ListDocumentsHead operation.
+type ListDocumentsHeadInternalServerError struct{}
+
+func (*ListDocumentsHeadInternalServerError) listDocumentsHeadRes() {}
+
+// ListDocumentsHeadOK is response for ListDocumentsHead operation.
+type ListDocumentsHeadOK struct{}
+
+func (*ListDocumentsHeadOK) listDocumentsHeadRes() {}
+
+// ListDocumentsHeadUnauthorized is response for ListDocumentsHead operation.
+type ListDocumentsHeadUnauthorized struct{}
+
+func (*ListDocumentsHeadUnauthorized) listDocumentsHeadRes() {}
+
+// Ref: #/components/schemas/list_documents_response
+type ListDocumentsResponse struct {
+	Data ListDocumentsResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListDocumentsResponse) GetData() ListDocumentsResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListDocumentsResponse) SetData(val ListDocumentsResponseData) {
+	s.Data = val
+}
+
+func (*ListDocumentsResponse) listDocumentsRes() {}
+
+type ListDocumentsResponseData struct {
+	// Страница документов.
+	Docs []DocumentDto `json:"docs"`
+	// Курсор следующей страницы (отсутствует на последней
+	// странице).
+	NextCursor OptString `json:"next_cursor"`
+	// Общее количество документов, подходящих под фильтр
+	// (только при total=true).
+	Total OptInt `json:"total"`
+	// Размер страницы.
+	Limit int `json:"limit"`
+}
+
+// GetDocs returns the value of Docs.
+func (s *ListDocumentsResponseData) GetDocs() []DocumentDto {
+	return s.Docs
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *ListDocumentsResponseData) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetTotal returns the value of Total.
+func (s *ListDocumentsResponseData) GetTotal() OptInt {
+	return s.Total
+}
+
+// GetLimit returns the value of Limit.
+func (s *ListDocumentsResponseData) GetLimit() int {
+	return s.Limit
+}
+
+// SetDocs sets the value of Docs.
+func (s *ListDocumentsResponseData) SetDocs(val []DocumentDto) {
+	s.Docs = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *ListDocumentsResponseData) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ListDocumentsResponseData) SetTotal(val OptInt) {
+	s.Total = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *ListDocumentsResponseData) SetLimit(val int) {
+	s.Limit = val
+}
+
+// Ref: #/components/schemas/list_sessions_response
+type ListSessionsResponse struct {
+	Data ListSessionsResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListSessionsResponse) GetData() ListSessionsResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListSessionsResponse) SetData(val ListSessionsResponseData) {
+	s.Data = val
+}
+
+func (*ListSessionsResponse) listSessionsRes() {}
+
+type ListSessionsResponseData struct {
+	// Активные сессии пользователя (новые первыми).
+	Sessions []SessionDto `json:"sessions"`
+}
+
+// GetSessions returns the value of Sessions.
+func (s *ListSessionsResponseData) GetSessions() []SessionDto {
+	return s.Sessions
+}
+
+// SetSessions sets the value of Sessions.
+func (s *ListSessionsResponseData) SetSessions(val []SessionDto) {
+	s.Sessions = val
+}
+
+// Ref: #/components/schemas/list_share_links_response
+type ListShareLinksResponse struct {
+	Data ListShareLinksResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListShareLinksResponse) GetData() ListShareLinksResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListShareLinksResponse) SetData(val ListShareLinksResponseData) {
+	s.Data = val
+}
+
+func (*ListShareLinksResponse) listShareLinksRes() {}
+
+type ListShareLinksResponseData struct {
+	// Ссылки на документ, новые первыми.
+	Links []ShareLinkDto `json:"links"`
+}
+
+// GetLinks returns the value of Links.
+func (s *ListShareLinksResponseData) GetLinks() []ShareLinkDto {
+	return s.Links
+}
+
+// SetLinks sets the value of Links.
+func (s *ListShareLinksResponseData) SetLinks(val []ShareLinkDto) {
+	s.Links = val
+}
+
+// Ref: #/components/schemas/list_tags_response
+type ListTagsResponse struct {
+	Data ListTagsResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListTagsResponse) GetData() ListTagsResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListTagsResponse) SetData(val ListTagsResponseData) {
+	s.Data = val
+}
+
+func (*ListTagsResponse) listTagsRes() {}
+
+type ListTagsResponseData struct {
+	// Теги пользователя по алфавиту.
+	Tags []TagDto `json:"tags"`
+}
+
+// GetTags returns the value of Tags.
+func (s *ListTagsResponseData) GetTags() []TagDto {
+	return s.Tags
+}
+
+// SetTags sets the value of Tags.
+func (s *ListTagsResponseData) SetTags(val []TagDto) {
+	s.Tags = val
+}
+
+// Ref: #/components/schemas/list_trash_response
+type ListTrashResponse struct {
+	Data ListTrashResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListTrashResponse) GetData() ListTrashResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListTrashResponse) SetData(val ListTrashResponseData) {
+	s.Data = val
+}
+
+func (*ListTrashResponse) listTrashRes() {}
+
+type ListTrashResponseData struct {
+	// Документы в корзине, последние удаленные первыми.
+	Docs []DocumentDto `json:"docs"`
+}
+
+// GetDocs returns the value of Docs.
+func (s *ListTrashResponseData) GetDocs() []DocumentDto {
+	return s.Docs
+}
+
+// SetDocs sets the value of Docs.
+func (s *ListTrashResponseData) SetDocs(val []DocumentDto) {
+	s.Docs = val
+}
+
+// Ref: #/components/schemas/list_users_response
+type ListUsersResponse struct {
+	Data ListUsersResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListUsersResponse) GetData() ListUsersResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListUsersResponse) SetData(val ListUsersResponseData) {
+	s.Data = val
+}
+
+func (*ListUsersResponse) listUsersRes() {}
+
+type ListUsersResponseData struct {
+	// Страница пользователей (по логину).
+	Users []UserDto `json:"users"`
+	// Общее количество пользователей, подходящих под
+	// фильтр.
+	Total int `json:"total"`
+	// Размер страницы.
+	Limit int `json:"limit"`
+	// Смещение страницы.
+	Offset int `json:"offset"`
+}
+
+// GetUsers returns the value of Users.
+func (s *ListUsersResponseData) GetUsers() []UserDto {
+	return s.Users
+}
+
+// GetTotal returns the value of Total.
+func (s *ListUsersResponseData) GetTotal() int {
+	return s.Total
+}
+
+// GetLimit returns the value of Limit.
+func (s *ListUsersResponseData) GetLimit() int {
+	return s.Limit
+}
+
+// GetOffset returns the value of Offset.
+func (s *ListUsersResponseData) GetOffset() int {
+	return s.Offset
+}
+
+// SetUsers sets the value of Users.
+func (s *ListUsersResponseData) SetUsers(val []UserDto) {
+	s.Users = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ListUsersResponseData) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *ListUsersResponseData) SetLimit(val int) {
+	s.Limit = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *ListUsersResponseData) SetOffset(val int) {
+	s.Offset = val
+}
+
+// Ref: #/components/schemas/list_versions_response
+type ListVersionsResponse struct {
+	Data ListVersionsResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ListVersionsResponse) GetData() ListVersionsResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ListVersionsResponse) SetData(val ListVersionsResponseData) {
+	s.Data = val
+}
+
+func (*ListVersionsResponse) listDocumentVersionsRes() {}
+
+type ListVersionsResponseData struct {
+	// История версий документа (новые первыми).
+	Versions []DocumentVersionDto `json:"versions"`
+}
+
+// GetVersions returns the value of Versions.
+func (s *ListVersionsResponseData) GetVersions() []DocumentVersionDto {
+	return s.Versions
+}
+
+// SetVersions sets the value of Versions.
+func (s *ListVersionsResponseData) SetVersions(val []DocumentVersionDto) {
+	s.Versions = val
+}
+
+// Ref: #/components/schemas/login_request
+type LoginRequest struct {
+	// Логин пользователя.
+	Login string `json:"login"`
+	// Пароль пользователя.
+	Pswd string `json:"pswd"`
+}
+
+// GetLogin returns the value of Login.
+func (s *LoginRequest) GetLogin() string {
+	return s.Login
+}
+
+// GetPswd returns the value of Pswd.
+func (s *LoginRequest) GetPswd() string {
+	return s.Pswd
+}
+
+// SetLogin sets the value of Login.
+func (s *LoginRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetPswd sets the value of Pswd.
+func (s *LoginRequest) SetPswd(val string) {
+	s.Pswd = val
+}
+
+// Ref: #/components/schemas/login_response
+type LoginResponse struct {
+	Response LoginResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *LoginResponse) GetResponse() LoginResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *LoginResponse) SetResponse(val LoginResponseResponse) {
+	s.Response = val
+}
+
+func (*LoginResponse) completeOidcLoginRes()  {}
+func (*LoginResponse) loginUserRes()          {}
+func (*LoginResponse) refreshTokenRes()       {}
+func (*LoginResponse) verifySecondFactorRes() {}
+
+type LoginResponseResponse struct {
+	// Токен авторизации.
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *LoginResponseResponse) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *LoginResponseResponse) SetToken(val string) {
+	s.Token = val
+}
+
+// Ref: #/components/schemas/logout_response
+type LogoutResponse struct {
+	// Результат завершения сессии (токен -> true).
+	Response LogoutResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *LogoutResponse) GetResponse() LogoutResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *LogoutResponse) SetResponse(val LogoutResponseResponse) {
+	s.Response = val
+}
+
+func (*LogoutResponse) deleteSessionRes()    {}
+func (*LogoutResponse) logoutEverywhereRes() {}
+func (*LogoutResponse) logoutUserRes()       {}
+func (*LogoutResponse) revokeApiKeyRes()     {}
+
+// Результат завершения сессии (токен -> true).
+type LogoutResponseResponse map[string]bool
+
+func (s *LogoutResponseResponse) init() LogoutResponseResponse {
+	m := *s
+	if m == nil {
+		m = map[string]bool{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/meta
+type Meta struct {
+	// Имя документа.
+	Name string `json:"name"`
+	// Является ли документ файлом.
+	File bool `json:"file"`
+	// Является ли документ публичным.
+	Public bool `json:"public"`
+	// Токен авторизации.
+	Token string `json:"token"`
+	// MIME тип документа.
+	Mime string `json:"mime"`
+	// Список логинов пользователей, которым предоставлен
+	// доступ.
+	Grant []string `json:"grant"`
+	// ID папки владельца для документа (без поля - корень).
+	Folder OptString `json:"folder"`
+	// Пользовательские метаданные ключ -> строка, число,
+	// логическое значение или дата (до 50 ключей; даты
+	// приводятся к RFC 3339 UTC).
+	Metadata OptMetaMetadata `json:"metadata"`
+}
+
+// GetName returns the value of Name.
+func (s *Meta) GetName() string {
+	return s.Name
+}
+
+// GetFile returns the value of File.
+func (s *Meta) GetFile() bool {
+	return s.File
+}
+
+// GetPublic returns the value of Public.
+func (s *Meta) GetPublic() bool {
+	return s.Public
+}
+
+// GetToken returns the value of Token.
+func (s *Meta) GetToken() string {
+	return s.Token
+}
+
+// GetMime returns the value of Mime.
+func (s *Meta) GetMime() string {
+	return s.Mime
+}
+
+// GetGrant returns the value of Grant.
+func (s *Meta) GetGrant() []string {
+	return s.Grant
+}
+
+// GetFolder returns the value of Folder.
+func (s *Meta) GetFolder() OptString {
+	return s.Folder
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *Meta) GetMetadata() OptMetaMetadata {
+	return s.Metadata
+}
+
+// SetName sets the value of Name.
+func (s *Meta) SetName(val string) {
+	s.Name = val
+}
+
+// SetFile sets the value of File.
+func (s *Meta) SetFile(val bool) {
+	s.File = val
+}
+
+// SetPublic sets the value of Public.
+func (s *Meta) SetPublic(val bool) {
+	s.Public = val
+}
+
+// SetToken sets the value of Token.
+func (s *Meta) SetToken(val string) {
+	s.Token = val
+}
+
+// SetMime sets the value of Mime.
+func (s *Meta) SetMime(val string) {
+	s.Mime = val
+}
+
+// SetGrant sets the value of Grant.
+func (s *Meta) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// SetFolder sets the value of Folder.
+func (s *Meta) SetFolder(val OptString) {
+	s.Folder = val
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *Meta) SetMetadata(val OptMetaMetadata) {
+	s.Metadata = val
+}
+
+// Пользовательские метаданные ключ -> строка, число,
+// логическое значение или дата (до 50 ключей; даты
+// приводятся к RFC 3339 UTC).
+type MetaMetadata map[string]jx.Raw
+
+func (s *MetaMetadata) init() MetaMetadata {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Ref: #/components/schemas/not_found_error
+type NotFoundError struct {
+	Error NotFoundErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *NotFoundError) GetError() NotFoundErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *NotFoundError) SetError(val NotFoundErrorError) {
+	s.Error = val
+}
+
+func (*NotFoundError) addDocumentTagsRes()        {}
+func (*NotFoundError) cancelUploadRes()           {}
+func (*NotFoundError) completeOidcLoginRes()      {}
+func (*NotFoundError) createDocumentVersionRes()  {}
+func (*NotFoundError) createFolderRes()           {}
+func (*NotFoundError) createShareLinkRes()        {}
+func (*NotFoundError) deleteDocumentRes()         {}
+func (*NotFoundError) deleteFolderRes()           {}
+func (*NotFoundError) deleteSessionRes()          {}
+func (*NotFoundError) deleteUserRes()             {}
+func (*NotFoundError) finalizeUploadRes()         {}
+func (*NotFoundError) getDocumentRes()            {}
+func (*NotFoundError) getFolderRes()              {}
+func (*NotFoundError) getSharedDocumentRes()      {}
+func (*NotFoundError) listDocumentVersionsRes()   {}
+func (*NotFoundError) listFolderRes()             {}
+func (*NotFoundError) listShareLinksRes()         {}
+func (*NotFoundError) removeDocumentTagRes()      {}
+func (*NotFoundError) replaceDocumentRes()        {}
+func (*NotFoundError) resetUserPasswordRes()      {}
+func (*NotFoundError) restoreDocumentRes()        {}
+func (*NotFoundError) restoreDocumentVersionRes() {}
+func (*NotFoundError) revokeApiKeyRes()           {}
+func (*NotFoundError) revokeShareLinkRes()        {}
+func (*NotFoundError) startOidcLoginRes()         {}
+func (*NotFoundError) unlockUserRes()             {}
+func (*NotFoundError) updateDocumentRes()         {}
+func (*NotFoundError) updateFolderRes()           {}
+func (*NotFoundError) updateUserRes()             {}
+func (*NotFoundError) uploadChunkRes()            {}
+
+type NotFoundErrorError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+}
+
+// GetCode returns the value of Code.
+func (s *NotFoundErrorError) GetCode() int {
+	return s.Code
+}
+
+// GetText returns the value of Text.
+func (s *NotFoundErrorError) GetText() string {
+	return s.Text
+}
+
+// SetCode sets the value of Code.
+func (s *NotFoundErrorError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetText sets the value of Text.
+func (s *NotFoundErrorError) SetText(val string) {
+	s.Text = val
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateDocumentRequestMultipartJSON returns new OptCreateDocumentRequestMultipartJSON with value set to v.
+func NewOptCreateDocumentRequestMultipartJSON(v CreateDocumentRequestMultipartJSON) OptCreateDocumentRequestMultipartJSON {
+	return OptCreateDocumentRequestMultipartJSON{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateDocumentRequestMultipartJSON is optional CreateDocumentRequestMultipartJSON.
+type OptCreateDocumentRequestMultipartJSON struct {
+	Value CreateDocumentRequestMultipartJSON
+	Set   bool
+}
+
+// IsSet returns true if OptCreateDocumentRequestMultipartJSON was set.
+func (o OptCreateDocumentRequestMultipartJSON) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateDocumentRequestMultipartJSON) Reset() {
+	var v CreateDocumentRequestMultipartJSON
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateDocumentRequestMultipartJSON) SetTo(v CreateDocumentRequestMultipartJSON) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateDocumentRequestMultipartJSON) Get() (v CreateDocumentRequestMultipartJSON, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateDocumentRequestMultipartJSON) Or(d CreateDocumentRequestMultipartJSON) CreateDocumentRequestMultipartJSON {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateDocumentResponseDataJSON returns new OptCreateDocumentResponseDataJSON with value set to v.
+func NewOptCreateDocumentResponseDataJSON(v CreateDocumentResponseDataJSON) OptCreateDocumentResponseDataJSON {
+	return OptCreateDocumentResponseDataJSON{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateDocumentResponseDataJSON is optional CreateDocumentResponseDataJSON.
+type OptCreateDocumentResponseDataJSON struct {
+	Value CreateDocumentResponseDataJSON
+	Set   bool
+}
+
+// IsSet returns true if OptCreateDocumentResponseDataJSON was set.
+func (o OptCreateDocumentResponseDataJSON) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateDocumentResponseDataJSON) Reset() {
+	var v CreateDocumentResponseDataJSON
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateDocumentResponseDataJSON) SetTo(v CreateDocumentResponseDataJSON) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateDocumentResponseDataJSON) Get() (v CreateDocumentResponseDataJSON, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateDocumentResponseDataJSON) Or(d CreateDocumentResponseDataJSON) CreateDocumentResponseDataJSON {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateVersionRequestMultipartJSON returns new OptCreateVersionRequestMultipartJSON with value set to v.
+func NewOptCreateVersionRequestMultipartJSON(v CreateVersionRequestMultipartJSON) OptCreateVersionRequestMultipartJSON {
+	return OptCreateVersionRequestMultipartJSON{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateVersionRequestMultipartJSON is optional CreateVersionRequestMultipartJSON.
+type OptCreateVersionRequestMultipartJSON struct {
+	Value CreateVersionRequestMultipartJSON
+	Set   bool
+}
+
+// IsSet returns true if OptCreateVersionRequestMultipartJSON was set.
+func (o OptCreateVersionRequestMultipartJSON) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateVersionRequestMultipartJSON) Reset() {
+	var v CreateVersionRequestMultipartJSON
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateVersionRequestMultipartJSON) SetTo(v CreateVersionRequestMultipartJSON) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateVersionRequestMultipartJSON) Get() (v CreateVersionRequestMultipartJSON, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateVersionRequestMultipartJSON) Or(d CreateVersionRequestMultipartJSON) CreateVersionRequestMultipartJSON {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDocumentDtoMetadata returns new OptDocumentDtoMetadata with value set to v.
+func NewOptDocumentDtoMetadata(v DocumentDtoMetadata) OptDocumentDtoMetadata {
+	return OptDocumentDtoMetadata{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDocumentDtoMetadata is optional DocumentDtoMetadata.
+type OptDocumentDtoMetadata struct {
+	Value DocumentDtoMetadata
+	Set   bool
+}
+
+// IsSet returns true if OptDocumentDtoMetadata was set.
+func (o OptDocumentDtoMetadata) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDocumentDtoMetadata) Reset() {
+	var v DocumentDtoMetadata
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDocumentDtoMetadata) SetTo(v DocumentDtoMetadata) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDocumentDtoMetadata) Get() (v DocumentDtoMetadata, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDocumentDtoMetadata) Or(d DocumentDtoMetadata) DocumentDtoMetadata {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFolderDto returns new OptFolderDto with value set to v.
+func NewOptFolderDto(v FolderDto) OptFolderDto {
+	return OptFolderDto{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFolderDto is optional FolderDto.
+type OptFolderDto struct {
+	Value FolderDto
+	Set   bool
+}
+
+// IsSet returns true if OptFolderDto was set.
+func (o OptFolderDto) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFolderDto) Reset() {
+	var v FolderDto
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFolderDto) SetTo(v FolderDto) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFolderDto) Get() (v FolderDto, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFolderDto) Or(d FolderDto) FolderDto {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptKey returns new OptKey with value set to v.
+func NewOptKey(v Key) OptKey {
+	return OptKey{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptKey is optional Key.
+type OptKey struct {
+	Value Key
+	Set   bool
+}
+
+// IsSet returns true if OptKey was set.
+func (o OptKey) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptKey) Reset() {
+	var v Key
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptKey) SetTo(v Key) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptKey) Get() (v Key, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptKey) Or(d Key) Key {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMetaMetadata returns new OptMetaMetadata with value set to v.
+func NewOptMetaMetadata(v MetaMetadata) OptMetaMetadata {
+	return OptMetaMetadata{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMetaMetadata is optional MetaMetadata.
+type OptMetaMetadata struct {
+	Value MetaMetadata
+	Set   bool
+}
+
+// IsSet returns true if OptMetaMetadata was set.
+func (o OptMetaMetadata) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMetaMetadata) Reset() {
+	var v MetaMetadata
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMetaMetadata) SetTo(v MetaMetadata) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMetaMetadata) Get() (v MetaMetadata, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMetaMetadata) Or(d MetaMetadata) MetaMetadata {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
+	Set   bool
+}
+
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRegisterRequestRole returns new OptRegisterRequestRole with value set to v.
+func NewOptRegisterRequestRole(v RegisterRequestRole) OptRegisterRequestRole {
+	return OptRegisterRequestRole{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRegisterRequestRole is optional RegisterRequestRole.
+type OptRegisterRequestRole struct {
+	Value RegisterRequestRole
+	Set   bool
+}
+
+// IsSet returns true if OptRegisterRequestRole was set.
+func (o OptRegisterRequestRole) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRegisterRequestRole) Reset() {
+	var v RegisterRequestRole
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRegisterRequestRole) SetTo(v RegisterRequestRole) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRegisterRequestRole) Get() (v RegisterRequestRole, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRegisterRequestRole) Or(d RegisterRequestRole) RegisterRequestRole {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRole returns new OptRole with value set to v.
+func NewOptRole(v Role) OptRole {
+	return OptRole{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRole is optional Role.
+type OptRole struct {
+	Value Role
+	Set   bool
+}
+
+// IsSet returns true if OptRole was set.
+func (o OptRole) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRole) Reset() {
+	var v Role
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRole) SetTo(v Role) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRole) Get() (v Role, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRole) Or(d Role) Role {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateDocumentRequestJSON returns new OptUpdateDocumentRequestJSON with value set to v.
+func NewOptUpdateDocumentRequestJSON(v UpdateDocumentRequestJSON) OptUpdateDocumentRequestJSON {
+	return OptUpdateDocumentRequestJSON{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateDocumentRequestJSON is optional UpdateDocumentRequestJSON.
+type OptUpdateDocumentRequestJSON struct {
+	Value UpdateDocumentRequestJSON
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateDocumentRequestJSON was set.
+func (o OptUpdateDocumentRequestJSON) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateDocumentRequestJSON) Reset() {
+	var v UpdateDocumentRequestJSON
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateDocumentRequestJSON) SetTo(v UpdateDocumentRequestJSON) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateDocumentRequestJSON) Get() (v UpdateDocumentRequestJSON, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateDocumentRequestJSON) Or(d UpdateDocumentRequestJSON) UpdateDocumentRequestJSON {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateDocumentRequestMetadata returns new OptUpdateDocumentRequestMetadata with value set to v.
+func NewOptUpdateDocumentRequestMetadata(v UpdateDocumentRequestMetadata) OptUpdateDocumentRequestMetadata {
+	return OptUpdateDocumentRequestMetadata{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateDocumentRequestMetadata is optional UpdateDocumentRequestMetadata.
+type OptUpdateDocumentRequestMetadata struct {
+	Value UpdateDocumentRequestMetadata
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateDocumentRequestMetadata was set.
+func (o OptUpdateDocumentRequestMetadata) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateDocumentRequestMetadata) Reset() {
+	var v UpdateDocumentRequestMetadata
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateDocumentRequestMetadata) SetTo(v UpdateDocumentRequestMetadata) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateDocumentRequestMetadata) Get() (v UpdateDocumentRequestMetadata, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateDocumentRequestMetadata) Or(d UpdateDocumentRequestMetadata) UpdateDocumentRequestMetadata {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateUserRequestRole returns new OptUpdateUserRequestRole with value set to v.
+func NewOptUpdateUserRequestRole(v UpdateUserRequestRole) OptUpdateUserRequestRole {
+	return OptUpdateUserRequestRole{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateUserRequestRole is optional UpdateUserRequestRole.
+type OptUpdateUserRequestRole struct {
+	Value UpdateUserRequestRole
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateUserRequestRole was set.
+func (o OptUpdateUserRequestRole) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateUserRequestRole) Reset() {
+	var v UpdateUserRequestRole
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateUserRequestRole) SetTo(v UpdateUserRequestRole) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateUserRequestRole) Get() (v UpdateUserRequestRole, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateUserRequestRole) Or(d UpdateUserRequestRole) UpdateUserRequestRole {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/password_reset_confirm_request
+type PasswordResetConfirmRequest struct {
+	// Токен сброса пароля.
+	ResetToken string `json:"reset_token"`
+	// Новый пароль (те же требования, что при регистрации).
+	NewPswd string `json:"new_pswd"`
+}
+
+// GetResetToken returns the value of ResetToken.
+func (s *PasswordResetConfirmRequest) GetResetToken() string {
+	return s.ResetToken
+}
+
+// GetNewPswd returns the value of NewPswd.
+func (s *PasswordResetConfirmRequest) GetNewPswd() string {
+	return s.NewPswd
+}
+
+// SetResetToken sets the value of ResetToken.
+func (s *PasswordResetConfirmRequest) SetResetToken(val string) {
+	s.ResetToken = val
+}
+
+// SetNewPswd sets the value of NewPswd.
+func (s *PasswordResetConfirmRequest) SetNewPswd(val string) {
+	s.NewPswd = val
+}
+
+// Ref: #/components/schemas/password_reset_request
+type PasswordResetRequest struct {
+	// Логин пользователя, которому нужно отправить токен
+	// сброса.
+	Login string `json:"login"`
+}
+
+// GetLogin returns the value of Login.
+func (s *PasswordResetRequest) GetLogin() string {
+	return s.Login
+}
+
+// SetLogin sets the value of Login.
+func (s *PasswordResetRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+// Ref: #/components/schemas/password_reset_response
+type PasswordResetResponse struct {
+	Data PasswordResetResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *PasswordResetResponse) GetData() PasswordResetResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *PasswordResetResponse) SetData(val PasswordResetResponseData) {
+	s.Data = val
+}
+
+func (*PasswordResetResponse) resetUserPasswordRes() {}
+
+type PasswordResetResponseData struct {
+	// Логин пользователя.
+	Login string `json:"login"`
+	// Одноразовый токен сброса пароля, показывается один
+	// раз (также отправляется пользователю).
+	ResetToken string `json:"reset_token"`
+	// Дата и время истечения токена сброса.
+	Expires string `json:"expires"`
+}
+
+// GetLogin returns the value of Login.
+func (s *PasswordResetResponseData) GetLogin() string {
+	return s.Login
+}
+
+// GetResetToken returns the value of ResetToken.
+func (s *PasswordResetResponseData) GetResetToken() string {
+	return s.ResetToken
+}
+
+// GetExpires returns the value of Expires.
+func (s *PasswordResetResponseData) GetExpires() string {
+	return s.Expires
+}
+
+// SetLogin sets the value of Login.
+func (s *PasswordResetResponseData) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetResetToken sets the value of ResetToken.
+func (s *PasswordResetResponseData) SetResetToken(val string) {
+	s.ResetToken = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *PasswordResetResponseData) SetExpires(val string) {
+	s.Expires = val
+}
+
+// Ref: #/components/schemas/recovery_codes_response
+type RecoveryCodesResponse struct {
+	Data RecoveryCodesResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *RecoveryCodesResponse) GetData() RecoveryCodesResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *RecoveryCodesResponse) SetData(val RecoveryCodesResponseData) {
+	s.Data = val
+}
+
+func (*RecoveryCodesResponse) confirmTotpRes() {}
+
+type RecoveryCodesResponseData struct {
+	// Одноразовые коды восстановления; показываются
+	// только один раз.
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *RecoveryCodesResponseData) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
+}
+
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *RecoveryCodesResponseData) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
+}
+
+// Ref: #/components/schemas/register_request
+type RegisterRequest struct {
+	// Токен сессии пользователя с ролью admin.
+	Token string `json:"token"`
+	// Логин нового пользователя (минимум 8 символов,
+	// латиница и цифры).
+	Login string `json:"login"`
+	// Пароль нового пользователя:
+	// - минимум 8 символов
+	// - минимум 2 буквы в разных регистрах
+	// - минимум 1 цифра
+	// - минимум 1 символ (не буква и не цифра).
+	Pswd string `json:"pswd"`
+	// Роль нового пользователя:
+	// - admin - все документы и управление пользователями
+	// - user - чтение доступных документов, создание и
+	// изменение своих
+	// - readonly - только чтение доступных документов
+	// - service - чтение всех документов, создание и изменение
+	// своих.
+	Role OptRegisterRequestRole `json:"role"`
+}
+
+// GetToken returns the value of Token.
+func (s *RegisterRequest) GetToken() string {
+	return s.Token
+}
+
+// GetLogin returns the value of Login.
+func (s *RegisterRequest) GetLogin() string {
+	return s.Login
+}
+
+// GetPswd returns the value of Pswd.
+func (s *RegisterRequest) GetPswd() string {
+	return s.Pswd
+}
+
+// GetRole returns the value of Role.
+func (s *RegisterRequest) GetRole() OptRegisterRequestRole {
+	return s.Role
+}
+
+// SetToken sets the value of Token.
+func (s *RegisterRequest) SetToken(val string) {
+	s.Token = val
+}
+
+// SetLogin sets the value of Login.
+func (s *RegisterRequest) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetPswd sets the value of Pswd.
+func (s *RegisterRequest) SetPswd(val string) {
+	s.Pswd = val
+}
+
+// SetRole sets the value of Role.
+func (s *RegisterRequest) SetRole(val OptRegisterRequestRole) {
+	s.Role = val
+}
+
+// Роль нового пользователя:
+// - admin - все документы и управление пользователями
+// - user - чтение доступных документов, создание и
+// изменение своих
+// - readonly - только чтение доступных документов
+// - service - чтение всех документов, создание и изменение
+// своих.
+type RegisterRequestRole string
+
+const (
+	RegisterRequestRoleAdmin    RegisterRequestRole = "admin"
+	RegisterRequestRoleUser     RegisterRequestRole = "user"
+	RegisterRequestRoleReadonly RegisterRequestRole = "readonly"
+	RegisterRequestRoleService  RegisterRequestRole = "service"
+)
+
+// AllValues returns all RegisterRequestRole values.
+func (RegisterRequestRole) AllValues() []RegisterRequestRole {
+	return []RegisterRequestRole{
+		RegisterRequestRoleAdmin,
+		RegisterRequestRoleUser,
+		RegisterRequestRoleReadonly,
+		RegisterRequestRoleService,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RegisterRequestRole) MarshalText() ([]byte, error) {
+	switch s {
+	case RegisterRequestRoleAdmin:
+		return []byte(s), nil
+	case RegisterRequestRoleUser:
+		return []byte(s), nil
+	case RegisterRequestRoleReadonly:
+		return []byte(s), nil
+	case RegisterRequestRoleService:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RegisterRequestRole) UnmarshalText(data []byte) error {
+	switch RegisterRequestRole(data) {
+	case RegisterRequestRoleAdmin:
+		*s = RegisterRequestRoleAdmin
+		return nil
+	case RegisterRequestRoleUser:
+		*s = RegisterRequestRoleUser
+		return nil
+	case RegisterRequestRoleReadonly:
+		*s = RegisterRequestRoleReadonly
+		return nil
+	case RegisterRequestRoleService:
+		*s = RegisterRequestRoleService
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/register_response
+type RegisterResponse struct {
+	Response RegisterResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *RegisterResponse) GetResponse() RegisterResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *RegisterResponse) SetResponse(val RegisterResponseResponse) {
+	s.Response = val
+}
+
+func (*RegisterResponse) registerUserRes() {}
+
+type RegisterResponseResponse struct {
+	// Логин зарегистрированного пользователя.
+	Login string `json:"login"`
+	// Роль зарегистрированного пользователя.
+	Role string `json:"role"`
+}
+
+// GetLogin returns the value of Login.
+func (s *RegisterResponseResponse) GetLogin() string {
+	return s.Login
+}
+
+// GetRole returns the value of Role.
+func (s *RegisterResponseResponse) GetRole() string {
+	return s.Role
+}
+
+// SetLogin sets the value of Login.
+func (s *RegisterResponseResponse) SetLogin(val string) {
+	s.Login = val
+}
+
+// SetRole sets the value of Role.
+func (s *RegisterResponseResponse) SetRole(val string) {
+	s.Role = val
+}
+
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleUser     Role = "user"
+	RoleReadonly Role = "readonly"
+	RoleService  Role = "service"
+)
+
+// AllValues returns all Role values.
+func (Role) AllValues() []Role {
+	return []Role{
+		RoleAdmin,
+		RoleUser,
+		RoleReadonly,
+		RoleService,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Role) MarshalText() ([]byte, error) {
+	switch s {
+	case RoleAdmin:
+		return []byte(s), nil
+	case RoleUser:
+		return []byte(s), nil
+	case RoleReadonly:
+		return []byte(s), nil
+	case RoleService:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Role) UnmarshalText(data []byte) error {
+	switch Role(data) {
+	case RoleAdmin:
+		*s = RoleAdmin
+		return nil
+	case RoleUser:
+		*s = RoleUser
+		return nil
+	case RoleReadonly:
+		*s = RoleReadonly
+		return nil
+	case RoleService:
+		*s = RoleService
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/search_documents_response
+type SearchDocumentsResponse struct {
+	Data SearchDocumentsResponseData `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *SearchDocumentsResponse) GetData() SearchDocumentsResponseData {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *SearchDocumentsResponse) SetData(val SearchDocumentsResponseData) {
+	s.Data = val
+}
+
+func (*SearchDocumentsResponse) searchDocumentsRes() {}
+
+type SearchDocumentsResponseData struct {
+	// Страница найденных документов по убыванию
+	// релевантности.
+	Results []SearchHitDto `json:"results"`
+	// Общее количество найденных документов.
+	Total int `json:"total"`
+	// Размер страницы.
+	Limit int `json:"limit"`
+	// Смещение страницы.
+	Offset int `json:"offset"`
+}
+
+// GetResults returns the value of Results.
+func (s *SearchDocumentsResponseData) GetResults() []SearchHitDto {
+	return s.Results
+}
+
+// GetTotal returns the value of Total.
+func (s *SearchDocumentsResponseData) GetTotal() int {
+	return s.Total
+}
+
+// GetLimit returns the value of Limit.
+func (s *SearchDocumentsResponseData) GetLimit() int {
+	return s.Limit
+}
+
+// GetOffset returns the value of Offset.
+func (s *SearchDocumentsResponseData) GetOffset() int {
+	return s.Offset
+}
+
+// SetResults sets the value of Results.
+func (s *SearchDocumentsResponseData) SetResults(val []SearchHitDto) {
+	s.Results = val
+}
+
+// SetTotal sets the value of Total.
+func (s *SearchDocumentsResponseData) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *SearchDocumentsResponseData) SetLimit(val int) {
+	s.Limit = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *SearchDocumentsResponseData) SetOffset(val int) {
+	s.Offset = val
+}
+
+// Ref: #/components/schemas/search_hit_dto
+type SearchHitDto struct {
+	Document DocumentDto `json:"document"`
+	// Релевантность документа запросу (больше - выше).
+	Rank float64 `json:"rank"`
+	// Фрагменты имени и текста документа,
+	// HTML-экранированные, совпадения выделены тегом <mark>.
+	Snippet string `json:"snippet"`
+}
+
+// GetDocument returns the value of Document.
+func (s *SearchHitDto) GetDocument() DocumentDto {
+	return s.Document
+}
+
+// GetRank returns the value of Rank.
+func (s *SearchHitDto) GetRank() float64 {
+	return s.Rank
+}
+
+// GetSnippet returns the value of Snippet.
+func (s *SearchHitDto) GetSnippet() string {
+	return s.Snippet
+}
+
+// SetDocument sets the value of Document.
+func (s *SearchHitDto) SetDocument(val DocumentDto) {
+	s.Document = val
+}
+
+// SetRank sets the value of Rank.
+func (s *SearchHitDto) SetRank(val float64) {
+	s.Rank = val
+}
+
+// SetSnippet sets the value of Snippet.
+func (s *SearchHitDto) SetSnippet(val string) {
+	s.Snippet = val
+}
+
+// Ref: #/components/schemas/second_factor_challenge_response
+type SecondFactorChallengeResponse struct {
+	Response SecondFactorChallengeResponseResponse `json:"response"`
+}
+
+// GetResponse returns the value of Response.
+func (s *SecondFactorChallengeResponse) GetResponse() SecondFactorChallengeResponseResponse {
+	return s.Response
+}
+
+// SetResponse sets the value of Response.
+func (s *SecondFactorChallengeResponse) SetResponse(val SecondFactorChallengeResponseResponse) {
+	s.Response = val
+}
+
+func (*SecondFactorChallengeResponse) completeOidcLoginRes() {}
+func (*SecondFactorChallengeResponse) loginUserRes()         {}
+
+type SecondFactorChallengeResponseResponse struct {
+	// Токен второго шага входа; обменивается на токен
+	// сессии вместе с кодом второго фактора.
+	Challenge string `json:"challenge"`
+	// Срок действия токена второго шага.
+	Expires time.Time `json:"expires"`
+}
+
+// GetChallenge returns the value of Challenge.
+func (s *SecondFactorChallengeResponseResponse) GetChallenge() string {
+	return s.Challenge
+}
+
+// GetExpires returns the value of Expires.
+func (s *SecondFactorChallengeResponseResponse) GetExpires() time.Time {
+	return s.Expires
+}
+
+// SetChallenge sets the value of Challenge.
+func (s *SecondFactorChallengeResponseResponse) SetChallenge(val string) {
+	s.Challenge = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *SecondFactorChallengeResponseResponse) SetExpires(val time.Time) {
+	s.Expires = val
+}
+
+// Ref: #/components/schemas/second_factor_request
+type SecondFactorRequest struct {
+	// Токен второго шага из ответа на вход по паролю.
+	Challenge string `json:"challenge"`
+	// Код из приложения-аутентификатора или код
+	// восстановления.
+	Code string `json:"code"`
+}
+
+// GetChallenge returns the value of Challenge.
+func (s *SecondFactorRequest) GetChallenge() string {
+	return s.Challenge
+}
+
+// GetCode returns the value of Code.
+func (s *SecondFactorRequest) GetCode() string {
+	return s.Code
+}
+
+// SetChallenge sets the value of Challenge.
+func (s *SecondFactorRequest) SetChallenge(val string) {
+	s.Challenge = val
+}
+
+// SetCode sets the value of Code.
+func (s *SecondFactorRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// Ref: #/components/schemas/session_dto
+type SessionDto struct {
+	// Идентификатор сессии.
+	ID string `json:"id"`
+	// Дата и время входа.
+	Created string `json:"created"`
+	// Дата и время истечения токена.
+	Expires string `json:"expires"`
+	// User-Agent клиента при входе.
+	UserAgent string `json:"user_agent"`
+	// IP-адрес клиента при входе.
+	IP string `json:"ip"`
+	// Сессия токена, которым выполнен запрос.
+	Current bool `json:"current"`
+}
+
+// GetID returns the value of ID.
+func (s *SessionDto) GetID() string {
+	return s.ID
+}
+
+// GetCreated returns the value of Created.
+func (s *SessionDto) GetCreated() string {
+	return s.Created
+}
+
+// GetExpires returns the value of Expires.
+func (s *SessionDto) GetExpires() string {
+	return s.Expires
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *SessionDto) GetUserAgent() string {
+	return s.UserAgent
+}
+
+// GetIP returns the value of IP.
+func (s *SessionDto) GetIP() string {
+	return s.IP
+}
+
+// GetCurrent returns the value of Current.
+func (s *SessionDto) GetCurrent() bool {
+	return s.Current
+}
+
+// SetID sets the value of ID.
+func (s *SessionDto) SetID(val string) {
+	s.ID = val
+}
+
+// SetCreated sets the value of Created.
+func (s *SessionDto) SetCreated(val string) {
+	s.Created = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *SessionDto) SetExpires(val string) {
+	s.Expires = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *SessionDto) SetUserAgent(val string) {
+	s.UserAgent = val
+}
+
+// SetIP sets the value of IP.
+func (s *SessionDto) SetIP(val string) {
+	s.IP = val
+}
+
+// SetCurrent sets the value of Current.
+func (s *SessionDto) SetCurrent(val bool) {
+	s.Current = val
+}
+
+// Ref: #/components/schemas/share_link_dto
+type ShareLinkDto struct {
+	// Идентификатор ссылки.
+	ID string `json:"id"`
+	// Случайный идентификатор ссылки.
+	Slug string `json:"slug"`
+	// Путь для открытия документа без авторизации.
+	URL string `json:"url"`
+	// Идентификатор документа.
+	Document string `json:"document"`
+	// Для открытия ссылки нужен пароль.
+	Password bool `json:"password"`
+	// Дата и время истечения; отсутствует у бессрочной
+	// ссылки.
+	Expires OptString `json:"expires"`
+	// Лимит скачиваний; отсутствует, если лимита нет.
+	MaxDownloads OptInt `json:"max_downloads"`
+	// Число скачиваний по ссылке.
+	Downloads int `json:"downloads"`
+	// Дата и время последнего скачивания.
+	LastAccess OptString `json:"last_access"`
+	// Дата и время отзыва; отсутствует у действующей ссылки.
+	Revoked OptString `json:"revoked"`
+	// Ссылка не отозвана, не истекла и лимит скачиваний не
+	// исчерпан.
+	Active bool `json:"active"`
+	// Дата и время создания.
+	Created string `json:"created"`
+}
+
+// GetID returns the value of ID.
+func (s *ShareLinkDto) GetID() string {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *ShareLinkDto) GetSlug() string {
+	return s.Slug
+}
+
+// GetURL returns the value of URL.
+func (s *ShareLinkDto) GetURL() string {
+	return s.URL
+}
+
+// GetDocument returns the value of Document.
+func (s *ShareLinkDto) GetDocument() string {
+	return s.Document
+}
+
+// GetPassword returns the value of Password.
+func (s *ShareLinkDto) GetPassword() bool {
+	return s.Password
+}
+
+// GetExpires returns the value of Expires.
+func (s *ShareLinkDto) GetExpires() OptString {
+	return s.Expires
+}
+
+// GetMaxDownloads returns the value of MaxDownloads.
+func (s *ShareLinkDto) GetMaxDownloads() OptInt {
+	return s.MaxDownloads
+}
+
+// GetDownloads returns the value of Downloads.
+func (s *ShareLinkDto) GetDownloads() int {
+	return s.Downloads
+}
+
+// GetLastAccess returns the value of LastAccess.
+func (s *ShareLinkDto) GetLastAccess() OptString {
+	return s.LastAccess
+}
+
+// GetRevoked returns the value of Revoked.
+func (s *ShareLinkDto) GetRevoked() OptString {
+	return s.Revoked
+}
+
+// GetActive returns the value of Active.
+func (s *ShareLinkDto) GetActive() bool {
+	return s.Active
+}
+
+// GetCreated returns the value of Created.
+func (s *ShareLinkDto) GetCreated() string {
+	return s.Created
+}
+
+// SetID sets the value of ID.
+func (s *ShareLinkDto) SetID(val string) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *ShareLinkDto) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetURL sets the value of URL.
+func (s *ShareLinkDto) SetURL(val string) {
+	s.URL = val
+}
+
+// SetDocument sets the value of Document.
+func (s *ShareLinkDto) SetDocument(val string) {
+	s.Document = val
+}
+
+// SetPassword sets the value of Password.
+func (s *ShareLinkDto) SetPassword(val bool) {
+	s.Password = val
+}
+
+// SetExpires sets the value of Expires.
+func (s *ShareLinkDto) SetExpires(val OptString) {
+	s.Expires = val
+}
+
+// SetMaxDownloads sets the value of MaxDownloads.
+func (s *ShareLinkDto) SetMaxDownloads(val OptInt) {
+	s.MaxDownloads = val
+}
+
+// SetDownloads sets the value of Downloads.
+func (s *ShareLinkDto) SetDownloads(val int) {
+	s.Downloads = val
+}
+
+// SetLastAccess sets the value of LastAccess.
+func (s *ShareLinkDto) SetLastAccess(val OptString) {
+	s.LastAccess = val
+}
+
+// SetRevoked sets the value of Revoked.
+func (s *ShareLinkDto) SetRevoked(val OptString) {
+	s.Revoked = val
+}
+
+// SetActive sets the value of Active.
+func (s *ShareLinkDto) SetActive(val bool) {
+	s.Active = val
+}
+
+// SetCreated sets the value of Created.
+func (s *ShareLinkDto) SetCreated(val string) {
+	s.Created = val
+}
+
+// Ref: #/components/schemas/share_link_response
+type ShareLinkResponse struct {
+	Data ShareLinkDto `json:"data"`
+}
+
+// GetData returns the value of Data.
+func (s *ShareLinkResponse) GetData() ShareLinkDto {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *ShareLinkResponse) SetData(val ShareLinkDto) {
+	s.Data = val
+}
+
+func (*ShareLinkResponse) createShareLinkRes() {}
+func (*ShareLinkResponse) revokeShareLinkRes() {}
+
+// StartOidcLoginFound is response for StartOidcLogin operation.
+type StartOidcLoginFound struct {
+	Location string
+}
+
+// GetLocation returns the value of Location.
+func (s *StartOidcLoginFound) GetLocation() string {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *StartOidcLoginFound) SetLocation(val string) {
+	s.Location = val
+}
+
+func (*StartOidcLoginFound) startOidcLoginRes() {}
+
+// Ref: #/components/schemas/tag_dto
+type TagDto struct {
+	// Имя тега.
+	Name string `json:"name"`
+	// Количество документов пользователя с тегом.
+	Count int `json:"count"`
+}
+
+// GetName returns the value of Name.
+func (s *TagDto) GetName() string {
+	return s.Name
+}
+
+// GetCount returns the value of Count.
+func (s *TagDto) GetCount() int {
+	return s.Count
+}
+
+// SetName sets the value of Name.
+func (s *TagDto) SetName(val string) {
+	s.Name = val
+}
+
+// SetCount sets the value of Count.
+func (s *TagDto) SetCount(val int) {
+	s.Count = val
+}
+
+// Ref: #/components/schemas/too_many_requests_error
+type TooManyRequestsError struct {
+	Error TooManyRequestsErrorError `json:"error"`
+}
+
+// GetError returns the value of Error.
+func (s *TooManyRequestsError) GetError() TooManyRequestsErrorError {
+	return s.Error
+}
+
+// SetError sets the value of Error.
+func (s *TooManyRequestsError) SetError(val TooManyRequestsErrorError) {
+	s.Error = val
+}
+
+type TooManyRequestsErrorError struct {
+	Code int    `json:"code"`
+	Text string `json:"text"`
+}
+
+// GetCode returns the value of Code.
+func (s *TooManyRequestsErrorError) GetCode() int {
+	return s.Code
+}
+
+// GetText returns the value of Text.
+func (s *TooManyRequestsErrorError) GetText() string {
+	return s.Text
+}
+
+// SetCode sets the value of Code.
+func (s *TooManyRequestsErrorError) SetCode(val int) {
+	s.Code = val
+}
+
+// SetText sets the value of Text.
+func (s *TooManyRequestsErrorError) SetText(val string) {
+	s.Text = val
+}
+
+// TooManyRequestsErrorHeaders wraps TooManyRequestsError with response headers.
+type TooManyRequestsErrorHeaders struct {
+	RetryAfter int
+	Response   TooManyRequestsError
+}
+
+// GetRetryAfter returns the value of RetryAfter.
+func (s *TooManyRequestsErrorHeaders) GetRetryAfter() int {
+	return s.RetryAfter
+}
+
+// GetResponse returns the value of Response.
+func (s *TooManyRequestsErrorHeaders) GetResponse() TooManyRequestsError {
+	return s.Response
+}
+
+// SetRetryAfter sets the value of RetryAfter.
+func (s *TooManyRequestsErrorHeaders) SetRetryAfter(val int) {
+	s.RetryAfter = val
 }
 
-// GetName returns the value of Name.
-func (s *Meta) GetName() string {
-	return s.Name
+// SetResponse sets the value of Response.
+func (s *TooManyRequestsErrorHeaders) SetResponse(val TooManyRequestsError) {
+	s.Response = val
 }
 
-// GetFile returns the value of File.
-func (s *Meta) GetFile() bool {
-	return s.File
+func (*TooManyRequestsErrorHeaders) changePasswordRes()     {}
+func (*TooManyRequestsErrorHeaders) getSharedDocumentRes()  {}
+func (*TooManyRequestsErrorHeaders) loginUserRes()          {}
+func (*TooManyRequestsErrorHeaders) verifySecondFactorRes() {}
+
+// Ref: #/components/schemas/totp_code_request
+type TotpCodeRequest struct {
+	// Код из приложения-аутентификатора (при отключении 2FA -
+	// или код восстановления).
+	Code string `json:"code"`
 }
 
-// GetPublic returns the value of Public.
-func (s *Meta) GetPublic() bool {
-	return s.Public
+// GetCode returns the value of Code.
+func (s *TotpCodeRequest) GetCode() string {
+	return s.Code
 }
 
-// GetToken returns the value of Token.
-func (s *Meta) GetToken() string {
-	return s.Token
+// SetCode sets the value of Code.
+func (s *TotpCodeRequest) SetCode(val string) {
+	s.Code = val
 }
 
-// GetMime returns the value of Mime.
-func (s *Meta) GetMime() string {
-	return s.Mime
+// Ref: #/components/schemas/totp_enrollment_response
+type TotpEnrollmentResponse struct {
+	Data TotpEnrollmentResponseData `json:"data"`
 }
 
-// GetGrant returns the value of Grant.
-func (s *Meta) GetGrant() []string {
-	return s.Grant
+// GetData returns the value of Data.
+func (s *TotpEnrollmentResponse) GetData() TotpEnrollmentResponseData {
+	return s.Data
 }
 
-// SetName sets the value of Name.
-func (s *Meta) SetName(val string) {
-	s.Name = val
+// SetData sets the value of Data.
+func (s *TotpEnrollmentResponse) SetData(val TotpEnrollmentResponseData) {
+	s.Data = val
 }
 
-// SetFile sets the value of File.
-func (s *Meta) SetFile(val bool) {
-	s.File = val
+func (*TotpEnrollmentResponse) enrollTotpRes() {}
+
+type TotpEnrollmentResponseData struct {
+	// Секрет TOTP в base32 для ручного ввода в приложение.
+	Secret string `json:"secret"`
+	// Otpauth:// URI для QR-кода.
+	URI string `json:"uri"`
 }
 
-// SetPublic sets the value of Public.
-func (s *Meta) SetPublic(val bool) {
-	s.Public = val
+// GetSecret returns the value of Secret.
+func (s *TotpEnrollmentResponseData) GetSecret() string {
+	return s.Secret
 }
 
-// SetToken sets the value of Token.
-func (s *Meta) SetToken(val string) {
-	s.Token = val
+// GetURI returns the value of URI.
+func (s *TotpEnrollmentResponseData) GetURI() string {
+	return s.URI
 }
 
-// SetMime sets the value of Mime.
-func (s *Meta) SetMime(val string) {
-	s.Mime = val
+// SetSecret sets the value of Secret.
+func (s *TotpEnrollmentResponseData) SetSecret(val string) {
+	s.Secret = val
 }
 
-// SetGrant sets the value of Grant.
-func (s *Meta) SetGrant(val []string) {
-	s.Grant = val
+// SetURI sets the value of URI.
+func (s *TotpEnrollmentResponseData) SetURI(val string) {
+	s.URI = val
 }
 
-// Ref: #/components/schemas/not_found_error
-type NotFoundError struct {
-	Error NotFoundErrorError `json:"error"`
+// Ref: #/components/schemas/unauthorized_error
+type UnauthorizedError struct {
+	Error UnauthorizedErrorError `json:"error"`
 }
 
 // GetError returns the value of Error.
-func (s *NotFoundError) GetError() NotFoundErrorError {
+func (s *UnauthorizedError) GetError() UnauthorizedErrorError {
 	return s.Error
 }
 
 // SetError sets the value of Error.
-func (s *NotFoundError) SetError(val NotFoundErrorError) {
+func (s *UnauthorizedError) SetError(val UnauthorizedErrorError) {
 	s.Error = val
 }
 
-func (*NotFoundError) deleteDocumentRes() {}
-func (*NotFoundError) getDocumentRes()    {}
+func (*UnauthorizedError) addDocumentTagsRes()        {}
+func (*UnauthorizedError) cancelUploadRes()           {}
+func (*UnauthorizedError) changePasswordRes()         {}
+func (*UnauthorizedError) completeOidcLoginRes()      {}
+func (*UnauthorizedError) confirmPasswordResetRes()   {}
+func (*UnauthorizedError) confirmTotpRes()            {}
+func (*UnauthorizedError) createApiKeyRes()           {}
+func (*UnauthorizedError) createDocumentRes()         {}
+func (*UnauthorizedError) createDocumentVersionRes()  {}
+func (*UnauthorizedError) createFolderRes()           {}
+func (*UnauthorizedError) createShareLinkRes()        {}
+func (*UnauthorizedError) createUploadRes()           {}
+func (*UnauthorizedError) deleteDocumentRes()         {}
+func (*UnauthorizedError) deleteFolderRes()           {}
+func (*UnauthorizedError) deleteSessionRes()          {}
+func (*UnauthorizedError) deleteUserRes()             {}
+func (*UnauthorizedError) disableTotpRes()            {}
+func (*UnauthorizedError) emptyTrashRes()             {}
+func (*UnauthorizedError) enrollTotpRes()             {}
+func (*UnauthorizedError) finalizeUploadRes()         {}
+func (*UnauthorizedError) getDocumentRes()            {}
+func (*UnauthorizedError) getFolderRes()              {}
+func (*UnauthorizedError) getSharedDocumentRes()      {}
+func (*UnauthorizedError) listApiKeysRes()            {}
+func (*UnauthorizedError) listDocumentVersionsRes()   {}
+func (*UnauthorizedError) listDocumentsRes()          {}
+func (*UnauthorizedError) listFolderRes()             {}
+func (*UnauthorizedError) listSessionsRes()           {}
+func (*UnauthorizedError) listShareLinksRes()         {}
+func (*UnauthorizedError) listTagsRes()               {}
+func (*UnauthorizedError) listTrashRes()              {}
+func (*UnauthorizedError) listUsersRes()              {}
+func (*UnauthorizedError) loginUserRes()              {}
+func (*UnauthorizedError) logoutEverywhereRes()       {}
+func (*UnauthorizedError) logoutUserRes()             {}
+func (*UnauthorizedError) refreshTokenRes()           {}
+func (*UnauthorizedError) registerUserRes()           {}
+func (*UnauthorizedError) removeDocumentTagRes()      {}
+func (*UnauthorizedError) replaceDocumentRes()        {}
+func (*UnauthorizedError) resetUserPasswordRes()      {}
+func (*UnauthorizedError) restoreDocumentRes()        {}
+func (*UnauthorizedError) restoreDocumentVersionRes() {}
+func (*UnauthorizedError) revokeApiKeyRes()           {}
+func (*UnauthorizedError) revokeShareLinkRes()        {}
+func (*UnauthorizedError) searchDocumentsRes()        {}
+func (*UnauthorizedError) unlockUserRes()             {}
+func (*UnauthorizedError) updateDocumentRes()         {}
+func (*UnauthorizedError) updateFolderRes()           {}
+func (*UnauthorizedError) updateUserRes()             {}
+func (*UnauthorizedError) uploadChunkRes()            {}
+func (*UnauthorizedError) verifySecondFactorRes()     {}
 
-type NotFoundErrorError struct {
+type UnauthorizedErrorError struct {
 	Code int    `json:"code"`
 	Text string `json:"text"`
 }
 
 // GetCode returns the value of Code.
-func (s *NotFoundErrorError) GetCode() int {
+func (s *UnauthorizedErrorError) GetCode() int {
 	return s.Code
 }
 
 // GetText returns the value of Text.
-func (s *NotFoundErrorError) GetText() string {
+func (s *UnauthorizedErrorError) GetText() string {
 	return s.Text
 }
 
 // SetCode sets the value of Code.
-func (s *NotFoundErrorError) SetCode(val int) {
+func (s *UnauthorizedErrorError) SetCode(val int) {
 	s.Code = val
 }
 
 // SetText sets the value of Text.
-func (s *NotFoundErrorError) SetText(val string) {
+func (s *UnauthorizedErrorError) SetText(val string) {
 	s.Text = val
 }
 
-// NewOptCreateDocumentRequestMultipartJSON returns new OptCreateDocumentRequestMultipartJSON with value set to v.
-func NewOptCreateDocumentRequestMultipartJSON(v CreateDocumentRequestMultipartJSON) OptCreateDocumentRequestMultipartJSON {
-	return OptCreateDocumentRequestMultipartJSON{
-		Value: v,
-		Set:   true,
-	}
+// Частичное обновление документа, отсутствующие поля
+// не меняются.
+// Ref: #/components/schemas/update_document_request
+type UpdateDocumentRequest struct {
+	// Новое имя документа.
+	Name OptString `json:"name"`
+	// Является ли документ публичным.
+	Public OptBool `json:"public"`
+	// Новый список логинов пользователей с доступом
+	// (заменяет текущий).
+	Grant []string `json:"grant"`
+	// ID папки для переноса документа (пустая строка -
+	// перенос в корень).
+	Folder OptString `json:"folder"`
+	// Изменение метаданных - заданные ключи заменяются,
+	// ключ со значением null удаляется, остальные не меняются.
+	Metadata OptUpdateDocumentRequestMetadata `json:"metadata"`
+	// Новые JSON данные (только для JSON документов, создают
+	// новую версию).
+	JSON OptUpdateDocumentRequestJSON `json:"json"`
 }
 
-// OptCreateDocumentRequestMultipartJSON is optional CreateDocumentRequestMultipartJSON.
-type OptCreateDocumentRequestMultipartJSON struct {
-	Value CreateDocumentRequestMultipartJSON
-	Set   bool
+// GetName returns the value of Name.
+func (s *UpdateDocumentRequest) GetName() OptString {
+	return s.Name
 }
 
-// IsSet returns true if OptCreateDocumentRequestMultipartJSON was set.
-func (o OptCreateDocumentRequestMultipartJSON) IsSet() bool { return o.Set }
+// GetPublic returns the value of Public.
+func (s *UpdateDocumentRequest) GetPublic() OptBool {
+	return s.Public
+}
 
-// Reset unsets value.
-func (o *OptCreateDocumentRequestMultipartJSON) Reset() {
-	var v CreateDocumentRequestMultipartJSON
-	o.Value = v
-	o.Set = false
+// GetGrant returns the value of Grant.
+func (s *UpdateDocumentRequest) GetGrant() []string {
+	return s.Grant
 }
 
-// SetTo sets value to v.
-func (o *OptCreateDocumentRequestMultipartJSON) SetTo(v CreateDocumentRequestMultipartJSON) {
-	o.Set = true
-	o.Value = v
+// GetFolder returns the value of Folder.
+func (s *UpdateDocumentRequest) GetFolder() OptString {
+	return s.Folder
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateDocumentRequestMultipartJSON) Get() (v CreateDocumentRequestMultipartJSON, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMetadata returns the value of Metadata.
+func (s *UpdateDocumentRequest) GetMetadata() OptUpdateDocumentRequestMetadata {
+	return s.Metadata
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateDocumentRequestMultipartJSON) Or(d CreateDocumentRequestMultipartJSON) CreateDocumentRequestMultipartJSON {
-	if v, ok := o.Get(); ok {
-		return v
+// GetJSON returns the value of JSON.
+func (s *UpdateDocumentRequest) GetJSON() OptUpdateDocumentRequestJSON {
+	return s.JSON
+}
+
+// SetName sets the value of Name.
+func (s *UpdateDocumentRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetPublic sets the value of Public.
+func (s *UpdateDocumentRequest) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetGrant sets the value of Grant.
+func (s *UpdateDocumentRequest) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// SetFolder sets the value of Folder.
+func (s *UpdateDocumentRequest) SetFolder(val OptString) {
+	s.Folder = val
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *UpdateDocumentRequest) SetMetadata(val OptUpdateDocumentRequestMetadata) {
+	s.Metadata = val
+}
+
+// SetJSON sets the value of JSON.
+func (s *UpdateDocumentRequest) SetJSON(val OptUpdateDocumentRequestJSON) {
+	s.JSON = val
+}
+
+// Новые JSON данные (только для JSON документов, создают
+// новую версию).
+type UpdateDocumentRequestJSON map[string]jx.Raw
+
+func (s *UpdateDocumentRequestJSON) init() UpdateDocumentRequestJSON {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
-	return d
+	return m
 }
 
-// NewOptCreateDocumentResponseDataJSON returns new OptCreateDocumentResponseDataJSON with value set to v.
-func NewOptCreateDocumentResponseDataJSON(v CreateDocumentResponseDataJSON) OptCreateDocumentResponseDataJSON {
-	return OptCreateDocumentResponseDataJSON{
-		Value: v,
-		Set:   true,
+// Изменение метаданных - заданные ключи заменяются,
+// ключ со значением null удаляется, остальные не меняются.
+type UpdateDocumentRequestMetadata map[string]jx.Raw
+
+func (s *UpdateDocumentRequestMetadata) init() UpdateDocumentRequestMetadata {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
 	}
+	return m
 }
 
-// OptCreateDocumentResponseDataJSON is optional CreateDocumentResponseDataJSON.
-type OptCreateDocumentResponseDataJSON struct {
-	Value CreateDocumentResponseDataJSON
-	Set   bool
+// Ref: #/components/schemas/update_document_response
+type UpdateDocumentResponse struct {
+	Data DocumentDto `json:"data"`
 }
 
-// IsSet returns true if OptCreateDocumentResponseDataJSON was set.
-func (o OptCreateDocumentResponseDataJSON) IsSet() bool { return o.Set }
+// GetData returns the value of Data.
+func (s *UpdateDocumentResponse) GetData() DocumentDto {
+	return s.Data
+}
 
-// Reset unsets value.
-func (o *OptCreateDocumentResponseDataJSON) Reset() {
-	var v CreateDocumentResponseDataJSON
-	o.Value = v
-	o.Set = false
+// SetData sets the value of Data.
+func (s *UpdateDocumentResponse) SetData(val DocumentDto) {
+	s.Data = val
 }
 
-// SetTo sets value to v.
-func (o *OptCreateDocumentResponseDataJSON) SetTo(v CreateDocumentResponseDataJSON) {
-	o.Set = true
-	o.Value = v
+func (*UpdateDocumentResponse) addDocumentTagsRes()   {}
+func (*UpdateDocumentResponse) removeDocumentTagRes() {}
+func (*UpdateDocumentResponse) replaceDocumentRes()   {}
+func (*UpdateDocumentResponse) restoreDocumentRes()   {}
+func (*UpdateDocumentResponse) updateDocumentRes()    {}
+
+// Частичное изменение папки, отсутствующие поля не
+// меняются.
+// Ref: #/components/schemas/update_folder_request
+type UpdateFolderRequest struct {
+	// Новое имя папки.
+	Name OptString `json:"name"`
+	// ID новой родительской папки (пустая строка - перенос в
+	// корень).
+	Parent OptString `json:"parent"`
+	// Содержимое папки доступно всем.
+	Public OptBool `json:"public"`
+	// Новый список логинов с доступом (заменяет текущий).
+	Grant []string `json:"grant"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateDocumentResponseDataJSON) Get() (v CreateDocumentResponseDataJSON, ok bool) {
-	if !o.Set {
-		return v, false
+// GetName returns the value of Name.
+func (s *UpdateFolderRequest) GetName() OptString {
+	return s.Name
+}
+
+// GetParent returns the value of Parent.
+func (s *UpdateFolderRequest) GetParent() OptString {
+	return s.Parent
+}
+
+// GetPublic returns the value of Public.
+func (s *UpdateFolderRequest) GetPublic() OptBool {
+	return s.Public
+}
+
+// GetGrant returns the value of Grant.
+func (s *UpdateFolderRequest) GetGrant() []string {
+	return s.Grant
+}
+
+// SetName sets the value of Name.
+func (s *UpdateFolderRequest) SetName(val OptString) {
+	s.Name = val
+}
+
+// SetParent sets the value of Parent.
+func (s *UpdateFolderRequest) SetParent(val OptString) {
+	s.Parent = val
+}
+
+// SetPublic sets the value of Public.
+func (s *UpdateFolderRequest) SetPublic(val OptBool) {
+	s.Public = val
+}
+
+// SetGrant sets the value of Grant.
+func (s *UpdateFolderRequest) SetGrant(val []string) {
+	s.Grant = val
+}
+
+// Изменение учетной записи администратором,
+// отсутствующие поля не меняются.
+// Ref: #/components/schemas/update_user_request
+type UpdateUserRequest struct {
+	// Новая роль пользователя.
+	Role OptUpdateUserRequestRole `json:"role"`
+	// Заблокировать (true) или разблокировать (false) учетную
+	// запись. Блокировка завершает все сессии.
+	Disabled OptBool `json:"disabled"`
+}
+
+// GetRole returns the value of Role.
+func (s *UpdateUserRequest) GetRole() OptUpdateUserRequestRole {
+	return s.Role
+}
+
+// GetDisabled returns the value of Disabled.
+func (s *UpdateUserRequest) GetDisabled() OptBool {
+	return s.Disabled
+}
+
+// SetRole sets the value of Role.
+func (s *UpdateUserRequest) SetRole(val OptUpdateUserRequestRole) {
+	s.Role = val
+}
+
+// SetDisabled sets the value of Disabled.
+func (s *UpdateUserRequest) SetDisabled(val OptBool) {
+	s.Disabled = val
+}
+
+// Новая роль пользователя.
+type UpdateUserRequestRole string
+
+const (
+	UpdateUserRequestRoleAdmin    UpdateUserRequestRole = "admin"
+	UpdateUserRequestRoleUser     UpdateUserRequestRole = "user"
+	UpdateUserRequestRoleReadonly UpdateUserRequestRole = "readonly"
+	UpdateUserRequestRoleService  UpdateUserRequestRole = "service"
+)
+
+// AllValues returns all UpdateUserRequestRole values.
+func (UpdateUserRequestRole) AllValues() []UpdateUserRequestRole {
+	return []UpdateUserRequestRole{
+		UpdateUserRequestRoleAdmin,
+		UpdateUserRequestRoleUser,
+		UpdateUserRequestRoleReadonly,
+		UpdateUserRequestRoleService,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateDocumentResponseDataJSON) Or(d CreateDocumentResponseDataJSON) CreateDocumentResponseDataJSON {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateUserRequestRole) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateUserRequestRoleAdmin:
+		return []byte(s), nil
+	case UpdateUserRequestRoleUser:
+		return []byte(s), nil
+	case UpdateUserRequestRoleReadonly:
+		return []byte(s), nil
+	case UpdateUserRequestRoleService:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateUserRequestRole) UnmarshalText(data []byte) error {
+	switch UpdateUserRequestRole(data) {
+	case UpdateUserRequestRoleAdmin:
+		*s = UpdateUserRequestRoleAdmin
+		return nil
+	case UpdateUserRequestRoleUser:
+		*s = UpdateUserRequestRoleUser
+		return nil
+	case UpdateUserRequestRoleReadonly:
+		*s = UpdateUserRequestRoleReadonly
+		return nil
+	case UpdateUserRequestRoleService:
+		*s = UpdateUserRequestRoleService
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// UploadChunkNoContent is response for UploadChunk operation.
+type UploadChunkNoContent struct {
+	UploadOffset int64
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+// GetUploadOffset returns the value of UploadOffset.
+func (s *UploadChunkNoContent) GetUploadOffset() int64 {
+	return s.UploadOffset
+}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+// SetUploadOffset sets the value of UploadOffset.
+func (s *UploadChunkNoContent) SetUploadOffset(val int64) {
+	s.UploadOffset = val
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+func (*UploadChunkNoContent) uploadChunkRes() {}
+
+type UploadChunkReq struct {
+	Data io.Reader
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s UploadChunkReq) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
 	}
-	return o.Value, true
+	return s.Data.Read(p)
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// Ref: #/components/schemas/upload_dto
+type UploadDto struct {
+	// Идентификатор сессии загрузки.
+	ID string `json:"id"`
+	// Имя будущего документа.
+	Name string `json:"name"`
+	// MIME тип файла.
+	Mime string `json:"mime"`
+	// Полный размер файла в байтах.
+	Size int64 `json:"size"`
+	// Количество уже принятых байт.
+	Offset int64 `json:"offset"`
+	// ID папки будущего документа (отсутствует для корня).
+	Folder OptString `json:"folder"`
+	// Дата и время истечения сессии.
+	Expires string `json:"expires"`
 }
 
-// NewOptKey returns new OptKey with value set to v.
-func NewOptKey(v Key) OptKey {
-	return OptKey{
-		Value: v,
-		Set:   true,
-	}
+// GetID returns the value of ID.
+func (s *UploadDto) GetID() string {
+	return s.ID
 }
 
-// OptKey is optional Key.
-type OptKey struct {
-	Value Key
-	Set   bool
+// GetName returns the value of Name.
+func (s *UploadDto) GetName() string {
+	return s.Name
 }
 
-// IsSet returns true if OptKey was set.
-func (o OptKey) IsSet() bool { return o.Set }
+// GetMime returns the value of Mime.
+func (s *UploadDto) GetMime() string {
+	return s.Mime
+}
 
-// Reset unsets value.
-func (o *OptKey) Reset() {
-	var v Key
-	o.Value = v
-	o.Set = false
+// GetSize returns the value of Size.
+func (s *UploadDto) GetSize() int64 {
+	return s.Size
 }
 
-// SetTo sets value to v.
-func (o *OptKey) SetTo(v Key) {
-	o.Set = true
-	o.Value = v
+// GetOffset returns the value of Offset.
+func (s *UploadDto) GetOffset() int64 {
+	return s.Offset
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptKey) Get() (v Key, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetFolder returns the value of Folder.
+func (s *UploadDto) GetFolder() OptString {
+	return s.Folder
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptKey) Or(d Key) Key {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetExpires returns the value of Expires.
+func (s *UploadDto) GetExpires() string {
+	return s.Expires
 }
 
-// NewOptMultipartFile returns new OptMultipartFile with value set to v.
-func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
-	return OptMultipartFile{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *UploadDto) SetID(val string) {
+	s.ID = val
 }
 
-// OptMultipartFile is optional ht.MultipartFile.
-type OptMultipartFile struct {
-	Value ht.MultipartFile
-	Set   bool
+// SetName sets the value of Name.
+func (s *UploadDto) SetName(val string) {
+	s.Name = val
 }
 
-// IsSet returns true if OptMultipartFile was set.
-func (o OptMultipartFile) IsSet() bool { return o.Set }
+// SetMime sets the value of Mime.
+func (s *UploadDto) SetMime(val string) {
+	s.Mime = val
+}
 
-// Reset unsets value.
-func (o *OptMultipartFile) Reset() {
-	var v ht.MultipartFile
-	o.Value = v
-	o.Set = false
+// SetSize sets the value of Size.
+func (s *UploadDto) SetSize(val int64) {
+	s.Size = val
 }
 
-// SetTo sets value to v.
-func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
-	o.Set = true
-	o.Value = v
+// SetOffset sets the value of Offset.
+func (s *UploadDto) SetOffset(val int64) {
+	s.Offset = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetFolder sets the value of Folder.
+func (s *UploadDto) SetFolder(val OptString) {
+	s.Folder = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetExpires sets the value of Expires.
+func (s *UploadDto) SetExpires(val string) {
+	s.Expires = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// Ref: #/components/schemas/upload_response
+type UploadResponse struct {
+	Data UploadDto `json:"data"`
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetData returns the value of Data.
+func (s *UploadResponse) GetData() UploadDto {
+	return s.Data
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// SetData sets the value of Data.
+func (s *UploadResponse) SetData(val UploadDto) {
+	s.Data = val
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// UploadResponseHeaders wraps UploadResponse with response headers.
+type UploadResponseHeaders struct {
+	Location OptString
+	Response UploadResponse
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetLocation returns the value of Location.
+func (s *UploadResponseHeaders) GetLocation() OptString {
+	return s.Location
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetResponse returns the value of Response.
+func (s *UploadResponseHeaders) GetResponse() UploadResponse {
+	return s.Response
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetLocation sets the value of Location.
+func (s *UploadResponseHeaders) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// Ref: #/components/schemas/register_request
-type RegisterRequest struct {
-	// Токен администратора (фиксированный, задается в
-	// конфиге).
-	Token string `json:"token"`
-	// Логин нового пользователя (минимум 8 символов,
-	// латиница и цифры).
+// SetResponse sets the value of Response.
+func (s *UploadResponseHeaders) SetResponse(val UploadResponse) {
+	s.Response = val
+}
+
+func (*UploadResponseHeaders) createUploadRes() {}
+
+// Ref: #/components/schemas/user_dto
+type UserDto struct {
+	// Уникальный идентификатор пользователя.
+	ID string `json:"id"`
+	// Логин пользователя.
 	Login string `json:"login"`
-	// Пароль нового пользователя:
-	// - минимум 8 символов
-	// - минимум 2 буквы в разных регистрах
-	// - минимум 1 цифра
-	// - минимум 1 символ (не буква и не цифра).
-	Pswd string `json:"pswd"`
+	// Роль пользователя (admin, user, readonly, service).
+	Role string `json:"role"`
+	// Учетная запись заблокирована.
+	Disabled bool `json:"disabled"`
+	// Пароль сброшен администратором и должен быть изменен.
+	PasswordResetRequired bool `json:"password_reset_required"`
+	// Неудачных попыток входа подряд.
+	FailedLogins int `json:"failed_logins"`
+	// Вход временно заблокирован до этого момента после
+	// неудачных попыток.
+	LockedUntil OptDateTime `json:"locked_until"`
+	// Включена двухфакторная аутентификация.
+	TotpEnabled bool `json:"totp_enabled"`
+	// Дата и время создания пользователя.
+	Created time.Time `json:"created"`
 }
 
-// GetToken returns the value of Token.
-func (s *RegisterRequest) GetToken() string {
-	return s.Token
+// GetID returns the value of ID.
+func (s *UserDto) GetID() string {
+	return s.ID
 }
 
 // GetLogin returns the value of Login.
-func (s *RegisterRequest) GetLogin() string {
+func (s *UserDto) GetLogin() string {
 	return s.Login
 }
 
-// GetPswd returns the value of Pswd.
-func (s *RegisterRequest) GetPswd() string {
-	return s.Pswd
-}
-
-// SetToken sets the value of Token.
-func (s *RegisterRequest) SetToken(val string) {
-	s.Token = val
+// GetRole returns the value of Role.
+func (s *UserDto) GetRole() string {
+	return s.Role
 }
 
-// SetLogin sets the value of Login.
-func (s *RegisterRequest) SetLogin(val string) {
-	s.Login = val
+// GetDisabled returns the value of Disabled.
+func (s *UserDto) GetDisabled() bool {
+	return s.Disabled
 }
 
-// SetPswd sets the value of Pswd.
-func (s *RegisterRequest) SetPswd(val string) {
-	s.Pswd = val
+// GetPasswordResetRequired returns the value of PasswordResetRequired.
+func (s *UserDto) GetPasswordResetRequired() bool {
+	return s.PasswordResetRequired
 }
 
-// Ref: #/components/schemas/register_response
-type RegisterResponse struct {
-	Response RegisterResponseResponse `json:"response"`
+// GetFailedLogins returns the value of FailedLogins.
+func (s *UserDto) GetFailedLogins() int {
+	return s.FailedLogins
 }
 
-// GetResponse returns the value of Response.
-func (s *RegisterResponse) GetResponse() RegisterResponseResponse {
-	return s.Response
+// GetLockedUntil returns the value of LockedUntil.
+func (s *UserDto) GetLockedUntil() OptDateTime {
+	return s.LockedUntil
 }
 
-// SetResponse sets the value of Response.
-func (s *RegisterResponse) SetResponse(val RegisterResponseResponse) {
-	s.Response = val
+// GetTotpEnabled returns the value of TotpEnabled.
+func (s *UserDto) GetTotpEnabled() bool {
+	return s.TotpEnabled
 }
 
-func (*RegisterResponse) registerUserRes() {}
-
-type RegisterResponseResponse struct {
-	// Логин зарегистрированного пользователя.
-	Login string `json:"login"`
+// GetCreated returns the value of Created.
+func (s *UserDto) GetCreated() time.Time {
+	return s.Created
 }
 
-// GetLogin returns the value of Login.
-func (s *RegisterResponseResponse) GetLogin() string {
-	return s.Login
+// SetID sets the value of ID.
+func (s *UserDto) SetID(val string) {
+	s.ID = val
 }
 
 // SetLogin sets the value of Login.
-func (s *RegisterResponseResponse) SetLogin(val string) {
+func (s *UserDto) SetLogin(val string) {
 	s.Login = val
 }
 
-// Ref: #/components/schemas/unauthorized_error
-type UnauthorizedError struct {
-	Error UnauthorizedErrorError `json:"error"`
+// SetRole sets the value of Role.
+func (s *UserDto) SetRole(val string) {
+	s.Role = val
 }
 
-// GetError returns the value of Error.
-func (s *UnauthorizedError) GetError() UnauthorizedErrorError {
-	return s.Error
+// SetDisabled sets the value of Disabled.
+func (s *UserDto) SetDisabled(val bool) {
+	s.Disabled = val
 }
 
-// SetError sets the value of Error.
-func (s *UnauthorizedError) SetError(val UnauthorizedErrorError) {
-	s.Error = val
+// SetPasswordResetRequired sets the value of PasswordResetRequired.
+func (s *UserDto) SetPasswordResetRequired(val bool) {
+	s.PasswordResetRequired = val
 }
 
-func (*UnauthorizedError) createDocumentRes() {}
-func (*UnauthorizedError) deleteDocumentRes() {}
-func (*UnauthorizedError) getDocumentRes()    {}
-func (*UnauthorizedError) listDocumentsRes()  {}
-func (*UnauthorizedError) loginUserRes()      {}
-func (*UnauthorizedError) logoutUserRes()     {}
+// SetFailedLogins sets the value of FailedLogins.
+func (s *UserDto) SetFailedLogins(val int) {
+	s.FailedLogins = val
+}
 
-type UnauthorizedErrorError struct {
-	Code int    `json:"code"`
-	Text string `json:"text"`
+// SetLockedUntil sets the value of LockedUntil.
+func (s *UserDto) SetLockedUntil(val OptDateTime) {
+	s.LockedUntil = val
 }
 
-// GetCode returns the value of Code.
-func (s *UnauthorizedErrorError) GetCode() int {
-	return s.Code
+// SetTotpEnabled sets the value of TotpEnabled.
+func (s *UserDto) SetTotpEnabled(val bool) {
+	s.TotpEnabled = val
 }
 
-// GetText returns the value of Text.
-func (s *UnauthorizedErrorError) GetText() string {
-	return s.Text
+// SetCreated sets the value of Created.
+func (s *UserDto) SetCreated(val time.Time) {
+	s.Created = val
 }
 
-// SetCode sets the value of Code.
-func (s *UnauthorizedErrorError) SetCode(val int) {
-	s.Code = val
+// Ref: #/components/schemas/user_response
+type UserResponse struct {
+	Data UserDto `json:"data"`
 }
 
-// SetText sets the value of Text.
-func (s *UnauthorizedErrorError) SetText(val string) {
-	s.Text = val
+// GetData returns the value of Data.
+func (s *UserResponse) GetData() UserDto {
+	return s.Data
+}
+
+// SetData sets the value of Data.
+func (s *UserResponse) SetData(val UserDto) {
+	s.Data = val
 }
+
+func (*UserResponse) disableTotpRes() {}
+func (*UserResponse) unlockUserRes()  {}
+func (*UserResponse) updateUserRes()  {}
@@ -8,36 +8,223 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddDocumentTags implements addDocumentTags operation.
+	//
+	// Добавление тегов документу; теги принадлежат
+	// владельцу документа.
+	//
+	// POST /api/docs/{id}/tags
+	AddDocumentTags(ctx context.Context, req *AddTagsRequest, params AddDocumentTagsParams) (AddDocumentTagsRes, error)
+	// CancelUpload implements cancelUpload operation.
+	//
+	// Удаление сессии загрузки и всех принятых фрагментов.
+	//
+	// DELETE /api/uploads/{upload_id}
+	CancelUpload(ctx context.Context, params CancelUploadParams) (CancelUploadRes, error)
+	// ChangePassword implements changePassword operation.
+	//
+	// Смена пароля по текущему паролю. Остальные сессии
+	// пользователя завершаются, текущая остается.
+	//
+	// POST /api/auth/password
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, params ChangePasswordParams) (ChangePasswordRes, error)
+	// CompleteOidcLogin implements completeOidcLogin operation.
+	//
+	// Обмен кода авторизации на ID-токен провайдера и выпуск
+	// токена авторизации. Учетная запись провайдера
+	// сопоставляется с пользователем по привязке или по
+	// логину из claim AUTH_OIDC_LOGIN_CLAIM. При включенной 2FA
+	// возвращается токен второго шага.
+	//
+	// GET /api/auth/oidc/callback
+	CompleteOidcLogin(ctx context.Context, params CompleteOidcLoginParams) (CompleteOidcLoginRes, error)
+	// ConfirmPasswordReset implements confirmPasswordReset operation.
+	//
+	// Установка нового пароля по одноразовому токену
+	// сброса. Все сессии пользователя завершаются.
+	//
+	// POST /api/auth/password/reset/confirm
+	ConfirmPasswordReset(ctx context.Context, req *PasswordResetConfirmRequest) (ConfirmPasswordResetRes, error)
+	// ConfirmTotp implements confirmTotp operation.
+	//
+	// Включение двухфакторной аутентификации кодом из
+	// приложения. Возвращает одноразовые коды
+	// восстановления, которые показываются только в этом
+	// ответе. Доступно только с токеном сессии.
+	//
+	// POST /api/auth/2fa/confirm
+	ConfirmTotp(ctx context.Context, req *TotpCodeRequest, params ConfirmTotpParams) (ConfirmTotpRes, error)
+	// CreateApiKey implements createApiKey operation.
+	//
+	// Выпуск долгоживущего ключа с ограниченными
+	// разрешениями для автоматизации. Значение ключа
+	// возвращается только в этом ответе. Доступно только с
+	// токеном сессии.
+	//
+	// POST /api/auth/keys
+	CreateApiKey(ctx context.Context, req *CreateAPIKeyRequest, params CreateApiKeyParams) (CreateApiKeyRes, error)
 	// CreateDocument implements createDocument operation.
 	//
 	// Загрузка нового документа (файл или JSON данные).
 	//
 	// POST /api/docs
 	CreateDocument(ctx context.Context, req *CreateDocumentRequestMultipart) (CreateDocumentRes, error)
+	// CreateDocumentVersion implements createDocumentVersion operation.
+	//
+	// Загрузка нового содержимого для существующего
+	// документа, создает новую текущую версию.
+	//
+	// POST /api/docs/{id}/versions
+	CreateDocumentVersion(ctx context.Context, req *CreateVersionRequestMultipart, params CreateDocumentVersionParams) (CreateDocumentVersionRes, error)
+	// CreateFolder implements createFolder operation.
+	//
+	// Создание папки в корне пользователя или внутри папки
+	// parent.
+	//
+	// POST /api/folders
+	CreateFolder(ctx context.Context, req *CreateFolderRequest, params CreateFolderParams) (CreateFolderRes, error)
+	// CreateShareLink implements createShareLink operation.
+	//
+	// Создание ссылки /s/{slug}, по которой документ
+	// открывается без авторизации. Можно задать пароль,
+	// срок действия и лимит скачиваний. Доступно владельцу
+	// и администратору.
+	//
+	// POST /api/docs/{id}/shares
+	CreateShareLink(ctx context.Context, req *CreateShareLinkRequest, params CreateShareLinkParams) (CreateShareLinkRes, error)
+	// CreateUpload implements createUpload operation.
+	//
+	// Создание сессии загрузки файла по частям; содержимое
+	// передается PATCH запросами к адресу из заголовка Location.
+	//
+	// POST /api/uploads
+	CreateUpload(ctx context.Context, req *CreateUploadRequest, params CreateUploadParams) (CreateUploadRes, error)
 	// DeleteDocument implements deleteDocument operation.
 	//
-	// Удаление документа по его идентификатору.
+	// Перемещение документа в корзину. Документ пропадает
+	// из списков и становится недоступен, но до
+	// окончательного удаления его можно восстановить
+	// через /api/trash/{id}/restore.
 	//
 	// DELETE /api/docs/{id}
 	DeleteDocument(ctx context.Context, params DeleteDocumentParams) (DeleteDocumentRes, error)
+	// DeleteFolder implements deleteFolder operation.
+	//
+	// Удаление пустой папки или, с recursive=true, папки вместе с
+	// вложенными папками; документы перемещаются в корзину.
+	//
+	// DELETE /api/folders/{id}
+	DeleteFolder(ctx context.Context, params DeleteFolderParams) (DeleteFolderRes, error)
+	// DeleteSession implements deleteSession operation.
+	//
+	// Завершение одной из сессий текущего пользователя.
+	//
+	// DELETE /api/auth/sessions/{session_id}
+	DeleteSession(ctx context.Context, params DeleteSessionParams) (DeleteSessionRes, error)
+	// DeleteUser implements deleteUser operation.
+	//
+	// Удаление пользователя вместе с документами или с
+	// передачей документов другому пользователю (transfer_to).
+	// Переданные документы попадают в корень нового
+	// владельца; совпадающие по имени документы получают
+	// суффикс из ID документа.
+	//
+	// DELETE /api/admin/users/{user_id}
+	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
+	// DisableTotp implements disableTotp operation.
+	//
+	// Отключение двухфакторной аутентификации по коду из
+	// приложения или коду восстановления. Недоступно для
+	// ролей, которым 2FA обязательна. Доступно только с
+	// токеном сессии.
+	//
+	// POST /api/auth/2fa/disable
+	DisableTotp(ctx context.Context, req *TotpCodeRequest, params DisableTotpParams) (DisableTotpRes, error)
+	// EmptyTrash implements emptyTrash operation.
+	//
+	// Окончательное удаление всех документов корзины
+	// пользователя вместе с их файлами.
+	//
+	// DELETE /api/trash
+	EmptyTrash(ctx context.Context, params EmptyTrashParams) (EmptyTrashRes, error)
+	// EnrollTotp implements enrollTotp operation.
+	//
+	// Выпуск нового секрета TOTP для
+	// приложения-аутентификатора. Секрет начинает
+	// действовать после подтверждения кодом (POST
+	// /api/auth/2fa/confirm). Доступно только с токеном сессии.
+	//
+	// POST /api/auth/2fa
+	EnrollTotp(ctx context.Context, params EnrollTotpParams) (EnrollTotpRes, error)
+	// FinalizeUpload implements finalizeUpload operation.
+	//
+	// Сборка принятых фрагментов в файл и создание
+	// документа.
+	//
+	// POST /api/uploads/{upload_id}/finalize
+	FinalizeUpload(ctx context.Context, params FinalizeUploadParams) (FinalizeUploadRes, error)
 	// GetDocument implements getDocument operation.
 	//
 	// Получение конкретного документа по его
-	// идентификатору.
+	// идентификатору. Файлы отдаются с заголовками Content-Type,
+	// Content-Length, Content-Disposition, ETag и Last-Modified; поддерживаются Range
+	// (206 Partial Content, несколько диапазонов в multipart/byteranges, 416 для
+	// недопустимого диапазона) и условные запросы If-None-Match /
+	// If-Modified-Since / If-Range (304 Not Modified).
 	//
 	// GET /api/docs/{id}
 	GetDocument(ctx context.Context, params GetDocumentParams) (GetDocumentRes, error)
 	// GetDocumentHead implements getDocumentHead operation.
 	//
 	// HEAD запрос для получения заголовков конкретного
-	// документа.
+	// документа. Для файлов возвращаются те же заголовки,
+	// что и для GET, без тела ответа.
 	//
 	// HEAD /api/docs/{id}
 	GetDocumentHead(ctx context.Context, params GetDocumentHeadParams) (GetDocumentHeadRes, error)
+	// GetFolder implements getFolder operation.
+	//
+	// Получение папки с путем из имен от корня владельца.
+	//
+	// GET /api/folders/{id}
+	GetFolder(ctx context.Context, params GetFolderParams) (GetFolderRes, error)
+	// GetSharedDocument implements getSharedDocument operation.
+	//
+	// Открытие документа по ссылке без авторизации.
+	// Скачиванием считается только ответ 200 с полным
+	// содержимым: ответы на запросы Range (206) и условные
+	// запросы (304) лимит не расходуют. Файлы отдаются так же,
+	// как в getDocument, с поддержкой Range и условных запросов.
+	//
+	// GET /s/{slug}
+	GetSharedDocument(ctx context.Context, params GetSharedDocumentParams) (GetSharedDocumentRes, error)
+	// GetUploadOffset implements getUploadOffset operation.
+	//
+	// Получение количества уже принятых байт для
+	// возобновления загрузки.
+	//
+	// HEAD /api/uploads/{upload_id}
+	GetUploadOffset(ctx context.Context, params GetUploadOffsetParams) (GetUploadOffsetRes, error)
+	// ListApiKeys implements listApiKeys operation.
+	//
+	// Список API-ключей текущего пользователя. Доступно
+	// только с токеном сессии.
+	//
+	// GET /api/auth/keys
+	ListApiKeys(ctx context.Context, params ListApiKeysParams) (ListApiKeysRes, error)
+	// ListDocumentVersions implements listDocumentVersions operation.
+	//
+	// Получение списка версий документа с размером, MIME
+	// типом, автором и датой создания.
+	//
+	// GET /api/docs/{id}/versions
+	ListDocumentVersions(ctx context.Context, params ListDocumentVersionsParams) (ListDocumentVersionsRes, error)
 	// ListDocuments implements listDocuments operation.
 	//
-	// Получение списка документов с возможностью
-	// фильтрации.
+	// Постраничное получение списка документов с
+	// фильтрацией и сортировкой.
+	// Для следующей страницы передайте next_cursor из ответа в
+	// параметре cursor с той же сортировкой.
 	//
 	// GET /api/docs
 	ListDocuments(ctx context.Context, params ListDocumentsParams) (ListDocumentsRes, error)
@@ -47,41 +234,233 @@ type Handler interface {
 	//
 	// HEAD /api/docs
 	ListDocumentsHead(ctx context.Context, params ListDocumentsHeadParams) (ListDocumentsHeadRes, error)
+	// ListFolder implements listFolder operation.
+	//
+	// Вложенные папки и постраничный список документов
+	// папки parent (без параметра - корень пользователя).
+	// Документы папки доступны всем, у кого есть доступ к
+	// папке или к одной из ее родительских папок.
+	// Для следующей страницы документов передайте next_cursor
+	// из ответа в параметре cursor с той же сортировкой.
+	//
+	// GET /api/folders
+	ListFolder(ctx context.Context, params ListFolderParams) (ListFolderRes, error)
+	// ListSessions implements listSessions operation.
+	//
+	// Список активных сессий текущего пользователя.
+	//
+	// GET /api/auth/sessions
+	ListSessions(ctx context.Context, params ListSessionsParams) (ListSessionsRes, error)
+	// ListShareLinks implements listShareLinks operation.
+	//
+	// Список ссылок на документ, включая отозванные и
+	// истекшие. Доступно владельцу и администратору.
+	//
+	// GET /api/docs/{id}/shares
+	ListShareLinks(ctx context.Context, params ListShareLinksParams) (ListShareLinksRes, error)
+	// ListTags implements listTags operation.
+	//
+	// Все теги документов пользователя с количеством
+	// документов по каждому тегу.
+	//
+	// GET /api/tags
+	ListTags(ctx context.Context, params ListTagsParams) (ListTagsRes, error)
+	// ListTrash implements listTrash operation.
+	//
+	// Удаленные документы пользователя. Документы
+	// хранятся в корзине до восстановления, очистки
+	// корзины или окончательного удаления по истечении
+	// срока хранения (TRASH_RETENTION).
+	//
+	// GET /api/trash
+	ListTrash(ctx context.Context, params ListTrashParams) (ListTrashRes, error)
+	// ListUsers implements listUsers operation.
+	//
+	// Поиск пользователей по логину, роли и статусу с
+	// постраничным выводом.
+	//
+	// GET /api/admin/users
+	ListUsers(ctx context.Context, params ListUsersParams) (ListUsersRes, error)
 	// LoginUser implements loginUser operation.
 	//
-	// Получение токена авторизации по логину и паролю.
+	// Получение токена авторизации по логину и паролю. При
+	// включенной 2FA возвращается токен второго шага.
+	// Частота попыток ограничена по IP и логину, после серии
+	// неудачных попыток учетная запись временно
+	// блокируется.
 	//
 	// POST /api/auth
 	LoginUser(ctx context.Context, req *LoginRequest) (LoginUserRes, error)
+	// LogoutEverywhere implements logoutEverywhere operation.
+	//
+	// Завершение всех сессий текущего пользователя,
+	// включая текущую.
+	//
+	// DELETE /api/auth/sessions
+	LogoutEverywhere(ctx context.Context, params LogoutEverywhereParams) (LogoutEverywhereRes, error)
 	// LogoutUser implements logoutUser operation.
 	//
-	// Завершение авторизованной сессии работы.
+	// Завершение сессии токена, переданного в заголовке
+	// Authorization (Bearer), чтобы токен не попадал в URL и журналы
+	// доступа.
+	//
+	// DELETE /api/auth
+	LogoutUser(ctx context.Context) (LogoutUserRes, error)
+	// RefreshToken implements refreshToken operation.
 	//
-	// DELETE /api/auth/{token}
-	LogoutUser(ctx context.Context, params LogoutUserParams) (LogoutUserRes, error)
+	// Выпуск нового токена взамен действующего; старый
+	// токен отзывается.
+	//
+	// POST /api/auth/refresh
+	RefreshToken(ctx context.Context, params RefreshTokenParams) (RefreshTokenRes, error)
 	// RegisterUser implements registerUser operation.
 	//
-	// Создание нового пользователя с логином и паролем.
+	// Создание нового пользователя с логином, паролем и
+	// ролью; доступно только администраторам.
 	//
 	// POST /api/register
 	RegisterUser(ctx context.Context, req *RegisterRequest) (RegisterUserRes, error)
+	// RemoveDocumentTag implements removeDocumentTag operation.
+	//
+	// Снятие одного тега с документа.
+	//
+	// DELETE /api/docs/{id}/tags/{tag}
+	RemoveDocumentTag(ctx context.Context, params RemoveDocumentTagParams) (RemoveDocumentTagRes, error)
+	// ReplaceDocument implements replaceDocument operation.
+	//
+	// Загрузка нового содержимого документа (файла или JSON),
+	// создает новую текущую версию.
+	//
+	// PUT /api/docs/{id}
+	ReplaceDocument(ctx context.Context, req *CreateVersionRequestMultipart, params ReplaceDocumentParams) (ReplaceDocumentRes, error)
+	// RequestPasswordReset implements requestPasswordReset operation.
+	//
+	// Выпуск одноразового токена сброса пароля и отправка
+	// его пользователю.
+	// Ответ не зависит от существования логина.
+	//
+	// POST /api/auth/password/reset
+	RequestPasswordReset(ctx context.Context, req *PasswordResetRequest) (RequestPasswordResetRes, error)
+	// ResetUserPassword implements resetUserPassword operation.
+	//
+	// Выпуск токена сброса пароля. Вход по старому паролю
+	// блокируется до смены пароля, все сессии пользователя
+	// завершаются.
+	//
+	// POST /api/admin/users/{user_id}/password-reset
+	ResetUserPassword(ctx context.Context, params ResetUserPasswordParams) (ResetUserPasswordRes, error)
+	// RestoreDocument implements restoreDocument operation.
+	//
+	// Возврат документа из корзины в его папку (или в корень,
+	//  если папка была удалена). Если там уже есть документ с
+	// тем же именем, восстановление отклоняется.
+	//
+	// POST /api/trash/{id}/restore
+	RestoreDocument(ctx context.Context, params RestoreDocumentParams) (RestoreDocumentRes, error)
+	// RestoreDocumentVersion implements restoreDocumentVersion operation.
+	//
+	// Делает содержимое указанной версии текущим, создавая
+	// новую версию.
+	//
+	// POST /api/docs/{id}/versions/{version}/restore
+	RestoreDocumentVersion(ctx context.Context, params RestoreDocumentVersionParams) (RestoreDocumentVersionRes, error)
+	// RevokeApiKey implements revokeApiKey operation.
+	//
+	// Отзыв одного из API-ключей текущего пользователя.
+	// Доступно только с токеном сессии.
+	//
+	// DELETE /api/auth/keys/{key_id}
+	RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (RevokeApiKeyRes, error)
+	// RevokeShareLink implements revokeShareLink operation.
+	//
+	// Отзыв ссылки на документ; по отозванной ссылке
+	// документ больше не открывается.
+	//
+	// DELETE /api/docs/{id}/shares/{share_id}
+	RevokeShareLink(ctx context.Context, params RevokeShareLinkParams) (RevokeShareLinkRes, error)
+	// SearchDocuments implements searchDocuments operation.
+	//
+	// Поиск по имени, JSON данным и тексту содержимого (text/plain,
+	// text/markdown, text/csv, JSON, PDF)
+	// среди документов, доступных пользователю:
+	// собственных, публичных, выданных через grants
+	// (роли с чтением всех документов ищут по всем). Текст
+	// файлов извлекается фоновым индексатором,
+	// поэтому содержимое только что загруженного файла
+	// находится с небольшой задержкой.
+	//
+	// GET /api/search
+	SearchDocuments(ctx context.Context, params SearchDocumentsParams) (SearchDocumentsRes, error)
+	// StartOidcLogin implements startOidcLogin operation.
+	//
+	// Перенаправление на страницу входа провайдера OpenID
+	// Connect (authorization code + PKCE). После входа провайдер возвращает
+	// пользователя на /api/auth/oidc/callback.
+	//
+	// GET /api/auth/oidc/login
+	StartOidcLogin(ctx context.Context) (StartOidcLoginRes, error)
+	// UnlockUser implements unlockUser operation.
+	//
+	// Снятие временной блокировки после неудачных попыток
+	// входа и сброс счетчика попыток.
+	//
+	// POST /api/admin/users/{user_id}/unlock
+	UnlockUser(ctx context.Context, params UnlockUserParams) (UnlockUserRes, error)
+	// UpdateDocument implements updateDocument operation.
+	//
+	// Изменение имени, публичности, списка доступа и JSON
+	// данных документа.
+	//
+	// PATCH /api/docs/{id}
+	UpdateDocument(ctx context.Context, req *UpdateDocumentRequest, params UpdateDocumentParams) (UpdateDocumentRes, error)
+	// UpdateFolder implements updateFolder operation.
+	//
+	// Переименование, перенос в другую папку владельца или
+	// в корень, изменение публичности и списка доступа.
+	//
+	// PATCH /api/folders/{id}
+	UpdateFolder(ctx context.Context, req *UpdateFolderRequest, params UpdateFolderParams) (UpdateFolderRes, error)
+	// UpdateUser implements updateUser operation.
+	//
+	// Смена роли, блокировка и разблокировка учетной
+	// записи. Блокировка завершает все сессии пользователя.
+	//
+	// PATCH /api/admin/users/{user_id}
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (UpdateUserRes, error)
+	// UploadChunk implements uploadChunk operation.
+	//
+	// Дозапись фрагмента начиная со смещения Upload-Offset,
+	// которое должно совпадать с текущим смещением сессии.
+	//
+	// PATCH /api/uploads/{upload_id}
+	UploadChunk(ctx context.Context, req UploadChunkReq, params UploadChunkParams) (UploadChunkRes, error)
+	// VerifySecondFactor implements verifySecondFactor operation.
+	//
+	// Обмен токена второго шага и кода из приложения или
+	// кода восстановления на токен авторизации. Число
+	// попыток на один токен второго шага ограничено.
+	//
+	// POST /api/auth/2fa/verify
+	VerifySecondFactor(ctx context.Context, req *SecondFactorRequest) (VerifySecondFactorRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
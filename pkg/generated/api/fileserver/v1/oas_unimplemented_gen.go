@@ -13,6 +13,82 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddDocumentTags implements addDocumentTags operation.
+//
+// Добавление тегов документу; теги принадлежат
+// владельцу документа.
+//
+// POST /api/docs/{id}/tags
+func (UnimplementedHandler) AddDocumentTags(ctx context.Context, req *AddTagsRequest, params AddDocumentTagsParams) (r AddDocumentTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CancelUpload implements cancelUpload operation.
+//
+// Удаление сессии загрузки и всех принятых фрагментов.
+//
+// DELETE /api/uploads/{upload_id}
+func (UnimplementedHandler) CancelUpload(ctx context.Context, params CancelUploadParams) (r CancelUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChangePassword implements changePassword operation.
+//
+// Смена пароля по текущему паролю. Остальные сессии
+// пользователя завершаются, текущая остается.
+//
+// POST /api/auth/password
+func (UnimplementedHandler) ChangePassword(ctx context.Context, req *ChangePasswordRequest, params ChangePasswordParams) (r ChangePasswordRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CompleteOidcLogin implements completeOidcLogin operation.
+//
+// Обмен кода авторизации на ID-токен провайдера и выпуск
+// токена авторизации. Учетная запись провайдера
+// сопоставляется с пользователем по привязке или по
+// логину из claim AUTH_OIDC_LOGIN_CLAIM. При включенной 2FA
+// возвращается токен второго шага.
+//
+// GET /api/auth/oidc/callback
+func (UnimplementedHandler) CompleteOidcLogin(ctx context.Context, params CompleteOidcLoginParams) (r CompleteOidcLoginRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConfirmPasswordReset implements confirmPasswordReset operation.
+//
+// Установка нового пароля по одноразовому токену
+// сброса. Все сессии пользователя завершаются.
+//
+// POST /api/auth/password/reset/confirm
+func (UnimplementedHandler) ConfirmPasswordReset(ctx context.Context, req *PasswordResetConfirmRequest) (r ConfirmPasswordResetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConfirmTotp implements confirmTotp operation.
+//
+// Включение двухфакторной аутентификации кодом из
+// приложения. Возвращает одноразовые коды
+// восстановления, которые показываются только в этом
+// ответе. Доступно только с токеном сессии.
+//
+// POST /api/auth/2fa/confirm
+func (UnimplementedHandler) ConfirmTotp(ctx context.Context, req *TotpCodeRequest, params ConfirmTotpParams) (r ConfirmTotpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateApiKey implements createApiKey operation.
+//
+// Выпуск долгоживущего ключа с ограниченными
+// разрешениями для автоматизации. Значение ключа
+// возвращается только в этом ответе. Доступно только с
+// токеном сессии.
+//
+// POST /api/auth/keys
+func (UnimplementedHandler) CreateApiKey(ctx context.Context, req *CreateAPIKeyRequest, params CreateApiKeyParams) (r CreateApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateDocument implements createDocument operation.
 //
 // Загрузка нового документа (файл или JSON данные).
@@ -22,19 +98,144 @@ func (UnimplementedHandler) CreateDocument(ctx context.Context, req *CreateDocum
 	return r, ht.ErrNotImplemented
 }
 
+// CreateDocumentVersion implements createDocumentVersion operation.
+//
+// Загрузка нового содержимого для существующего
+// документа, создает новую текущую версию.
+//
+// POST /api/docs/{id}/versions
+func (UnimplementedHandler) CreateDocumentVersion(ctx context.Context, req *CreateVersionRequestMultipart, params CreateDocumentVersionParams) (r CreateDocumentVersionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateFolder implements createFolder operation.
+//
+// Создание папки в корне пользователя или внутри папки
+// parent.
+//
+// POST /api/folders
+func (UnimplementedHandler) CreateFolder(ctx context.Context, req *CreateFolderRequest, params CreateFolderParams) (r CreateFolderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateShareLink implements createShareLink operation.
+//
+// Создание ссылки /s/{slug}, по которой документ
+// открывается без авторизации. Можно задать пароль,
+// срок действия и лимит скачиваний. Доступно владельцу
+// и администратору.
+//
+// POST /api/docs/{id}/shares
+func (UnimplementedHandler) CreateShareLink(ctx context.Context, req *CreateShareLinkRequest, params CreateShareLinkParams) (r CreateShareLinkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateUpload implements createUpload operation.
+//
+// Создание сессии загрузки файла по частям; содержимое
+// передается PATCH запросами к адресу из заголовка Location.
+//
+// POST /api/uploads
+func (UnimplementedHandler) CreateUpload(ctx context.Context, req *CreateUploadRequest, params CreateUploadParams) (r CreateUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteDocument implements deleteDocument operation.
 //
-// Удаление документа по его идентификатору.
+// Перемещение документа в корзину. Документ пропадает
+// из списков и становится недоступен, но до
+// окончательного удаления его можно восстановить
+// через /api/trash/{id}/restore.
 //
 // DELETE /api/docs/{id}
 func (UnimplementedHandler) DeleteDocument(ctx context.Context, params DeleteDocumentParams) (r DeleteDocumentRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteFolder implements deleteFolder operation.
+//
+// Удаление пустой папки или, с recursive=true, папки вместе с
+// вложенными папками; документы перемещаются в корзину.
+//
+// DELETE /api/folders/{id}
+func (UnimplementedHandler) DeleteFolder(ctx context.Context, params DeleteFolderParams) (r DeleteFolderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteSession implements deleteSession operation.
+//
+// Завершение одной из сессий текущего пользователя.
+//
+// DELETE /api/auth/sessions/{session_id}
+func (UnimplementedHandler) DeleteSession(ctx context.Context, params DeleteSessionParams) (r DeleteSessionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteUser implements deleteUser operation.
+//
+// Удаление пользователя вместе с документами или с
+// передачей документов другому пользователю (transfer_to).
+// Переданные документы попадают в корень нового
+// владельца; совпадающие по имени документы получают
+// суффикс из ID документа.
+//
+// DELETE /api/admin/users/{user_id}
+func (UnimplementedHandler) DeleteUser(ctx context.Context, params DeleteUserParams) (r DeleteUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DisableTotp implements disableTotp operation.
+//
+// Отключение двухфакторной аутентификации по коду из
+// приложения или коду восстановления. Недоступно для
+// ролей, которым 2FA обязательна. Доступно только с
+// токеном сессии.
+//
+// POST /api/auth/2fa/disable
+func (UnimplementedHandler) DisableTotp(ctx context.Context, req *TotpCodeRequest, params DisableTotpParams) (r DisableTotpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EmptyTrash implements emptyTrash operation.
+//
+// Окончательное удаление всех документов корзины
+// пользователя вместе с их файлами.
+//
+// DELETE /api/trash
+func (UnimplementedHandler) EmptyTrash(ctx context.Context, params EmptyTrashParams) (r EmptyTrashRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EnrollTotp implements enrollTotp operation.
+//
+// Выпуск нового секрета TOTP для
+// приложения-аутентификатора. Секрет начинает
+// действовать после подтверждения кодом (POST
+// /api/auth/2fa/confirm). Доступно только с токеном сессии.
+//
+// POST /api/auth/2fa
+func (UnimplementedHandler) EnrollTotp(ctx context.Context, params EnrollTotpParams) (r EnrollTotpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// FinalizeUpload implements finalizeUpload operation.
+//
+// Сборка принятых фрагментов в файл и создание
+// документа.
+//
+// POST /api/uploads/{upload_id}/finalize
+func (UnimplementedHandler) FinalizeUpload(ctx context.Context, params FinalizeUploadParams) (r FinalizeUploadRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetDocument implements getDocument operation.
 //
 // Получение конкретного документа по его
-// идентификатору.
+// идентификатору. Файлы отдаются с заголовками Content-Type,
+// Content-Length, Content-Disposition, ETag и Last-Modified; поддерживаются Range
+// (206 Partial Content, несколько диапазонов в multipart/byteranges, 416 для
+// недопустимого диапазона) и условные запросы If-None-Match /
+// If-Modified-Since / If-Range (304 Not Modified).
 //
 // GET /api/docs/{id}
 func (UnimplementedHandler) GetDocument(ctx context.Context, params GetDocumentParams) (r GetDocumentRes, _ error) {
@@ -44,17 +245,72 @@ func (UnimplementedHandler) GetDocument(ctx context.Context, params GetDocumentP
 // GetDocumentHead implements getDocumentHead operation.
 //
 // HEAD запрос для получения заголовков конкретного
-// документа.
+// документа. Для файлов возвращаются те же заголовки,
+// что и для GET, без тела ответа.
 //
 // HEAD /api/docs/{id}
 func (UnimplementedHandler) GetDocumentHead(ctx context.Context, params GetDocumentHeadParams) (r GetDocumentHeadRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetFolder implements getFolder operation.
+//
+// Получение папки с путем из имен от корня владельца.
+//
+// GET /api/folders/{id}
+func (UnimplementedHandler) GetFolder(ctx context.Context, params GetFolderParams) (r GetFolderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetSharedDocument implements getSharedDocument operation.
+//
+// Открытие документа по ссылке без авторизации.
+// Скачиванием считается только ответ 200 с полным
+// содержимым: ответы на запросы Range (206) и условные
+// запросы (304) лимит не расходуют. Файлы отдаются так же,
+// как в getDocument, с поддержкой Range и условных запросов.
+//
+// GET /s/{slug}
+func (UnimplementedHandler) GetSharedDocument(ctx context.Context, params GetSharedDocumentParams) (r GetSharedDocumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetUploadOffset implements getUploadOffset operation.
+//
+// Получение количества уже принятых байт для
+// возобновления загрузки.
+//
+// HEAD /api/uploads/{upload_id}
+func (UnimplementedHandler) GetUploadOffset(ctx context.Context, params GetUploadOffsetParams) (r GetUploadOffsetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListApiKeys implements listApiKeys operation.
+//
+// Список API-ключей текущего пользователя. Доступно
+// только с токеном сессии.
+//
+// GET /api/auth/keys
+func (UnimplementedHandler) ListApiKeys(ctx context.Context, params ListApiKeysParams) (r ListApiKeysRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListDocumentVersions implements listDocumentVersions operation.
+//
+// Получение списка версий документа с размером, MIME
+// типом, автором и датой создания.
+//
+// GET /api/docs/{id}/versions
+func (UnimplementedHandler) ListDocumentVersions(ctx context.Context, params ListDocumentVersionsParams) (r ListDocumentVersionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ListDocuments implements listDocuments operation.
 //
-// Получение списка документов с возможностью
-// фильтрации.
+// Постраничное получение списка документов с
+// фильтрацией и сортировкой.
+// Для следующей страницы передайте next_cursor из ответа в
+// параметре cursor с той же сортировкой.
 //
 // GET /api/docs
 func (UnimplementedHandler) ListDocuments(ctx context.Context, params ListDocumentsParams) (r ListDocumentsRes, _ error) {
@@ -70,29 +326,293 @@ func (UnimplementedHandler) ListDocumentsHead(ctx context.Context, params ListDo
 	return r, ht.ErrNotImplemented
 }
 
+// ListFolder implements listFolder operation.
+//
+// Вложенные папки и постраничный список документов
+// папки parent (без параметра - корень пользователя).
+// Документы папки доступны всем, у кого есть доступ к
+// папке или к одной из ее родительских папок.
+// Для следующей страницы документов передайте next_cursor
+// из ответа в параметре cursor с той же сортировкой.
+//
+// GET /api/folders
+func (UnimplementedHandler) ListFolder(ctx context.Context, params ListFolderParams) (r ListFolderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListSessions implements listSessions operation.
+//
+// Список активных сессий текущего пользователя.
+//
+// GET /api/auth/sessions
+func (UnimplementedHandler) ListSessions(ctx context.Context, params ListSessionsParams) (r ListSessionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListShareLinks implements listShareLinks operation.
+//
+// Список ссылок на документ, включая отозванные и
+// истекшие. Доступно владельцу и администратору.
+//
+// GET /api/docs/{id}/shares
+func (UnimplementedHandler) ListShareLinks(ctx context.Context, params ListShareLinksParams) (r ListShareLinksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTags implements listTags operation.
+//
+// Все теги документов пользователя с количеством
+// документов по каждому тегу.
+//
+// GET /api/tags
+func (UnimplementedHandler) ListTags(ctx context.Context, params ListTagsParams) (r ListTagsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTrash implements listTrash operation.
+//
+// Удаленные документы пользователя. Документы
+// хранятся в корзине до восстановления, очистки
+// корзины или окончательного удаления по истечении
+// срока хранения (TRASH_RETENTION).
+//
+// GET /api/trash
+func (UnimplementedHandler) ListTrash(ctx context.Context, params ListTrashParams) (r ListTrashRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListUsers implements listUsers operation.
+//
+// Поиск пользователей по логину, роли и статусу с
+// постраничным выводом.
+//
+// GET /api/admin/users
+func (UnimplementedHandler) ListUsers(ctx context.Context, params ListUsersParams) (r ListUsersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // LoginUser implements loginUser operation.
 //
-// Получение токена авторизации по логину и паролю.
+// Получение токена авторизации по логину и паролю. При
+// включенной 2FA возвращается токен второго шага.
+// Частота попыток ограничена по IP и логину, после серии
+// неудачных попыток учетная запись временно
+// блокируется.
 //
 // POST /api/auth
 func (UnimplementedHandler) LoginUser(ctx context.Context, req *LoginRequest) (r LoginUserRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// LogoutEverywhere implements logoutEverywhere operation.
+//
+// Завершение всех сессий текущего пользователя,
+// включая текущую.
+//
+// DELETE /api/auth/sessions
+func (UnimplementedHandler) LogoutEverywhere(ctx context.Context, params LogoutEverywhereParams) (r LogoutEverywhereRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // LogoutUser implements logoutUser operation.
 //
-// Завершение авторизованной сессии работы.
+// Завершение сессии токена, переданного в заголовке
+// Authorization (Bearer), чтобы токен не попадал в URL и журналы
+// доступа.
+//
+// DELETE /api/auth
+func (UnimplementedHandler) LogoutUser(ctx context.Context) (r LogoutUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RefreshToken implements refreshToken operation.
+//
+// Выпуск нового токена взамен действующего; старый
+// токен отзывается.
 //
-// DELETE /api/auth/{token}
-func (UnimplementedHandler) LogoutUser(ctx context.Context, params LogoutUserParams) (r LogoutUserRes, _ error) {
+// POST /api/auth/refresh
+func (UnimplementedHandler) RefreshToken(ctx context.Context, params RefreshTokenParams) (r RefreshTokenRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
 // RegisterUser implements registerUser operation.
 //
-// Создание нового пользователя с логином и паролем.
+// Создание нового пользователя с логином, паролем и
+// ролью; доступно только администраторам.
 //
 // POST /api/register
 func (UnimplementedHandler) RegisterUser(ctx context.Context, req *RegisterRequest) (r RegisterUserRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// RemoveDocumentTag implements removeDocumentTag operation.
+//
+// Снятие одного тега с документа.
+//
+// DELETE /api/docs/{id}/tags/{tag}
+func (UnimplementedHandler) RemoveDocumentTag(ctx context.Context, params RemoveDocumentTagParams) (r RemoveDocumentTagRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReplaceDocument implements replaceDocument operation.
+//
+// Загрузка нового содержимого документа (файла или JSON),
+// создает новую текущую версию.
+//
+// PUT /api/docs/{id}
+func (UnimplementedHandler) ReplaceDocument(ctx context.Context, req *CreateVersionRequestMultipart, params ReplaceDocumentParams) (r ReplaceDocumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RequestPasswordReset implements requestPasswordReset operation.
+//
+// Выпуск одноразового токена сброса пароля и отправка
+// его пользователю.
+// Ответ не зависит от существования логина.
+//
+// POST /api/auth/password/reset
+func (UnimplementedHandler) RequestPasswordReset(ctx context.Context, req *PasswordResetRequest) (r RequestPasswordResetRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResetUserPassword implements resetUserPassword operation.
+//
+// Выпуск токена сброса пароля. Вход по старому паролю
+// блокируется до смены пароля, все сессии пользователя
+// завершаются.
+//
+// POST /api/admin/users/{user_id}/password-reset
+func (UnimplementedHandler) ResetUserPassword(ctx context.Context, params ResetUserPasswordParams) (r ResetUserPasswordRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreDocument implements restoreDocument operation.
+//
+// Возврат документа из корзины в его папку (или в корень,
+//
+//	если папка была удалена). Если там уже есть документ с
+//
+// тем же именем, восстановление отклоняется.
+//
+// POST /api/trash/{id}/restore
+func (UnimplementedHandler) RestoreDocument(ctx context.Context, params RestoreDocumentParams) (r RestoreDocumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreDocumentVersion implements restoreDocumentVersion operation.
+//
+// Делает содержимое указанной версии текущим, создавая
+// новую версию.
+//
+// POST /api/docs/{id}/versions/{version}/restore
+func (UnimplementedHandler) RestoreDocumentVersion(ctx context.Context, params RestoreDocumentVersionParams) (r RestoreDocumentVersionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeApiKey implements revokeApiKey operation.
+//
+// Отзыв одного из API-ключей текущего пользователя.
+// Доступно только с токеном сессии.
+//
+// DELETE /api/auth/keys/{key_id}
+func (UnimplementedHandler) RevokeApiKey(ctx context.Context, params RevokeApiKeyParams) (r RevokeApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeShareLink implements revokeShareLink operation.
+//
+// Отзыв ссылки на документ; по отозванной ссылке
+// документ больше не открывается.
+//
+// DELETE /api/docs/{id}/shares/{share_id}
+func (UnimplementedHandler) RevokeShareLink(ctx context.Context, params RevokeShareLinkParams) (r RevokeShareLinkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchDocuments implements searchDocuments operation.
+//
+// Поиск по имени, JSON данным и тексту содержимого (text/plain,
+// text/markdown, text/csv, JSON, PDF)
+// среди документов, доступных пользователю:
+// собственных, публичных, выданных через grants
+// (роли с чтением всех документов ищут по всем). Текст
+// файлов извлекается фоновым индексатором,
+// поэтому содержимое только что загруженного файла
+// находится с небольшой задержкой.
+//
+// GET /api/search
+func (UnimplementedHandler) SearchDocuments(ctx context.Context, params SearchDocumentsParams) (r SearchDocumentsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// StartOidcLogin implements startOidcLogin operation.
+//
+// Перенаправление на страницу входа провайдера OpenID
+// Connect (authorization code + PKCE). После входа провайдер возвращает
+// пользователя на /api/auth/oidc/callback.
+//
+// GET /api/auth/oidc/login
+func (UnimplementedHandler) StartOidcLogin(ctx context.Context) (r StartOidcLoginRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockUser implements unlockUser operation.
+//
+// Снятие временной блокировки после неудачных попыток
+// входа и сброс счетчика попыток.
+//
+// POST /api/admin/users/{user_id}/unlock
+func (UnimplementedHandler) UnlockUser(ctx context.Context, params UnlockUserParams) (r UnlockUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateDocument implements updateDocument operation.
+//
+// Изменение имени, публичности, списка доступа и JSON
+// данных документа.
+//
+// PATCH /api/docs/{id}
+func (UnimplementedHandler) UpdateDocument(ctx context.Context, req *UpdateDocumentRequest, params UpdateDocumentParams) (r UpdateDocumentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateFolder implements updateFolder operation.
+//
+// Переименование, перенос в другую папку владельца или
+// в корень, изменение публичности и списка доступа.
+//
+// PATCH /api/folders/{id}
+func (UnimplementedHandler) UpdateFolder(ctx context.Context, req *UpdateFolderRequest, params UpdateFolderParams) (r UpdateFolderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateUser implements updateUser operation.
+//
+// Смена роли, блокировка и разблокировка учетной
+// записи. Блокировка завершает все сессии пользователя.
+//
+// PATCH /api/admin/users/{user_id}
+func (UnimplementedHandler) UpdateUser(ctx context.Context, req *UpdateUserRequest, params UpdateUserParams) (r UpdateUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadChunk implements uploadChunk operation.
+//
+// Дозапись фрагмента начиная со смещения Upload-Offset,
+// которое должно совпадать с текущим смещением сессии.
+//
+// PATCH /api/uploads/{upload_id}
+func (UnimplementedHandler) UploadChunk(ctx context.Context, req UploadChunkReq, params UploadChunkParams) (r UploadChunkRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// VerifySecondFactor implements verifySecondFactor operation.
+//
+// Обмен токена второго шага и кода из приложения или
+// кода восстановления на токен авторизации. Число
+// попыток на один токен второго шага ограничено.
+//
+// POST /api/auth/2fa/verify
+func (UnimplementedHandler) VerifySecondFactor(ctx context.Context, req *SecondFactorRequest) (r VerifySecondFactorRes, _ error) {
+	return r, ht.ErrNotImplemented
+}